@@ -7,9 +7,10 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/kaspanet/kaspad/domain/consensus"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
-	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/migrations"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/infrastructure/os/execenv"
 	"github.com/kaspanet/kaspad/infrastructure/os/limits"
@@ -18,9 +19,12 @@ import (
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/kaspanet/kaspad/util/profiling"
 	"github.com/kaspanet/kaspad/version"
-)
 
-const leveldbCacheSizeMiB = 256
+	// Register the database backends selectable with --dbtype
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/boltdb"
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+)
 
 var desiredLimits = &limits.DesiredLimits{
 	FileLimitWant: 2048,
@@ -85,12 +89,6 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 		profiling.Start(app.cfg.Profile, log)
 	}
 
-	// Perform upgrades to kaspad as new versions require it.
-	if err := doUpgrades(); err != nil {
-		log.Error(err)
-		return err
-	}
-
 	// Return now if an interrupt signal was triggered.
 	if signal.InterruptRequested(interrupt) {
 		return nil
@@ -104,6 +102,15 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 		}
 	}
 
+	isRestoringDatabase := app.cfg.RestoreDatabase != ""
+	if isRestoringDatabase {
+		err := verifyNoDatabase(app.cfg)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+	}
+
 	// Open the database
 	databaseContext, err := openDB(app.cfg)
 	if err != nil {
@@ -111,6 +118,14 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 		return err
 	}
 
+	if isRestoringDatabase {
+		err := restoreDatabase(app.cfg, databaseContext)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+	}
+
 	defer func() {
 		log.Infof("Gracefully shutting down the database...")
 		err := databaseContext.Close()
@@ -119,6 +134,14 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 		}
 	}()
 
+	// Perform the database upgrades that new versions of kaspad
+	// require. This must happen before consensus reads the database.
+	err = migrations.Migrate(databaseContext)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
 	// Return now if an interrupt signal was triggered.
 	if signal.InterruptRequested(interrupt) {
 		return nil
@@ -163,12 +186,6 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 	return nil
 }
 
-// doUpgrades performs upgrades to kaspad as new versions require it.
-// currently it's a placeholder we got from kaspad upstream, that does nothing
-func doUpgrades() error {
-	return nil
-}
-
 // dbPath returns the path to the block database given a database type.
 func databasePath(cfg *config.Config) string {
 	return filepath.Join(cfg.DataDir, "db")
@@ -181,6 +198,11 @@ func removeDatabase(cfg *config.Config) error {
 
 func openDB(cfg *config.Config) (database.Database, error) {
 	dbPath := databasePath(cfg)
-	log.Infof("Loading database from '%s'", dbPath)
-	return ldb.NewLevelDB(dbPath, leveldbCacheSizeMiB)
+	log.Infof("Loading %s database from '%s'", cfg.DbType, dbPath)
+	_, databaseCacheSizeMiB := consensus.CacheSizesForBudget(cfg.ActiveNetParams, cfg.CacheSizeMiB)
+	return database.Open(cfg.DbType, dbPath, &database.Options{
+		CacheSizeMiB: databaseCacheSizeMiB,
+		SyncMode:     cfg.DbSyncMode,
+		Compression:  cfg.DbCompression,
+	})
 }
@@ -63,6 +63,13 @@ const (
 	CmdBlockHeaders
 	CmdRequestNextPruningPointUTXOSetChunk
 	CmdDonePruningPointUTXOSetChunks
+	CmdRequestCompactBlock
+	CmdCompactBlock
+	CmdRequestBlockTransactions
+	CmdBlockTransactions
+	CmdRequestArchivalBlocks
+	CmdArchivalBlock
+	CmdArchivalBlockNotFound
 
 	// rpc
 	CmdGetCurrentNetworkRequestMessage
@@ -137,6 +144,28 @@ const (
 	CmdPruningPointUTXOSetOverrideNotificationMessage
 	CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage
 	CmdStopNotifyingPruningPointUTXOSetOverrideResponseMessage
+	CmdGetNetTotalsRequestMessage
+	CmdGetNetTotalsResponseMessage
+	CmdDisconnectPeerRequestMessage
+	CmdDisconnectPeerResponseMessage
+	CmdGetConnectionRequestsRequestMessage
+	CmdGetConnectionRequestsResponseMessage
+	CmdRemovePeerRequestMessage
+	CmdRemovePeerResponseMessage
+	CmdCreateDatabaseSnapshotRequestMessage
+	CmdCreateDatabaseSnapshotResponseMessage
+	CmdExportPruningPointUTXOSetRequestMessage
+	CmdExportPruningPointUTXOSetResponseMessage
+	CmdGetDatabaseStatsRequestMessage
+	CmdGetDatabaseStatsResponseMessage
+	CmdCompactDatabaseRequestMessage
+	CmdCompactDatabaseResponseMessage
+	CmdResetUTXOIndexRequestMessage
+	CmdResetUTXOIndexResponseMessage
+	CmdGetCacheStatsRequestMessage
+	CmdGetCacheStatsResponseMessage
+	CmdGetBlockAcceptanceDataRequestMessage
+	CmdGetBlockAcceptanceDataResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -174,6 +203,13 @@ var ProtocolMessageCommandToString = map[MessageCommand]string{
 	CmdBlockHeaders:                        "BlockHeaders",
 	CmdRequestNextPruningPointUTXOSetChunk: "RequestNextPruningPointUTXOSetChunk",
 	CmdDonePruningPointUTXOSetChunks:       "DonePruningPointUTXOSetChunks",
+	CmdRequestCompactBlock:                 "RequestCompactBlock",
+	CmdCompactBlock:                        "CompactBlock",
+	CmdRequestBlockTransactions:            "RequestBlockTransactions",
+	CmdBlockTransactions:                   "BlockTransactions",
+	CmdRequestArchivalBlocks:               "RequestArchivalBlocks",
+	CmdArchivalBlock:                       "ArchivalBlock",
+	CmdArchivalBlockNotFound:               "ArchivalBlockNotFound",
 }
 
 // RPCMessageCommandToString maps all MessageCommands to their string representation
@@ -248,6 +284,28 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdPruningPointUTXOSetOverrideNotificationMessage:             "PruningPointUTXOSetOverrideNotification",
 	CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage:     "StopNotifyingPruningPointUTXOSetOverrideRequest",
 	CmdStopNotifyingPruningPointUTXOSetOverrideResponseMessage:    "StopNotifyingPruningPointUTXOSetOverrideResponse",
+	CmdGetNetTotalsRequestMessage:                                 "GetNetTotalsRequest",
+	CmdGetNetTotalsResponseMessage:                                "GetNetTotalsResponse",
+	CmdDisconnectPeerRequestMessage:                               "DisconnectPeerRequest",
+	CmdDisconnectPeerResponseMessage:                              "DisconnectPeerResponse",
+	CmdGetConnectionRequestsRequestMessage:                        "GetConnectionRequestsRequest",
+	CmdGetConnectionRequestsResponseMessage:                       "GetConnectionRequestsResponse",
+	CmdRemovePeerRequestMessage:                                   "RemovePeerRequest",
+	CmdRemovePeerResponseMessage:                                  "RemovePeerResponse",
+	CmdCreateDatabaseSnapshotRequestMessage:                       "CreateDatabaseSnapshotRequest",
+	CmdCreateDatabaseSnapshotResponseMessage:                      "CreateDatabaseSnapshotResponse",
+	CmdExportPruningPointUTXOSetRequestMessage:                    "ExportPruningPointUTXOSetRequest",
+	CmdExportPruningPointUTXOSetResponseMessage:                   "ExportPruningPointUTXOSetResponse",
+	CmdGetDatabaseStatsRequestMessage:                             "GetDatabaseStatsRequest",
+	CmdGetDatabaseStatsResponseMessage:                            "GetDatabaseStatsResponse",
+	CmdCompactDatabaseRequestMessage:                              "CompactDatabaseRequest",
+	CmdCompactDatabaseResponseMessage:                             "CompactDatabaseResponse",
+	CmdResetUTXOIndexRequestMessage:                               "ResetUTXOIndexRequest",
+	CmdResetUTXOIndexResponseMessage:                              "ResetUTXOIndexResponse",
+	CmdGetCacheStatsRequestMessage:                                "GetCacheStatsRequest",
+	CmdGetCacheStatsResponseMessage:                               "GetCacheStatsResponse",
+	CmdGetBlockAcceptanceDataRequestMessage:                       "GetBlockAcceptanceDataRequest",
+	CmdGetBlockAcceptanceDataResponseMessage:                      "GetBlockAcceptanceDataResponse",
 }
 
 // Message is an interface that describes a kaspa message. A type that
@@ -0,0 +1,48 @@
+package appmessage
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// MsgArchivalBlock implements the Message interface and represents a kaspa
+// ArchivalBlock message. One is sent for each of the hashes in a
+// MsgRequestArchivalBlocks, in the same order.
+type MsgArchivalBlock struct {
+	baseMessage
+	Block *MsgBlock
+
+	// AcceptanceData is empty if it wasn't requested, or if the block
+	// was never in the selected parent chain
+	AcceptanceData []*MsgBlockAcceptanceData
+}
+
+// MsgBlockAcceptanceData holds which of the transactions of a block in
+// the merge set of an archival block were accepted, in the order of the
+// block's transactions
+type MsgBlockAcceptanceData struct {
+	BlockHash                 *externalapi.DomainHash
+	TransactionAcceptanceData []*MsgTransactionAcceptanceData
+}
+
+// MsgTransactionAcceptanceData holds whether a transaction was accepted,
+// and the fee it paid if it was
+type MsgTransactionAcceptanceData struct {
+	TransactionID *externalapi.DomainTransactionID
+	Fee           uint64
+	IsAccepted    bool
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgArchivalBlock) Command() MessageCommand {
+	return CmdArchivalBlock
+}
+
+// NewMsgArchivalBlock returns a new kaspa ArchivalBlock message that conforms to
+// the Message interface. See MsgArchivalBlock for details.
+func NewMsgArchivalBlock(block *MsgBlock, acceptanceData []*MsgBlockAcceptanceData) *MsgArchivalBlock {
+	return &MsgArchivalBlock{
+		Block:          block,
+		AcceptanceData: acceptanceData,
+	}
+}
@@ -0,0 +1,27 @@
+package appmessage
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// MsgArchivalBlockNotFound implements the Message interface and represents a kaspa
+// ArchivalBlockNotFound message. It is sent instead of a MsgArchivalBlock for a
+// requested block whose body isn't available on the peer.
+type MsgArchivalBlockNotFound struct {
+	baseMessage
+	Hash *externalapi.DomainHash
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgArchivalBlockNotFound) Command() MessageCommand {
+	return CmdArchivalBlockNotFound
+}
+
+// NewMsgArchivalBlockNotFound returns a new kaspa ArchivalBlockNotFound message that conforms to
+// the Message interface. See MsgArchivalBlockNotFound for details.
+func NewMsgArchivalBlockNotFound(hash *externalapi.DomainHash) *MsgArchivalBlockNotFound {
+	return &MsgArchivalBlockNotFound{
+		Hash: hash,
+	}
+}
@@ -0,0 +1,46 @@
+package appmessage
+
+// MsgCompactBlock implements the Message interface and represents a kaspa
+// CompactBlock message. It carries a block's header and, instead of its full
+// transactions, a short ID for every transaction the receiver is expected to
+// already have in its mempool. The rest of the transactions, always including
+// the coinbase, are prefilled.
+//
+// The block's transactions are ordered by filling every index that is not
+// covered by PrefilledTransactions with the next short ID.
+type MsgCompactBlock struct {
+	baseMessage
+	Header                MsgBlockHeader
+	ShortIDs              []uint64
+	PrefilledTransactions []*PrefilledTransaction
+}
+
+// PrefilledTransaction is a transaction that is sent in full within a
+// MsgCompactBlock, along with its index in the block
+type PrefilledTransaction struct {
+	Index       uint32
+	Transaction *MsgTx
+}
+
+// TransactionCount returns the total number of transactions in the block
+func (msg *MsgCompactBlock) TransactionCount() int {
+	return len(msg.ShortIDs) + len(msg.PrefilledTransactions)
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgCompactBlock) Command() MessageCommand {
+	return CmdCompactBlock
+}
+
+// NewMsgCompactBlock returns a new kaspa CompactBlock message that conforms to
+// the Message interface. See MsgCompactBlock for details.
+func NewMsgCompactBlock(header *MsgBlockHeader, shortIDs []uint64,
+	prefilledTransactions []*PrefilledTransaction) *MsgCompactBlock {
+
+	return &MsgCompactBlock{
+		Header:                *header,
+		ShortIDs:              shortIDs,
+		PrefilledTransactions: prefilledTransactions,
+	}
+}
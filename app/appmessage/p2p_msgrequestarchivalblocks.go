@@ -0,0 +1,33 @@
+package appmessage
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// MaxRequestArchivalBlocksHashes is the maximum number of hashes that can
+// be in a single RequestArchivalBlocks message.
+const MaxRequestArchivalBlocksHashes = 100
+
+// MsgRequestArchivalBlocks implements the Message interface and represents a kaspa
+// RequestArchivalBlocks message. It is used to request blocks, including blocks
+// below the pruning point, from peers that advertise SFNodeArchival.
+type MsgRequestArchivalBlocks struct {
+	baseMessage
+	Hashes                []*externalapi.DomainHash
+	IncludeAcceptanceData bool
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgRequestArchivalBlocks) Command() MessageCommand {
+	return CmdRequestArchivalBlocks
+}
+
+// NewMsgRequestArchivalBlocks returns a new kaspa RequestArchivalBlocks message that conforms to
+// the Message interface. See MsgRequestArchivalBlocks for details.
+func NewMsgRequestArchivalBlocks(hashes []*externalapi.DomainHash, includeAcceptanceData bool) *MsgRequestArchivalBlocks {
+	return &MsgRequestArchivalBlocks{
+		Hashes:                hashes,
+		IncludeAcceptanceData: includeAcceptanceData,
+	}
+}
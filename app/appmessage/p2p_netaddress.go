@@ -5,10 +5,33 @@
 package appmessage
 
 import (
-	"github.com/kaspanet/kaspad/util/mstime"
+	"bytes"
+	"encoding/base32"
 	"net"
+	"strconv"
+	"strings"
+
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// OnionPublicKeySize is the size of the public key of a Tor version 3
+	// onion service, which is what identifies it
+	OnionPublicKeySize = 32
+
+	onionSuffix         = ".onion"
+	onionHostNameLength = 56
+	onionVersion        = 3
+	onionChecksumSize   = 2
+	onionChecksumPrefix = ".onion checksum"
 )
 
+// onionEncoding is the base32 encoding of onion host names, without the
+// .onion suffix
+var onionEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
 // NetAddress defines information about a peer on the network including the time
 // it was last seen, the services it supports, its IP address, and port.
 type NetAddress struct {
@@ -24,6 +47,11 @@ type NetAddress struct {
 	// Port the peer is using. This is encoded in big endian on the appmessage
 	// which differs from most everything else.
 	Port uint16
+
+	// OnionPublicKey is the public key of the Tor version 3 onion service
+	// the peer is reachable at. It's set only for .onion addresses, which
+	// have no IP address.
+	OnionPublicKey []byte
 }
 
 // HasService returns whether the specified service is supported by the address.
@@ -45,6 +73,80 @@ func (na *NetAddress) TCPAddress() *net.TCPAddr {
 	}
 }
 
+// IsOnion returns whether the NetAddress is a Tor .onion address
+func (na *NetAddress) IsOnion() bool {
+	return len(na.OnionPublicKey) != 0
+}
+
+// Host returns the host of the NetAddress: its .onion host name if it is a
+// Tor address, or its IP address otherwise.
+func (na *NetAddress) Host() string {
+	if na.IsOnion() {
+		return OnionPublicKeyToHost(na.OnionPublicKey)
+	}
+	return na.IP.String()
+}
+
+// String returns the NetAddress in host:port form
+func (na *NetAddress) String() string {
+	return net.JoinHostPort(na.Host(), strconv.Itoa(int(na.Port)))
+}
+
+// IsOnionHost returns whether the given host is a Tor .onion host name
+func IsOnionHost(host string) bool {
+	return strings.HasSuffix(strings.ToLower(host), onionSuffix)
+}
+
+// OnionHostToPublicKey returns the public key of the onion service with the
+// given .onion host name. Only version 3 onion services are supported, since
+// Tor no longer supports older ones.
+func OnionHostToPublicKey(host string) ([]byte, error) {
+	if !IsOnionHost(host) {
+		return nil, errors.Errorf("%s is not a .onion address", host)
+	}
+	name := host[:len(host)-len(onionSuffix)]
+	if len(name) != onionHostNameLength {
+		return nil, errors.Errorf("%s is not a version 3 .onion address", host)
+	}
+	data, err := onionEncoding.DecodeString(strings.ToUpper(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s is not a valid .onion address", host)
+	}
+
+	// The host name encodes the public key, followed by a checksum and the version
+	publicKey := data[:OnionPublicKeySize]
+	checksum := data[OnionPublicKeySize : OnionPublicKeySize+onionChecksumSize]
+	version := data[OnionPublicKeySize+onionChecksumSize]
+	if version != onionVersion {
+		return nil, errors.Errorf("%s is a version %d .onion address: only version %d is supported",
+			host, version, onionVersion)
+	}
+	if !bytes.Equal(checksum, onionChecksum(publicKey)) {
+		return nil, errors.Errorf("%s has an invalid checksum", host)
+	}
+	return publicKey, nil
+}
+
+// OnionPublicKeyToHost returns the .onion host name of the onion service with
+// the given public key
+func OnionPublicKeyToHost(publicKey []byte) string {
+	data := make([]byte, 0, OnionPublicKeySize+onionChecksumSize+1)
+	data = append(data, publicKey...)
+	data = append(data, onionChecksum(publicKey)...)
+	data = append(data, onionVersion)
+	return strings.ToLower(onionEncoding.EncodeToString(data)) + onionSuffix
+}
+
+// onionChecksum returns the checksum of the .onion host name of the version 3
+// onion service with the given public key, as specified by Tor's rend-spec-v3
+func onionChecksum(publicKey []byte) []byte {
+	hash := sha3.New256()
+	hash.Write([]byte(onionChecksumPrefix))
+	hash.Write(publicKey)
+	hash.Write([]byte{onionVersion})
+	return hash.Sum(nil)[:onionChecksumSize]
+}
+
 // NewNetAddressIPPort returns a new NetAddress using the provided IP, port, and
 // supported services with defaults for the remaining fields.
 func NewNetAddressIPPort(ip net.IP, port uint16, services ServiceFlag) *NetAddress {
@@ -67,6 +169,18 @@ func NewNetAddressTimestamp(
 	return &na
 }
 
+// NewOnionNetAddress returns a new NetAddress of the onion service with the
+// provided public key, using the provided port and supported services with
+// defaults for the remaining fields.
+func NewOnionNetAddress(publicKey []byte, port uint16, services ServiceFlag) *NetAddress {
+	return &NetAddress{
+		Timestamp:      mstime.Now(),
+		Services:       services,
+		Port:           port,
+		OnionPublicKey: publicKey,
+	}
+}
+
 // NewNetAddress returns a new NetAddress using the provided TCP address and
 // supported services with defaults for the remaining fields.
 func NewNetAddress(addr *net.TCPAddr, services ServiceFlag) *NetAddress {
@@ -5,7 +5,10 @@
 package appmessage
 
 import (
+	"bytes"
+	"encoding/hex"
 	"net"
+	"strings"
 	"testing"
 )
 
@@ -43,3 +46,62 @@ func TestNetAddress(t *testing.T) {
 		t.Errorf("HasService: SFNodeNetwork service not set")
 	}
 }
+
+// TestOnionNetAddress tests the encoding of .onion addresses as NetAddresses.
+func TestOnionNetAddress(t *testing.T) {
+	host := "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+	expectedPublicKey, _ := hex.DecodeString("1d04a1d04a338c6e6ae970bfabee49049d6702250984ca950c01673f4ec034ad")
+
+	publicKey, err := OnionHostToPublicKey(host)
+	if err != nil {
+		t.Fatalf("OnionHostToPublicKey: unexpected error: %s", err)
+	}
+	if !bytes.Equal(publicKey, expectedPublicKey) {
+		t.Fatalf("OnionHostToPublicKey: wrong public key - got %x, want %x", publicKey, expectedPublicKey)
+	}
+	upperCasePublicKey, err := OnionHostToPublicKey(strings.ToUpper(host))
+	if err != nil || !bytes.Equal(upperCasePublicKey, publicKey) {
+		t.Errorf("OnionHostToPublicKey: upper case host name - got %x, %v, want %x", upperCasePublicKey, err, publicKey)
+	}
+
+	na := NewOnionNetAddress(publicKey, 16111, 0)
+	if !na.IsOnion() {
+		t.Errorf("IsOnion: %s is not reported as an onion address", na)
+	}
+	if na.IP != nil {
+		t.Errorf("IP: an onion address unexpectedly has the IP %s", na.IP)
+	}
+	if na.Host() != host {
+		t.Errorf("Host: got %s, want %s", na.Host(), host)
+	}
+	if na.String() != host+":16111" {
+		t.Errorf("String: got %s, want %s", na.String(), host+":16111")
+	}
+
+	ipv4Address := NewNetAddressIPPort(net.ParseIP("127.0.0.1"), 16111, 0)
+	if ipv4Address.IsOnion() {
+		t.Errorf("IsOnion: %s is reported as an onion address", ipv4Address.IP)
+	}
+	if ipv4Address.String() != "127.0.0.1:16111" {
+		t.Errorf("String: got %s, want %s", ipv4Address.String(), "127.0.0.1:16111")
+	}
+
+	invalidHosts := []string{
+		// Not .onion addresses
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.com",
+		// A version 2 .onion address
+		"a5ccbdkubbr2jlcp.onion",
+		// Not base32
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzcza!.onion",
+		// A wrong checksum
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzdzad.onion",
+		// A wrong version
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczae.onion",
+	}
+	for _, invalidHost := range invalidHosts {
+		_, err := OnionHostToPublicKey(invalidHost)
+		if err == nil {
+			t.Errorf("OnionHostToPublicKey: expected an error for %s", invalidHost)
+		}
+	}
+}
@@ -12,7 +12,11 @@ import (
 
 const (
 	// ProtocolVersion is the latest protocol version this package supports.
-	ProtocolVersion uint32 = 1
+	ProtocolVersion uint32 = 2
+
+	// CompactBlocksProtocolVersion is the protocol version from which peers
+	// relay blocks using compact blocks.
+	CompactBlocksProtocolVersion uint32 = 2
 
 	// DefaultServices describes the default services that are supported by
 	// the server.
@@ -44,16 +48,21 @@ const (
 	// SFNodeCF is a flag used to indicate a peer supports committed
 	// filters (CFs).
 	SFNodeCF
+
+	// SFNodeArchival is a flag used to indicate a peer is an archival
+	// node, and serves the blocks below its pruning point.
+	SFNodeArchival
 )
 
 // Map of service flags back to their constant names for pretty printing.
 var sfStrings = map[ServiceFlag]string{
-	SFNodeNetwork: "SFNodeNetwork",
-	SFNodeGetUTXO: "SFNodeGetUTXO",
-	SFNodeBloom:   "SFNodeBloom",
-	SFNodeXthin:   "SFNodeXthin",
-	SFNodeBit5:    "SFNodeBit5",
-	SFNodeCF:      "SFNodeCF",
+	SFNodeNetwork:  "SFNodeNetwork",
+	SFNodeGetUTXO:  "SFNodeGetUTXO",
+	SFNodeBloom:    "SFNodeBloom",
+	SFNodeXthin:    "SFNodeXthin",
+	SFNodeBit5:     "SFNodeBit5",
+	SFNodeCF:       "SFNodeCF",
+	SFNodeArchival: "SFNodeArchival",
 }
 
 // orderedSFStrings is an ordered list of service flags from highest to
@@ -65,6 +74,7 @@ var orderedSFStrings = []ServiceFlag{
 	SFNodeXthin,
 	SFNodeBit5,
 	SFNodeCF,
+	SFNodeArchival,
 }
 
 // String returns the ServiceFlag in human-readable form.
@@ -0,0 +1,45 @@
+package appmessage
+
+// CreateDatabaseSnapshotRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type CreateDatabaseSnapshotRequestMessage struct {
+	baseMessage
+	Path     string
+	Compress bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *CreateDatabaseSnapshotRequestMessage) Command() MessageCommand {
+	return CmdCreateDatabaseSnapshotRequestMessage
+}
+
+// NewCreateDatabaseSnapshotRequestMessage returns a instance of the message
+func NewCreateDatabaseSnapshotRequestMessage(path string, compress bool) *CreateDatabaseSnapshotRequestMessage {
+	return &CreateDatabaseSnapshotRequestMessage{
+		Path:     path,
+		Compress: compress,
+	}
+}
+
+// CreateDatabaseSnapshotResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type CreateDatabaseSnapshotResponseMessage struct {
+	baseMessage
+	EntryCount uint64
+	Size       uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *CreateDatabaseSnapshotResponseMessage) Command() MessageCommand {
+	return CmdCreateDatabaseSnapshotResponseMessage
+}
+
+// NewCreateDatabaseSnapshotResponseMessage returns a instance of the message
+func NewCreateDatabaseSnapshotResponseMessage(entryCount uint64, size uint64) *CreateDatabaseSnapshotResponseMessage {
+	return &CreateDatabaseSnapshotResponseMessage{
+		EntryCount: entryCount,
+		Size:       size,
+	}
+}
@@ -0,0 +1,47 @@
+package appmessage
+
+// ExportPruningPointUTXOSetRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ExportPruningPointUTXOSetRequestMessage struct {
+	baseMessage
+	Path string
+}
+
+// Command returns the protocol command string for the message
+func (msg *ExportPruningPointUTXOSetRequestMessage) Command() MessageCommand {
+	return CmdExportPruningPointUTXOSetRequestMessage
+}
+
+// NewExportPruningPointUTXOSetRequestMessage returns a instance of the message
+func NewExportPruningPointUTXOSetRequestMessage(path string) *ExportPruningPointUTXOSetRequestMessage {
+	return &ExportPruningPointUTXOSetRequestMessage{
+		Path: path,
+	}
+}
+
+// ExportPruningPointUTXOSetResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ExportPruningPointUTXOSetResponseMessage struct {
+	baseMessage
+	PruningPointHash string
+	HeaderCount      uint64
+	UTXOCount        uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *ExportPruningPointUTXOSetResponseMessage) Command() MessageCommand {
+	return CmdExportPruningPointUTXOSetResponseMessage
+}
+
+// NewExportPruningPointUTXOSetResponseMessage returns a instance of the message
+func NewExportPruningPointUTXOSetResponseMessage(pruningPointHash string,
+	headerCount uint64, utxoCount uint64) *ExportPruningPointUTXOSetResponseMessage {
+
+	return &ExportPruningPointUTXOSetResponseMessage{
+		PruningPointHash: pruningPointHash,
+		HeaderCount:      headerCount,
+		UTXOCount:        utxoCount,
+	}
+}
@@ -0,0 +1,41 @@
+package appmessage
+
+// GetBlockAcceptanceDataRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetBlockAcceptanceDataRequestMessage struct {
+	baseMessage
+	Hash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetBlockAcceptanceDataRequestMessage) Command() MessageCommand {
+	return CmdGetBlockAcceptanceDataRequestMessage
+}
+
+// NewGetBlockAcceptanceDataRequestMessage returns a instance of the message
+func NewGetBlockAcceptanceDataRequestMessage(hash string) *GetBlockAcceptanceDataRequestMessage {
+	return &GetBlockAcceptanceDataRequestMessage{
+		Hash: hash,
+	}
+}
+
+// GetBlockAcceptanceDataResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetBlockAcceptanceDataResponseMessage struct {
+	baseMessage
+	AcceptedBlocks []*AcceptedBlock
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetBlockAcceptanceDataResponseMessage) Command() MessageCommand {
+	return CmdGetBlockAcceptanceDataResponseMessage
+}
+
+// NewGetBlockAcceptanceDataResponseMessage returns a instance of the message
+func NewGetBlockAcceptanceDataResponseMessage(acceptedBlocks []*AcceptedBlock) *GetBlockAcceptanceDataResponseMessage {
+	return &GetBlockAcceptanceDataResponseMessage{
+		AcceptedBlocks: acceptedBlocks,
+	}
+}
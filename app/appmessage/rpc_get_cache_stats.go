@@ -0,0 +1,48 @@
+package appmessage
+
+// GetCacheStatsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetCacheStatsRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetCacheStatsRequestMessage) Command() MessageCommand {
+	return CmdGetCacheStatsRequestMessage
+}
+
+// NewGetCacheStatsRequestMessage returns a instance of the message
+func NewGetCacheStatsRequestMessage() *GetCacheStatsRequestMessage {
+	return &GetCacheStatsRequestMessage{}
+}
+
+// GetCacheStatsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetCacheStatsResponseMessage struct {
+	baseMessage
+	Caches []*CacheStats
+
+	Error *RPCError
+}
+
+// CacheStats are the size of an in-memory cache and the number
+// of lookups that were and weren't served from it
+type CacheStats struct {
+	Name     string
+	Size     uint64
+	Capacity uint64
+	Hits     uint64
+	Misses   uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetCacheStatsResponseMessage) Command() MessageCommand {
+	return CmdGetCacheStatsResponseMessage
+}
+
+// NewGetCacheStatsResponseMessage returns a instance of the message
+func NewGetCacheStatsResponseMessage(caches []*CacheStats) *GetCacheStatsResponseMessage {
+	return &GetCacheStatsResponseMessage{
+		Caches: caches,
+	}
+}
@@ -47,4 +47,12 @@ type GetConnectedPeerInfoMessage struct {
 	AdvertisedProtocolVersion uint32
 	TimeConnected             int64
 	IsIBDPeer                 bool
+	BanScore                  uint32
+	BytesSent                 uint64
+	BytesReceived             uint64
+	MessagesSent              uint64
+	MessagesReceived          uint64
+	SentPerCommand            []*MessageTrafficStats
+	ReceivedPerCommand        []*MessageTrafficStats
+	IsArchival                bool
 }
@@ -0,0 +1,46 @@
+package appmessage
+
+// GetConnectionRequestsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetConnectionRequestsRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetConnectionRequestsRequestMessage) Command() MessageCommand {
+	return CmdGetConnectionRequestsRequestMessage
+}
+
+// NewGetConnectionRequestsRequestMessage returns a instance of the message
+func NewGetConnectionRequestsRequestMessage() *GetConnectionRequestsRequestMessage {
+	return &GetConnectionRequestsRequestMessage{}
+}
+
+// GetConnectionRequestsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetConnectionRequestsResponseMessage struct {
+	baseMessage
+	ConnectionRequests []*ConnectionRequestInfo
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetConnectionRequestsResponseMessage) Command() MessageCommand {
+	return CmdGetConnectionRequestsResponseMessage
+}
+
+// NewGetConnectionRequestsResponseMessage returns a instance of the message
+func NewGetConnectionRequestsResponseMessage(connectionRequests []*ConnectionRequestInfo) *GetConnectionRequestsResponseMessage {
+	return &GetConnectionRequestsResponseMessage{
+		ConnectionRequests: connectionRequests,
+	}
+}
+
+// ConnectionRequestInfo holds information about a single connection request
+type ConnectionRequestInfo struct {
+	Address              string
+	IsPermanent          bool
+	IsConnected          bool
+	NextAttemptTimestamp int64
+}
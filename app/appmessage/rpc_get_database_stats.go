@@ -0,0 +1,47 @@
+package appmessage
+
+// GetDatabaseStatsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetDatabaseStatsRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetDatabaseStatsRequestMessage) Command() MessageCommand {
+	return CmdGetDatabaseStatsRequestMessage
+}
+
+// NewGetDatabaseStatsRequestMessage returns a instance of the message
+func NewGetDatabaseStatsRequestMessage() *GetDatabaseStatsRequestMessage {
+	return &GetDatabaseStatsRequestMessage{}
+}
+
+// GetDatabaseStatsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetDatabaseStatsResponseMessage struct {
+	baseMessage
+	Stores []*DatabaseStoreStats
+
+	Error *RPCError
+}
+
+// DatabaseStoreStats are the number of keys of a database store
+// and the total sizes of its keys and values
+type DatabaseStoreStats struct {
+	Name       string
+	KeyCount   uint64
+	KeysSize   uint64
+	ValuesSize uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetDatabaseStatsResponseMessage) Command() MessageCommand {
+	return CmdGetDatabaseStatsResponseMessage
+}
+
+// NewGetDatabaseStatsResponseMessage returns a instance of the message
+func NewGetDatabaseStatsResponseMessage(stores []*DatabaseStoreStats) *GetDatabaseStatsResponseMessage {
+	return &GetDatabaseStatsResponseMessage{
+		Stores: stores,
+	}
+}
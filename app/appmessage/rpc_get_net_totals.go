@@ -0,0 +1,59 @@
+package appmessage
+
+// GetNetTotalsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetNetTotalsRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetNetTotalsRequestMessage) Command() MessageCommand {
+	return CmdGetNetTotalsRequestMessage
+}
+
+// NewGetNetTotalsRequestMessage returns a instance of the message
+func NewGetNetTotalsRequestMessage() *GetNetTotalsRequestMessage {
+	return &GetNetTotalsRequestMessage{}
+}
+
+// GetNetTotalsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetNetTotalsResponseMessage struct {
+	baseMessage
+	TotalBytesSent        uint64
+	TotalBytesReceived    uint64
+	TotalMessagesSent     uint64
+	TotalMessagesReceived uint64
+	SentPerCommand        []*MessageTrafficStats
+	ReceivedPerCommand    []*MessageTrafficStats
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetNetTotalsResponseMessage) Command() MessageCommand {
+	return CmdGetNetTotalsResponseMessage
+}
+
+// NewGetNetTotalsResponseMessage returns a instance of the message
+func NewGetNetTotalsResponseMessage(totalBytesSent uint64, totalBytesReceived uint64,
+	totalMessagesSent uint64, totalMessagesReceived uint64,
+	sentPerCommand []*MessageTrafficStats, receivedPerCommand []*MessageTrafficStats) *GetNetTotalsResponseMessage {
+
+	return &GetNetTotalsResponseMessage{
+		TotalBytesSent:        totalBytesSent,
+		TotalBytesReceived:    totalBytesReceived,
+		TotalMessagesSent:     totalMessagesSent,
+		TotalMessagesReceived: totalMessagesReceived,
+		SentPerCommand:        sentPerCommand,
+		ReceivedPerCommand:    receivedPerCommand,
+	}
+}
+
+// MessageTrafficStats holds the number of messages of a single command that
+// were sent or received, and the number of bytes they took up
+type MessageTrafficStats struct {
+	Command      string
+	MessageCount uint64
+	ByteCount    uint64
+}
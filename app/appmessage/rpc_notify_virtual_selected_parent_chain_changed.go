@@ -49,7 +49,10 @@ type ChainBlock struct {
 
 // AcceptedBlock represents a block accepted into the DAG
 type AcceptedBlock struct {
-	Hash                   string
+	Hash string
+
+	// AcceptedTransactionIDs lists only the transactions of the block
+	// that were accepted. Rejected transactions are left out.
 	AcceptedTransactionIDs []string
 }
 
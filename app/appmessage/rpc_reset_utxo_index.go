@@ -0,0 +1,34 @@
+package appmessage
+
+// ResetUTXOIndexRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ResetUTXOIndexRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *ResetUTXOIndexRequestMessage) Command() MessageCommand {
+	return CmdResetUTXOIndexRequestMessage
+}
+
+// NewResetUTXOIndexRequestMessage returns a instance of the message
+func NewResetUTXOIndexRequestMessage() *ResetUTXOIndexRequestMessage {
+	return &ResetUTXOIndexRequestMessage{}
+}
+
+// ResetUTXOIndexResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ResetUTXOIndexResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *ResetUTXOIndexResponseMessage) Command() MessageCommand {
+	return CmdResetUTXOIndexResponseMessage
+}
+
+// NewResetUTXOIndexResponseMessage returns a instance of the message
+func NewResetUTXOIndexResponseMessage() *ResetUTXOIndexResponseMessage {
+	return &ResetUTXOIndexResponseMessage{}
+}
@@ -9,6 +9,7 @@ import (
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
 
 	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus"
 
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed"
+	"github.com/kaspanet/kaspad/infrastructure/network/natmapping"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
 	"github.com/kaspanet/kaspad/util/panics"
 )
@@ -32,6 +34,10 @@ type ComponentManager struct {
 	rpcManager        *rpc.Manager
 	connectionManager *connmanager.ConnectionManager
 	netAdapter        *netadapter.NetAdapter
+	natManager        *natmapping.Manager
+	database          infrastructuredatabase.Database
+
+	databaseCompactionScheduler *databaseCompactionScheduler
 
 	started, shutdown int32
 }
@@ -50,6 +56,17 @@ func (a *ComponentManager) Start() {
 		panics.Exit(log, fmt.Sprintf("Error starting the net adapter: %+v", err))
 	}
 
+	if a.natManager != nil {
+		a.natManager.Start()
+	}
+
+	if a.databaseCompactionScheduler != nil {
+		err := a.databaseCompactionScheduler.start()
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("Error starting the database compaction scheduler: %+v", err))
+		}
+	}
+
 	a.maybeSeedFromDNS()
 
 	a.connectionManager.Start()
@@ -67,11 +84,24 @@ func (a *ComponentManager) Stop() {
 
 	a.connectionManager.Stop()
 
+	if a.natManager != nil {
+		a.natManager.Stop()
+	}
+
+	if a.databaseCompactionScheduler != nil {
+		a.databaseCompactionScheduler.stop()
+	}
+
 	err := a.netAdapter.Stop()
 	if err != nil {
 		log.Errorf("Error stopping the net adapter: %+v", err)
 	}
 
+	err = markCleanShutdown(a.database)
+	if err != nil {
+		log.Errorf("Error marking the shutdown as clean: %+v", err)
+	}
+
 	return
 }
 
@@ -80,11 +110,28 @@ func (a *ComponentManager) Stop() {
 func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database, interrupt chan<- struct{}) (
 	*ComponentManager, error) {
 
-	domain, err := domain.New(cfg.ActiveNetParams, db, cfg.IsArchivalNode)
+	cacheSizes, _ := consensus.CacheSizesForBudget(cfg.ActiveNetParams, cfg.CacheSizeMiB)
+	domain, err := domain.New(cfg.ActiveNetParams, db, cfg.IsArchivalNode, cacheSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	// The integrity check must happen before anything reads the UTXO set,
+	// like the UTXO index, and before the node starts serving peers and RPC
+	err = verifyDatabaseIntegrity(cfg, db, domain.Consensus())
 	if err != nil {
 		return nil, err
 	}
 
+	// The UTXO index is reset when the virtual changes, so the pruning
+	// point has to be imported before it starts
+	if cfg.ImportPruningPoint != "" {
+		err = importPruningPoint(cfg, domain.Consensus())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	netAdapter, err := netadapter.NewNetAdapter(cfg)
 	if err != nil {
 		return nil, err
@@ -95,6 +142,16 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		return nil, err
 	}
 
+	// Port mapping is only useful when listening, and when the external
+	// addresses were not specified explicitly
+	var natManager *natmapping.Manager
+	if cfg.Upnp && len(cfg.ExternalIPs) == 0 && !cfg.DisableListen {
+		natManager, err = natmapping.New(cfg, addressManager)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var utxoIndex *utxoindex.UTXOIndex
 	if cfg.UTXOIndex {
 		utxoIndex, err = utxoindex.New(domain.Consensus(), db)
@@ -103,6 +160,13 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		}
 
 		log.Infof("UTXO index started")
+
+		if cfg.ReindexUTXOIndex {
+			err = utxoIndex.Rebuild()
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
@@ -113,15 +177,23 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, interrupt)
+	rpcManager := setupRPC(cfg, domain, db, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, interrupt)
+
+	var databaseCompactionScheduler *databaseCompactionScheduler
+	if cfg.DbAutoCompact {
+		databaseCompactionScheduler = newDatabaseCompactionScheduler(db, domain.Consensus())
+	}
 
 	return &ComponentManager{
-		cfg:               cfg,
-		protocolManager:   protocolManager,
-		rpcManager:        rpcManager,
-		connectionManager: connectionManager,
-		netAdapter:        netAdapter,
-		addressManager:    addressManager,
+		cfg:                         cfg,
+		protocolManager:             protocolManager,
+		rpcManager:                  rpcManager,
+		connectionManager:           connectionManager,
+		netAdapter:                  netAdapter,
+		natManager:                  natManager,
+		addressManager:              addressManager,
+		database:                    db,
+		databaseCompactionScheduler: databaseCompactionScheduler,
 	}, nil
 
 }
@@ -129,6 +201,7 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 func setupRPC(
 	cfg *config.Config,
 	domain domain.Domain,
+	db infrastructuredatabase.Database,
 	netAdapter *netadapter.NetAdapter,
 	protocolManager *protocol.Manager,
 	connectionManager *connmanager.ConnectionManager,
@@ -140,6 +213,7 @@ func setupRPC(
 	rpcManager := rpc.NewManager(
 		cfg,
 		domain,
+		db,
 		netAdapter,
 		protocolManager,
 		connectionManager,
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// databaseCompactionCheckInterval is how often the database compaction
+// scheduler checks whether the pruning point moved
+const databaseCompactionCheckInterval = time.Minute
+
+// databaseCompactionScheduler compacts the database whenever the pruning
+// point moves. Moving the pruning point deletes the blocks in its past,
+// and compacting right after reclaims their disk space.
+type databaseCompactionScheduler struct {
+	database  database.Database
+	consensus externalapi.Consensus
+	quit      chan struct{}
+}
+
+func newDatabaseCompactionScheduler(db database.Database,
+	consensus externalapi.Consensus) *databaseCompactionScheduler {
+
+	return &databaseCompactionScheduler{
+		database:  db,
+		consensus: consensus,
+		quit:      make(chan struct{}),
+	}
+}
+
+func (s *databaseCompactionScheduler) start() error {
+	lastPruningPoint, err := s.consensus.PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	spawn("databaseCompactionScheduler", func() {
+		ticker := time.NewTicker(databaseCompactionCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.quit:
+				return
+			case <-ticker.C:
+			}
+
+			pruningPoint, err := s.consensus.PruningPoint()
+			if err != nil {
+				log.Errorf("Error getting the pruning point: %s", err)
+				continue
+			}
+			if pruningPoint.Equal(lastPruningPoint) {
+				continue
+			}
+			// A failed compaction is only retried after the next
+			// move, so that it isn't retried every interval
+			lastPruningPoint = pruningPoint
+
+			log.Infof("The pruning point moved to %s. Compacting the database", pruningPoint)
+			err = s.database.Compact()
+			if err != nil {
+				log.Errorf("Error compacting the database: %s", err)
+			}
+		}
+	})
+	return nil
+}
+
+// stop stops the scheduler. A compaction that is already
+// running is not waited for.
+func (s *databaseCompactionScheduler) stop() {
+	close(s.quit)
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// cleanShutdownKey is stored when kaspad shuts down cleanly, and removed
+// once it starts, so that its absence on startup means that the previous
+// run was interrupted
+var cleanShutdownKey = database.MakeBucket([]byte("kaspad-state")).Key([]byte("clean-shutdown"))
+
+// verifyDatabaseIntegrity verifies the consensus state, and repairs it if
+// possible, if kaspad wasn't shut down cleanly or if --checkdb was given
+func verifyDatabaseIntegrity(cfg *config.Config, db database.Database, consensus externalapi.Consensus) error {
+	wasShutDownCleanly, err := db.Has(cleanShutdownKey)
+	if err != nil {
+		return err
+	}
+
+	if !wasShutDownCleanly || cfg.CheckDatabase {
+		if !wasShutDownCleanly {
+			log.Infof("No record of a clean shutdown was found. Verifying the database integrity")
+		} else {
+			log.Infof("Verifying the database integrity")
+		}
+		err := consensus.VerifyAndRepairVirtualState()
+		if err != nil {
+			return errors.Wrapf(err, "the database is inconsistent and could not be repaired. "+
+				"Restart kaspad with --reset-db to resync from scratch")
+		}
+	}
+
+	return db.Delete(cleanShutdownKey)
+}
+
+// markCleanShutdown records that kaspad was shut down cleanly
+func markCleanShutdown(db database.Database) error {
+	return db.Put(cleanShutdownKey, []byte{})
+}
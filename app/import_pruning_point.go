@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/pruningpointfile"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/pkg/errors"
+)
+
+// importPruningPoint bootstraps the given consensus from the pruning point
+// file given in --import-pruning-point. Restarting kaspad with the same
+// flag is harmless: a pruning point that was already imported is skipped.
+func importPruningPoint(cfg *config.Config, consensus externalapi.Consensus) error {
+	log.Infof("Importing the pruning point from %s", cfg.ImportPruningPoint)
+	_, err := pruningpointfile.Import(consensus, cfg.ActiveNetParams, cfg.ImportPruningPoint)
+	if err != nil {
+		if errors.Is(err, pruningpointfile.ErrPruningPointAlreadyImported) {
+			log.Infof("Skipping the import of %s: %s", cfg.ImportPruningPoint, err)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to import the pruning point from %s", cfg.ImportPruningPoint)
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ package app
 
 import (
 	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/util/panics"
 )
 
 var log = logger.RegisterSubSystem("KASD")
+var spawn = panics.GoroutineWrapperFunc(log)
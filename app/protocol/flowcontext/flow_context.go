@@ -59,6 +59,9 @@ type FlowContext struct {
 	peers      map[id.ID]*peerpkg.Peer
 	peersMutex sync.RWMutex
 
+	banScores      map[string]*peerpkg.BanScore
+	banScoresMutex sync.Mutex
+
 	orphans      map[externalapi.DomainHash]*externalapi.DomainBlock
 	orphansMutex sync.RWMutex
 }
@@ -76,6 +79,7 @@ func New(cfg *config.Config, domain domain.Domain, addressManager *addressmanage
 		sharedRequestedTransactions: transactionrelay.NewSharedRequestedTransactions(),
 		sharedRequestedBlocks:       blockrelay.NewSharedRequestedBlocks(),
 		peers:                       make(map[id.ID]*peerpkg.Peer),
+		banScores:                   make(map[string]*peerpkg.BanScore),
 		transactionsToRebroadcast:   make(map[externalapi.DomainTransactionID]*externalapi.DomainTransaction),
 		orphans:                     make(map[externalapi.DomainHash]*externalapi.DomainBlock),
 		timeStarted:                 mstime.Now().UnixMilliseconds(),
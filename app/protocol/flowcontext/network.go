@@ -7,6 +7,7 @@ import (
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
 	"github.com/pkg/errors"
+	"time"
 )
 
 // NetAdapter returns the net adapter that is associated to the flow context.
@@ -41,6 +42,46 @@ func (f *FlowContext) RemoveFromPeers(peer *peerpkg.Peer) {
 	delete(f.peers, *peer.ID())
 }
 
+// BanScore returns the ban score of the peer at the other end of the given
+// connection. Ban scores are kept per host, so that they survive reconnections
+// and are shared between all the connections from the same host.
+func (f *FlowContext) BanScore(netConnection *netadapter.NetConnection) *peerpkg.BanScore {
+	f.banScoresMutex.Lock()
+	defer f.banScoresMutex.Unlock()
+
+	f.removeDecayedBanScores()
+
+	host := netConnection.NetAddress().Host()
+	banScore, ok := f.banScores[host]
+	if !ok {
+		banScore = peerpkg.NewBanScore()
+		f.banScores[host] = banScore
+	}
+	return banScore
+}
+
+// removeDecayedBanScores forgets the ban scores that have fully decayed,
+// unless they still belong to a ready peer.
+// This function is not safe for concurrent use, and must be called
+// while holding banScoresMutex
+func (f *FlowContext) removeDecayedBanScores() {
+	f.peersMutex.RLock()
+	defer f.peersMutex.RUnlock()
+
+	banScoresInUse := make(map[*peerpkg.BanScore]struct{}, len(f.peers))
+	for _, peer := range f.peers {
+		banScoresInUse[peer.BanScore()] = struct{}{}
+	}
+	for host, banScore := range f.banScores {
+		if _, ok := banScoresInUse[banScore]; ok {
+			continue
+		}
+		if banScore.Value() == 0 {
+			delete(f.banScores, host)
+		}
+	}
+}
+
 // readyPeerConnections returns the NetConnections of all the ready peers.
 func (f *FlowContext) readyPeerConnections() []*netadapter.NetConnection {
 	f.peersMutex.RLock()
@@ -72,3 +113,21 @@ func (f *FlowContext) Peers() []*peerpkg.Peer {
 	}
 	return peers
 }
+
+// PeerEvictionInfos returns the eviction info of all the ready peers, by the
+// address of their connection
+func (f *FlowContext) PeerEvictionInfos() map[string]*connmanager.PeerEvictionInfo {
+	f.peersMutex.RLock()
+	defer f.peersMutex.RUnlock()
+
+	peerEvictionInfos := make(map[string]*connmanager.PeerEvictionInfo, len(f.peers))
+	for _, peer := range f.peers {
+		peerEvictionInfos[peer.Address()] = &connmanager.PeerEvictionInfo{
+			ConnectedAt:         time.Now().Add(-peer.TimeConnected()),
+			LastPingDuration:    peer.LastPingDuration(),
+			LastBlockTime:       peer.LastBlockTime(),
+			LastTransactionTime: peer.LastTransactionTime(),
+		}
+	}
+	return peerEvictionInfos
+}
@@ -0,0 +1,149 @@
+package blockrelay
+
+import (
+	"encoding/binary"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/hashes"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/merkle"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionhelper"
+)
+
+// shortTransactionID returns the short ID of the transaction with the given ID
+// within the compact block with the given hash. Since short IDs are keyed by
+// the block hash, a transaction that collides with another in one block is
+// not expected to collide with it in any other.
+func shortTransactionID(blockHash *externalapi.DomainHash, transactionID *externalapi.DomainTransactionID) uint64 {
+	writer := hashes.NewShortTransactionIDWriter()
+	writer.InfallibleWrite(blockHash.ByteSlice())
+	writer.InfallibleWrite(transactionID.ByteSlice())
+	return binary.LittleEndian.Uint64(writer.Finalize().ByteSlice())
+}
+
+// domainBlockToMsgCompactBlock converts the given block to a compact block
+// in which only the coinbase transaction is prefilled
+func domainBlockToMsgCompactBlock(block *externalapi.DomainBlock) *appmessage.MsgCompactBlock {
+	blockHash := consensushashing.BlockHash(block)
+
+	prefilledTransactions := []*appmessage.PrefilledTransaction{{
+		Index:       transactionhelper.CoinbaseTransactionIndex,
+		Transaction: appmessage.DomainTransactionToMsgTx(block.Transactions[transactionhelper.CoinbaseTransactionIndex]),
+	}}
+	shortIDs := make([]uint64, 0, len(block.Transactions)-1)
+	for _, transaction := range block.Transactions[transactionhelper.CoinbaseTransactionIndex+1:] {
+		shortIDs = append(shortIDs, shortTransactionID(blockHash, consensushashing.TransactionID(transaction)))
+	}
+
+	return appmessage.NewMsgCompactBlock(appmessage.DomainBlockHeaderToBlockHeader(block.Header),
+		shortIDs, prefilledTransactions)
+}
+
+// partialBlock is a block that is being reconstructed from a compact block
+type partialBlock struct {
+	hash           *externalapi.DomainHash
+	header         externalapi.BlockHeader
+	transactions   []*externalapi.DomainTransaction
+	missingIndexes []uint32
+}
+
+// newPartialBlock fills the transactions of the given compact block with its
+// prefilled transactions and with the matching transactions out of the given
+// mempool transactions. The indexes of the transactions that could not be
+// filled are kept in missingIndexes.
+func newPartialBlock(compactBlock *appmessage.MsgCompactBlock,
+	mempoolTransactions []*externalapi.DomainTransaction) (*partialBlock, error) {
+
+	header := appmessage.BlockHeaderToDomainBlockHeader(&compactBlock.Header)
+	hash := consensushashing.HeaderHash(header)
+
+	prefilledTransactions := compactBlock.PrefilledTransactions
+	if len(prefilledTransactions) == 0 ||
+		prefilledTransactions[0].Index != transactionhelper.CoinbaseTransactionIndex {
+
+		return nil, protocolerrors.Errorf(true, "compact block %s is missing its coinbase transaction", hash)
+	}
+
+	transactionCount := compactBlock.TransactionCount()
+	transactions := make([]*externalapi.DomainTransaction, transactionCount)
+	for i, prefilledTransaction := range prefilledTransactions {
+		if int(prefilledTransaction.Index) >= transactionCount ||
+			(i > 0 && prefilledTransaction.Index <= prefilledTransactions[i-1].Index) {
+
+			return nil, protocolerrors.Errorf(true, "compact block %s has a prefilled transaction "+
+				"with an invalid index %d", hash, prefilledTransaction.Index)
+		}
+		transactions[prefilledTransaction.Index] = appmessage.MsgTxToDomainTransaction(prefilledTransaction.Transaction)
+	}
+
+	// Mempool transactions that share a short ID can't be told apart,
+	// so they're treated as missing
+	mempoolTransactionsByShortID := make(map[uint64]*externalapi.DomainTransaction, len(mempoolTransactions))
+	for _, transaction := range mempoolTransactions {
+		shortID := shortTransactionID(hash, consensushashing.TransactionID(transaction))
+		if _, ok := mempoolTransactionsByShortID[shortID]; ok {
+			mempoolTransactionsByShortID[shortID] = nil
+			continue
+		}
+		mempoolTransactionsByShortID[shortID] = transaction
+	}
+
+	var missingIndexes []uint32
+	shortIDIndex := 0
+	for i := range transactions {
+		if transactions[i] != nil {
+			continue
+		}
+		transaction := mempoolTransactionsByShortID[compactBlock.ShortIDs[shortIDIndex]]
+		shortIDIndex++
+		if transaction == nil {
+			missingIndexes = append(missingIndexes, uint32(i))
+			continue
+		}
+		// The mempool's transaction must not be modified when the block
+		// is validated, and the UTXO entries it was populated with must
+		// not be sent to consensus
+		transaction = transaction.Clone()
+		for _, input := range transaction.Inputs {
+			input.UTXOEntry = nil
+		}
+		transactions[i] = transaction
+	}
+
+	return &partialBlock{
+		hash:           hash,
+		header:         header,
+		transactions:   transactions,
+		missingIndexes: missingIndexes,
+	}, nil
+}
+
+// fillMissingTransactions fills the missing transactions of the block with the
+// given transactions, which are expected to be in the order of missingIndexes
+func (pb *partialBlock) fillMissingTransactions(transactions []*appmessage.MsgTx) error {
+	if len(transactions) != len(pb.missingIndexes) {
+		return protocolerrors.Errorf(true, "got %d transactions of block %s while "+
+			"expecting %d", len(transactions), pb.hash, len(pb.missingIndexes))
+	}
+	for i, index := range pb.missingIndexes {
+		pb.transactions[index] = appmessage.MsgTxToDomainTransaction(transactions[i])
+	}
+	pb.missingIndexes = nil
+	return nil
+}
+
+// hasValidMerkleRoot returns whether the transactions of the block match the
+// merkle root in its header. A mismatch is expected whenever a short ID
+// collided with that of an unrelated mempool transaction.
+func (pb *partialBlock) hasValidMerkleRoot() bool {
+	return merkle.CalculateHashMerkleRoot(pb.transactions).Equal(pb.header.HashMerkleRoot())
+}
+
+func (pb *partialBlock) block() *externalapi.DomainBlock {
+	return &externalapi.DomainBlock{
+		Header:       pb.header,
+		Transactions: pb.transactions,
+	}
+}
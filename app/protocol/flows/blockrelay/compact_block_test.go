@@ -0,0 +1,177 @@
+package blockrelay
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/blockheader"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/merkle"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+)
+
+func testTransaction(value uint64) *externalapi.DomainTransaction {
+	txIn := appmessage.NewTxIn(appmessage.NewOutpoint(&externalapi.DomainTransactionID{}, 0), nil, 0)
+	txOut := appmessage.NewTxOut(value, &externalapi.ScriptPublicKey{Script: []byte{}, Version: 0})
+	return appmessage.MsgTxToDomainTransaction(
+		appmessage.NewNativeMsgTx(0, []*appmessage.TxIn{txIn}, []*appmessage.TxOut{txOut}))
+}
+
+func testBlock(transactionCount int) *externalapi.DomainBlock {
+	txOut := appmessage.NewTxOut(1, &externalapi.ScriptPublicKey{Script: []byte{}, Version: 0})
+	coinbase := appmessage.MsgTxToDomainTransaction(appmessage.NewSubnetworkMsgTx(0, nil,
+		[]*appmessage.TxOut{txOut}, &subnetworks.SubnetworkIDCoinbase, 0, []byte{1, 2, 3}))
+
+	transactions := []*externalapi.DomainTransaction{coinbase}
+	for i := 1; i < transactionCount; i++ {
+		transactions = append(transactions, testTransaction(uint64(i)))
+	}
+
+	return &externalapi.DomainBlock{
+		Header: blockheader.NewImmutableBlockHeader(
+			constants.MaxBlockVersion,
+			[]*externalapi.DomainHash{externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1})},
+			merkle.CalculateHashMerkleRoot(transactions),
+			&externalapi.DomainHash{},
+			&externalapi.DomainHash{},
+			0,
+			0,
+			0,
+		),
+		Transactions: transactions,
+	}
+}
+
+func TestCompactBlockReconstruction(t *testing.T) {
+	block := testBlock(5)
+	blockHash := consensushashing.BlockHash(block)
+	compactBlock := domainBlockToMsgCompactBlock(block)
+
+	if len(compactBlock.PrefilledTransactions) != 1 || len(compactBlock.ShortIDs) != 4 {
+		t.Fatalf("Expected 1 prefilled transaction and 4 short IDs, got %d and %d",
+			len(compactBlock.PrefilledTransactions), len(compactBlock.ShortIDs))
+	}
+
+	// Transactions 2 and 4 are missing from the mempool, which also
+	// contains a transaction that isn't in the block
+	mempoolTransaction := block.Transactions[1].Clone()
+	mempoolTransaction.Inputs[0].UTXOEntry = utxo.NewUTXOEntry(1, &externalapi.ScriptPublicKey{}, false, 0)
+	mempoolTransactions := []*externalapi.DomainTransaction{
+		mempoolTransaction, block.Transactions[3], testTransaction(100),
+	}
+	partialBlock, err := newPartialBlock(compactBlock, mempoolTransactions)
+	if err != nil {
+		t.Fatalf("newPartialBlock: %s", err)
+	}
+	if !partialBlock.hash.Equal(blockHash) {
+		t.Fatalf("Unexpected block hash. Want: %s, got: %s", blockHash, partialBlock.hash)
+	}
+	if len(partialBlock.missingIndexes) != 2 || partialBlock.missingIndexes[0] != 2 ||
+		partialBlock.missingIndexes[1] != 4 {
+
+		t.Fatalf("Unexpected missing indexes %v", partialBlock.missingIndexes)
+	}
+
+	err = partialBlock.fillMissingTransactions([]*appmessage.MsgTx{
+		appmessage.DomainTransactionToMsgTx(block.Transactions[2]),
+	})
+	if err == nil {
+		t.Fatalf("Expected an error when filling too few missing transactions")
+	}
+
+	err = partialBlock.fillMissingTransactions([]*appmessage.MsgTx{
+		appmessage.DomainTransactionToMsgTx(block.Transactions[2]),
+		appmessage.DomainTransactionToMsgTx(block.Transactions[4]),
+	})
+	if err != nil {
+		t.Fatalf("fillMissingTransactions: %s", err)
+	}
+	if !partialBlock.hasValidMerkleRoot() {
+		t.Fatalf("Expected the reconstructed block to have a valid merkle root")
+	}
+	if !consensushashing.BlockHash(partialBlock.block()).Equal(blockHash) {
+		t.Fatalf("Unexpected reconstructed block hash")
+	}
+	if partialBlock.transactions[1] == mempoolTransaction {
+		t.Fatalf("Expected the mempool transaction to be copied into the reconstructed block")
+	}
+	if partialBlock.transactions[1].Inputs[0].UTXOEntry != nil {
+		t.Fatalf("Expected the UTXO entries of the mempool transaction to be cleared")
+	}
+}
+
+func TestCompactBlockWrongTransactions(t *testing.T) {
+	block := testBlock(3)
+	compactBlock := domainBlockToMsgCompactBlock(block)
+
+	partialBlock, err := newPartialBlock(compactBlock, nil)
+	if err != nil {
+		t.Fatalf("newPartialBlock: %s", err)
+	}
+	err = partialBlock.fillMissingTransactions([]*appmessage.MsgTx{
+		appmessage.DomainTransactionToMsgTx(block.Transactions[1]),
+		appmessage.DomainTransactionToMsgTx(testTransaction(100)),
+	})
+	if err != nil {
+		t.Fatalf("fillMissingTransactions: %s", err)
+	}
+	if partialBlock.hasValidMerkleRoot() {
+		t.Fatalf("Expected a block with a wrong transaction to have an invalid merkle root")
+	}
+}
+
+func TestMalformedCompactBlock(t *testing.T) {
+	block := testBlock(3)
+
+	tests := []struct {
+		name   string
+		modify func(compactBlock *appmessage.MsgCompactBlock)
+	}{
+		{
+			name: "no prefilled transactions",
+			modify: func(compactBlock *appmessage.MsgCompactBlock) {
+				compactBlock.PrefilledTransactions = nil
+			},
+		},
+		{
+			name: "coinbase is not prefilled",
+			modify: func(compactBlock *appmessage.MsgCompactBlock) {
+				compactBlock.PrefilledTransactions[0].Index = 1
+			},
+		},
+		{
+			name: "prefilled transaction index out of range",
+			modify: func(compactBlock *appmessage.MsgCompactBlock) {
+				compactBlock.PrefilledTransactions = append(compactBlock.PrefilledTransactions,
+					&appmessage.PrefilledTransaction{
+						Index:       3,
+						Transaction: appmessage.DomainTransactionToMsgTx(block.Transactions[1]),
+					})
+				compactBlock.ShortIDs = compactBlock.ShortIDs[1:]
+			},
+		},
+		{
+			name: "prefilled transactions out of order",
+			modify: func(compactBlock *appmessage.MsgCompactBlock) {
+				compactBlock.PrefilledTransactions = append(compactBlock.PrefilledTransactions,
+					&appmessage.PrefilledTransaction{
+						Index:       0,
+						Transaction: appmessage.DomainTransactionToMsgTx(block.Transactions[1]),
+					})
+				compactBlock.ShortIDs = compactBlock.ShortIDs[1:]
+			},
+		},
+	}
+
+	for _, test := range tests {
+		compactBlock := domainBlockToMsgCompactBlock(block)
+		test.modify(compactBlock)
+		_, err := newPartialBlock(compactBlock, nil)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
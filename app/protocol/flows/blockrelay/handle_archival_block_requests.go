@@ -0,0 +1,98 @@
+package blockrelay
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleArchivalBlockRequestsContext is the interface for the context needed for the HandleArchivalBlockRequests flow.
+type HandleArchivalBlockRequestsContext interface {
+	Domain() domain.Domain
+}
+
+// HandleArchivalBlockRequests listens to appmessage.MsgRequestArchivalBlocks messages and
+// sends their corresponding blocks, and optionally their acceptance data, to the requesting
+// peer. Unlike HandleIBDBlockRequests, blocks below the pruning point are served as long as
+// their bodies were kept, which is the case on archival nodes. Blocks that aren't available
+// are answered with appmessage.MsgArchivalBlockNotFound, since asking a non-archival node for
+// old history, or asking for a block that isn't stored yet, is a legitimate request.
+func HandleArchivalBlockRequests(context HandleArchivalBlockRequestsContext, incomingRoute *router.Route,
+	outgoingRoute *router.Route) error {
+
+	for {
+		message, err := incomingRoute.Dequeue()
+		if err != nil {
+			return err
+		}
+		msgRequestArchivalBlocks := message.(*appmessage.MsgRequestArchivalBlocks)
+		log.Debugf("Got request for %d archival blocks", len(msgRequestArchivalBlocks.Hashes))
+		for _, hash := range msgRequestArchivalBlocks.Hashes {
+			hasBody, err := context.Domain().Consensus().HasBlockBody(hash)
+			if err != nil {
+				return err
+			}
+			if !hasBody {
+				log.Debugf("Archival block %s not found", hash)
+				err = outgoingRoute.Enqueue(appmessage.NewMsgArchivalBlockNotFound(hash))
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			block, err := context.Domain().Consensus().GetBlock(hash)
+			if err != nil {
+				return errors.Wrapf(err, "unable to fetch requested block hash %s", hash)
+			}
+
+			var acceptanceData []*appmessage.MsgBlockAcceptanceData
+			if msgRequestArchivalBlocks.IncludeAcceptanceData {
+				acceptanceData, err = getArchivalBlockAcceptanceData(context, hash)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = outgoingRoute.Enqueue(appmessage.NewMsgArchivalBlock(appmessage.DomainBlockToMsgBlock(block), acceptanceData))
+			if err != nil {
+				return err
+			}
+		}
+		log.Debugf("Sent %d archival blocks", len(msgRequestArchivalBlocks.Hashes))
+	}
+}
+
+func getArchivalBlockAcceptanceData(context HandleArchivalBlockRequestsContext,
+	hash *externalapi.DomainHash) ([]*appmessage.MsgBlockAcceptanceData, error) {
+
+	acceptanceData, err := context.Domain().Consensus().GetBlockAcceptanceData(hash)
+	if err != nil {
+		// Blocks that were never in the selected parent chain have no acceptance data
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	msgAcceptanceData := make([]*appmessage.MsgBlockAcceptanceData, len(acceptanceData))
+	for i, blockAcceptanceData := range acceptanceData {
+		transactionAcceptanceData := make([]*appmessage.MsgTransactionAcceptanceData,
+			len(blockAcceptanceData.TransactionAcceptanceData))
+		for j, transaction := range blockAcceptanceData.TransactionAcceptanceData {
+			transactionAcceptanceData[j] = &appmessage.MsgTransactionAcceptanceData{
+				TransactionID: consensushashing.TransactionID(transaction.Transaction),
+				Fee:           transaction.Fee,
+				IsAccepted:    transaction.IsAccepted,
+			}
+		}
+		msgAcceptanceData[i] = &appmessage.MsgBlockAcceptanceData{
+			BlockHash:                 blockAcceptanceData.BlockHash,
+			TransactionAcceptanceData: transactionAcceptanceData,
+		}
+	}
+	return msgAcceptanceData, nil
+}
@@ -0,0 +1,85 @@
+package blockrelay
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
+	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// CompactBlockRequestsContext is the interface for the context needed for the HandleCompactBlockRequests flow.
+type CompactBlockRequestsContext interface {
+	Domain() domain.Domain
+}
+
+// HandleCompactBlockRequests listens to appmessage.MsgRequestCompactBlock and
+// appmessage.MsgRequestBlockTransactions messages and sends the requested compact
+// blocks and block transactions to the requesting peer.
+func HandleCompactBlockRequests(context CompactBlockRequestsContext, incomingRoute *router.Route,
+	outgoingRoute *router.Route, peer *peerpkg.Peer) error {
+
+	for {
+		message, err := incomingRoute.Dequeue()
+		if err != nil {
+			return err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.MsgRequestCompactBlock:
+			log.Debugf("Got request for compact block %s", message.Hash)
+			block, err := getRequestedBlock(context, message.Hash)
+			if err != nil {
+				return err
+			}
+
+			err = outgoingRoute.Enqueue(domainBlockToMsgCompactBlock(block))
+			if err != nil {
+				return err
+			}
+			log.Debugf("Relayed compact block %s", message.Hash)
+
+		case *appmessage.MsgRequestBlockTransactions:
+			log.Debugf("Got request for %d transactions of block %s", len(message.Indexes), message.BlockHash)
+			block, err := getRequestedBlock(context, message.BlockHash)
+			if err != nil {
+				return err
+			}
+
+			transactions := make([]*appmessage.MsgTx, len(message.Indexes))
+			for i, index := range message.Indexes {
+				if int(index) >= len(block.Transactions) {
+					return protocolerrors.Errorf(true, "requested transaction index %d of block %s "+
+						"is out of range", index, message.BlockHash)
+				}
+				transactions[i] = appmessage.DomainTransactionToMsgTx(block.Transactions[index])
+			}
+
+			err = outgoingRoute.Enqueue(appmessage.NewMsgBlockTransactions(message.BlockHash, transactions))
+			if err != nil {
+				return err
+			}
+
+		default:
+			return protocolerrors.Errorf(true, "unexpected %s message in the HandleCompactBlockRequests flow",
+				message.Command())
+		}
+	}
+}
+
+func getRequestedBlock(context CompactBlockRequestsContext, hash *externalapi.DomainHash) (*externalapi.DomainBlock, error) {
+	blockInfo, err := context.Domain().Consensus().GetBlockInfo(hash)
+	if err != nil {
+		return nil, err
+	}
+	if !blockInfo.Exists || blockInfo.BlockStatus == externalapi.StatusHeaderOnly {
+		return nil, protocolerrors.Errorf(true, "block %s not found", hash)
+	}
+	block, err := context.Domain().Consensus().GetBlock(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to fetch requested block hash %s", hash)
+	}
+	return block, nil
+}
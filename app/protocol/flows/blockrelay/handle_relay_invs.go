@@ -76,8 +76,8 @@ func (flow *handleRelayInvsFlow) start() error {
 		}
 		if blockInfo.Exists && blockInfo.BlockStatus != externalapi.StatusHeaderOnly {
 			if blockInfo.BlockStatus == externalapi.StatusInvalid {
-				return protocolerrors.Errorf(true, "sent inv of an invalid block %s",
-					inv.Hash)
+				return protocolerrors.ErrorfWithBanScore(protocolerrors.BanScoreInvalidBlock,
+					"sent inv of an invalid block %s", inv.Hash)
 			}
 			log.Debugf("Block %s already exists. continuing...", inv.Hash)
 			continue
@@ -144,6 +144,7 @@ func (flow *handleRelayInvsFlow) start() error {
 			return err
 		}
 		log.Infof("Accepted block %s via relay", inv.Hash)
+		flow.peer.MarkBlockRelayed()
 		err = flow.OnNewBlock(block, blockInsertionResult)
 		if err != nil {
 			return err
@@ -190,44 +191,138 @@ func (flow *handleRelayInvsFlow) requestBlock(requestHash *externalapi.DomainHas
 	// clean from any pending blocks.
 	defer flow.SharedRequestedBlocks().remove(requestHash)
 
+	if flow.peer.ProtocolVersion() >= appmessage.CompactBlocksProtocolVersion {
+		block, err := flow.requestCompactBlock(requestHash)
+		if err != nil {
+			return nil, false, err
+		}
+		if block != nil {
+			return block, false, nil
+		}
+		log.Debugf("Compact block %s could not be reconstructed. Requesting it in full", requestHash)
+	}
+
+	block, err := flow.requestFullBlock(requestHash)
+	if err != nil {
+		return nil, false, err
+	}
+	return block, false, nil
+}
+
+func (flow *handleRelayInvsFlow) requestFullBlock(requestHash *externalapi.DomainHash) (*externalapi.DomainBlock, error) {
 	getRelayBlocksMsg := appmessage.NewMsgRequestRelayBlocks([]*externalapi.DomainHash{requestHash})
 	err := flow.outgoingRoute.Enqueue(getRelayBlocksMsg)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
 	msgBlock, err := flow.readMsgBlock()
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
 	block := appmessage.MsgBlockToDomainBlock(msgBlock)
 	blockHash := consensushashing.BlockHash(block)
 	if !blockHash.Equal(requestHash) {
-		return nil, false, protocolerrors.Errorf(true, "got unrequested block %s", blockHash)
+		return nil, protocolerrors.ErrorfWithBanScore(protocolerrors.BanScoreUnrequestedMessage,
+			"got unrequested block %s", blockHash)
 	}
 
-	return block, false, nil
+	return block, nil
+}
+
+// requestCompactBlock requests the compact form of the block with the given hash, and
+// reconstructs the block out of it and out of the mempool, requesting any transactions
+// that are missing from the mempool. It returns nil if the reconstructed block doesn't
+// match its header, in which case the block should be requested in full.
+func (flow *handleRelayInvsFlow) requestCompactBlock(requestHash *externalapi.DomainHash) (*externalapi.DomainBlock, error) {
+	err := flow.outgoingRoute.Enqueue(appmessage.NewMsgRequestCompactBlock(requestHash))
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := flow.readNonInvMessage()
+	if err != nil {
+		return nil, err
+	}
+	compactBlock, ok := message.(*appmessage.MsgCompactBlock)
+	if !ok {
+		return nil, protocolerrors.Errorf(true, "unexpected %s message while expecting "+
+			"a compact block", message.Command())
+	}
+
+	partialBlock, err := newPartialBlock(compactBlock, flow.Domain().MiningManager().AllTransactions())
+	if err != nil {
+		return nil, err
+	}
+	if !partialBlock.hash.Equal(requestHash) {
+		return nil, protocolerrors.ErrorfWithBanScore(protocolerrors.BanScoreUnrequestedMessage,
+			"got unrequested compact block %s", partialBlock.hash)
+	}
+
+	if len(partialBlock.missingIndexes) > 0 {
+		log.Debugf("Requesting %d out of %d transactions of compact block %s", len(partialBlock.missingIndexes),
+			len(partialBlock.transactions), requestHash)
+		err := flow.outgoingRoute.Enqueue(
+			appmessage.NewMsgRequestBlockTransactions(requestHash, partialBlock.missingIndexes))
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := flow.readNonInvMessage()
+		if err != nil {
+			return nil, err
+		}
+		blockTransactions, ok := message.(*appmessage.MsgBlockTransactions)
+		if !ok {
+			return nil, protocolerrors.Errorf(true, "unexpected %s message while expecting "+
+				"block transactions", message.Command())
+		}
+		if !blockTransactions.BlockHash.Equal(requestHash) {
+			return nil, protocolerrors.ErrorfWithBanScore(protocolerrors.BanScoreUnrequestedMessage,
+				"got transactions of unrequested block %s",
+				blockTransactions.BlockHash)
+		}
+		err = partialBlock.fillMissingTransactions(blockTransactions.Transactions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !partialBlock.hasValidMerkleRoot() {
+		return nil, nil
+	}
+	return partialBlock.block(), nil
 }
 
 // readMsgBlock returns the next msgBlock in msgChan, and populates invsQueue with any inv messages that meanwhile arrive.
-//
-// Note: this function assumes msgChan can contain only appmessage.MsgInvRelayBlock and appmessage.MsgBlock messages.
 func (flow *handleRelayInvsFlow) readMsgBlock() (msgBlock *appmessage.MsgBlock, err error) {
+	message, err := flow.readNonInvMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	msgBlock, ok := message.(*appmessage.MsgBlock)
+	if !ok {
+		return nil, errors.Errorf("unexpected message %s", message.Command())
+	}
+	return msgBlock, nil
+}
+
+// readNonInvMessage returns the next message in msgChan that isn't an inv, and populates invsQueue
+// with any inv messages that meanwhile arrive.
+func (flow *handleRelayInvsFlow) readNonInvMessage() (appmessage.Message, error) {
 	for {
 		message, err := flow.incomingRoute.DequeueWithTimeout(common.DefaultTimeout)
 		if err != nil {
 			return nil, err
 		}
 
-		switch message := message.(type) {
-		case *appmessage.MsgInvRelayBlock:
-			flow.invsQueue = append(flow.invsQueue, message)
-		case *appmessage.MsgBlock:
-			return message, nil
-		default:
-			return nil, errors.Errorf("unexpected message %s", message.Command())
+		if inv, ok := message.(*appmessage.MsgInvRelayBlock); ok {
+			flow.invsQueue = append(flow.invsQueue, inv)
+			continue
 		}
+		return message, nil
 	}
 }
 
@@ -244,7 +339,8 @@ func (flow *handleRelayInvsFlow) processBlock(block *externalapi.DomainBlock) ([
 			return missingParentsError.MissingParentHashes, nil, nil
 		}
 		log.Warnf("Rejected block %s from %s: %s", blockHash, flow.peer, err)
-		return nil, nil, protocolerrors.Wrapf(true, err, "got invalid block %s from relay", blockHash)
+		return nil, nil, protocolerrors.WrapfWithBanScore(protocolerrors.BanScoreInvalidBlock, err,
+			"got invalid block %s from relay", blockHash)
 	}
 	return nil, blockInsertionResult, nil
 }
@@ -25,6 +25,7 @@ type HandleHandshakeContext interface {
 	Domain() domain.Domain
 	AddressManager() *addressmanager.AddressManager
 	AddToPeers(peer *peerpkg.Peer) error
+	BanScore(netConnection *netadapter.NetConnection) *peerpkg.BanScore
 	HandleError(err error, flowName string, isStopping *uint32, errChan chan<- error)
 }
 
@@ -45,7 +46,7 @@ func HandleHandshake(context HandleHandshakeContext, netConnection *netadapter.N
 	isStopping := uint32(0)
 	errChan := make(chan error)
 
-	peer := peerpkg.New(netConnection)
+	peer := peerpkg.New(netConnection, context.BanScore(netConnection))
 
 	var peerAddress *appmessage.NetAddress
 	spawn("HandleHandshake-ReceiveVersion", func() {
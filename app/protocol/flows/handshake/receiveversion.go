@@ -16,8 +16,9 @@ var (
 	allowSelfConnections bool
 
 	// minAcceptableProtocolVersion is the lowest protocol version that a
-	// connected peer may support.
-	minAcceptableProtocolVersion = appmessage.ProtocolVersion
+	// connected peer may support. Peers that don't support compact blocks
+	// are still accepted, and get relayed full blocks.
+	minAcceptableProtocolVersion uint32 = 1
 )
 
 type receiveVersionFlow struct {
@@ -62,6 +62,9 @@ func (flow *sendVersionFlow) start() error {
 
 	// Advertise the services flag
 	msg.Services = defaultServices
+	if flow.Config().IsArchivalNode {
+		msg.AddService(appmessage.SFNodeArchival)
+	}
 
 	// Advertise our max supported protocol version.
 	msg.ProtocolVersion = appmessage.ProtocolVersion
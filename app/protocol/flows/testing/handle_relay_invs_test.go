@@ -145,6 +145,18 @@ func (f *fakeRelayInvsContext) Anticone(blockHash *externalapi.DomainHash) ([]*e
 	panic(errors.Errorf("called unimplemented function from test '%s'", f.testName))
 }
 
+func (f *fakeRelayInvsContext) HasBlockBody(blockHash *externalapi.DomainHash) (bool, error) {
+	panic(errors.Errorf("called unimplemented function from test '%s'", f.testName))
+}
+
+func (f *fakeRelayInvsContext) VerifyAndRepairVirtualState() error {
+	panic(errors.Errorf("called unimplemented function from test '%s'", f.testName))
+}
+
+func (f *fakeRelayInvsContext) GetCacheStats() []*externalapi.CacheStats {
+	panic(errors.Errorf("called unimplemented function from test '%s'", f.testName))
+}
+
 func (f *fakeRelayInvsContext) BuildBlock(coinbaseData *externalapi.DomainCoinbaseData, transactions []*externalapi.DomainTransaction) (*externalapi.DomainBlock, error) {
 	panic(errors.Errorf("called unimplemented function from test '%s'", f.testName))
 }
@@ -1507,7 +1519,7 @@ func TestHandleRelayInvs(t *testing.T) {
 
 				incomingRoute := router.NewRoute()
 				outgoingRoute := router.NewRoute()
-				peer := peerpkg.New(nil)
+				peer := peerpkg.New(nil, peerpkg.NewBanScore())
 				errChan := make(chan error)
 				context := &fakeRelayInvsContext{
 					testName:    test.name,
@@ -22,7 +22,7 @@ func TestReceiveAddressesErrors(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, params *dagconfig.Params) {
 		incomingRoute := router.NewRoute()
 		outgoingRoute := router.NewRoute()
-		peer := peerpkg.New(nil)
+		peer := peerpkg.New(nil, peerpkg.NewBanScore())
 		errChan := make(chan error)
 		go func() {
 			errChan <- addressexchange.ReceiveAddresses(fakeReceiveAddressesContext{}, incomingRoute, outgoingRoute, peer)
@@ -3,6 +3,7 @@ package transactionrelay
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/protocol/common"
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
 	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
 	"github.com/kaspanet/kaspad/domain"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
@@ -26,16 +27,20 @@ type TransactionsRelayContext interface {
 type handleRelayedTransactionsFlow struct {
 	TransactionsRelayContext
 	incomingRoute, outgoingRoute *router.Route
+	peer                         *peerpkg.Peer
 	invsQueue                    []*appmessage.MsgInvTransaction
 }
 
 // HandleRelayedTransactions listens to appmessage.MsgInvTransaction messages, requests their corresponding transactions if they
 // are missing, adds them to the mempool and propagates them to the rest of the network.
-func HandleRelayedTransactions(context TransactionsRelayContext, incomingRoute *router.Route, outgoingRoute *router.Route) error {
+func HandleRelayedTransactions(context TransactionsRelayContext, incomingRoute *router.Route, outgoingRoute *router.Route,
+	peer *peerpkg.Peer) error {
+
 	flow := &handleRelayedTransactionsFlow{
 		TransactionsRelayContext: context,
 		incomingRoute:            incomingRoute,
 		outgoingRoute:            outgoingRoute,
+		peer:                     peer,
 		invsQueue:                make([]*appmessage.MsgInvTransaction, 0),
 	}
 	return flow.start()
@@ -193,6 +198,8 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 
 			return protocolerrors.Errorf(true, "rejected transaction %s: %s", txID, ruleErr)
 		}
+		flow.peer.MarkTransactionRelayed()
+
 		err = flow.broadcastAcceptedTransactions([]*externalapi.DomainTransactionID{txID})
 		if err != nil {
 			return err
@@ -29,6 +29,7 @@ func NewManager(cfg *config.Config, domain domain.Domain, netAdapter *netadapter
 	}
 
 	netAdapter.SetP2PRouterInitializer(manager.routerInitializer)
+	connectionManager.SetPeerEvictionInfoProvider(manager.context.PeerEvictionInfos)
 	return &manager, nil
 }
 
@@ -0,0 +1,63 @@
+package peer
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// banScoreHalfLife is the time it takes a ban score to decay to
+// half of its value
+const banScoreHalfLife = 10 * time.Minute
+
+// BanScore is a misbehavior score that decays exponentially over time,
+// so that occasional misbehavior is forgiven while frequent misbehavior
+// adds up until the peer is banned.
+type BanScore struct {
+	lock       sync.Mutex
+	score      float64
+	lastUpdate time.Time
+}
+
+// NewBanScore returns a new BanScore with a score of zero
+func NewBanScore() *BanScore {
+	return &BanScore{}
+}
+
+// Increase decays the score up to the current time, adds the given weight
+// to it, and returns the result
+func (s *BanScore) Increase(weight uint32) uint32 {
+	return s.increaseAt(weight, time.Now())
+}
+
+// Value returns the current, decayed, score
+func (s *BanScore) Value() uint32 {
+	return s.valueAt(time.Now())
+}
+
+func (s *BanScore) increaseAt(weight uint32, now time.Time) uint32 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.score = s.decayedScore(now) + float64(weight)
+	s.lastUpdate = now
+	return uint32(s.score)
+}
+
+func (s *BanScore) valueAt(now time.Time) uint32 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return uint32(s.decayedScore(now))
+}
+
+func (s *BanScore) decayedScore(now time.Time) float64 {
+	if s.score == 0 {
+		return 0
+	}
+	elapsed := now.Sub(s.lastUpdate)
+	if elapsed <= 0 {
+		return s.score
+	}
+	return s.score * math.Pow(0.5, float64(elapsed)/float64(banScoreHalfLife))
+}
@@ -0,0 +1,38 @@
+package peer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBanScore(t *testing.T) {
+	banScore := NewBanScore()
+	start := time.Now()
+
+	if value := banScore.valueAt(start); value != 0 {
+		t.Fatalf("new ban score is %d, want 0", value)
+	}
+
+	if value := banScore.increaseAt(40, start); value != 40 {
+		t.Fatalf("ban score after increase is %d, want 40", value)
+	}
+	if value := banScore.increaseAt(40, start); value != 80 {
+		t.Fatalf("ban score after second increase is %d, want 80", value)
+	}
+
+	if value := banScore.valueAt(start.Add(banScoreHalfLife)); value != 40 {
+		t.Fatalf("ban score after one half-life is %d, want 40", value)
+	}
+	if value := banScore.valueAt(start.Add(2 * banScoreHalfLife)); value != 20 {
+		t.Fatalf("ban score after two half-lives is %d, want 20", value)
+	}
+
+	// Increasing after a half-life should add to the decayed score
+	if value := banScore.increaseAt(10, start.Add(banScoreHalfLife)); value != 50 {
+		t.Fatalf("ban score after decay and increase is %d, want 50", value)
+	}
+
+	if value := banScore.valueAt(start.Add(100 * banScoreHalfLife)); value != 0 {
+		t.Fatalf("ban score after a long time is %d, want 0", value)
+	}
+}
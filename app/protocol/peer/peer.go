@@ -15,7 +15,7 @@ import (
 
 // maxProtocolVersion version is the maximum supported protocol
 // version this kaspad node supports
-const maxProtocolVersion = 1
+const maxProtocolVersion = 2
 
 // Peer holds data about a peer.
 type Peer struct {
@@ -31,17 +31,25 @@ type Peer struct {
 	timeOffset        time.Duration
 	connectionStarted time.Time
 
+	banScore *BanScore
+
 	pingLock         sync.RWMutex
 	lastPingNonce    uint64        // The nonce of the last ping we sent
 	lastPingTime     time.Time     // Time we sent last ping
 	lastPingDuration time.Duration // Time for last ping to return
+
+	relayLock           sync.RWMutex
+	lastBlockTime       time.Time // Time the peer last relayed a block we accepted
+	lastTransactionTime time.Time // Time the peer last relayed a transaction we accepted
 }
 
-// New returns a new Peer
-func New(connection *netadapter.NetConnection) *Peer {
+// New returns a new Peer. The given ban score may be shared with
+// earlier connections of the same peer.
+func New(connection *netadapter.NetConnection, banScore *BanScore) *Peer {
 	return &Peer{
 		connection:        connection,
 		connectionStarted: time.Now(),
+		banScore:          banScore,
 	}
 }
 
@@ -76,6 +84,17 @@ func (p *Peer) AdvertisedProtocolVersion() uint32 {
 	return p.advertisedProtocolVerion
 }
 
+// ProtocolVersion returns the protocol version negotiated with the peer.
+func (p *Peer) ProtocolVersion() uint32 {
+	return p.protocolVersion
+}
+
+// HasService returns whether the peer advertised the given service
+// in its version message.
+func (p *Peer) HasService(service appmessage.ServiceFlag) bool {
+	return p.services&service == service
+}
+
 // TimeConnected returns the time since the connection to this been has been started.
 func (p *Peer) TimeConnected() time.Duration {
 	return time.Since(p.connectionStarted)
@@ -86,6 +105,11 @@ func (p *Peer) IsOutbound() bool {
 	return p.connection.IsOutbound()
 }
 
+// BanScore returns the misbehavior score of the peer
+func (p *Peer) BanScore() *BanScore {
+	return p.banScore
+}
+
 // UpdateFieldsFromMsgVersion updates the peer with the data from the version message.
 func (p *Peer) UpdateFieldsFromMsgVersion(msg *appmessage.MsgVersion) {
 	// Negotiate the protocol version.
@@ -142,3 +166,39 @@ func (p *Peer) LastPingDuration() time.Duration {
 
 	return p.lastPingDuration
 }
+
+// MarkBlockRelayed records that the peer has just relayed a block that was
+// accepted
+func (p *Peer) MarkBlockRelayed() {
+	p.relayLock.Lock()
+	defer p.relayLock.Unlock()
+
+	p.lastBlockTime = time.Now()
+}
+
+// LastBlockTime returns the last time the peer relayed a block that was
+// accepted, or the zero time if it never has
+func (p *Peer) LastBlockTime() time.Time {
+	p.relayLock.RLock()
+	defer p.relayLock.RUnlock()
+
+	return p.lastBlockTime
+}
+
+// MarkTransactionRelayed records that the peer has just relayed a transaction
+// that was accepted to the mempool
+func (p *Peer) MarkTransactionRelayed() {
+	p.relayLock.Lock()
+	defer p.relayLock.Unlock()
+
+	p.lastTransactionTime = time.Now()
+}
+
+// LastTransactionTime returns the last time the peer relayed a transaction
+// that was accepted to the mempool, or the zero time if it never has
+func (p *Peer) LastTransactionTime() time.Time {
+	p.relayLock.RLock()
+	defer p.relayLock.RUnlock()
+
+	return p.lastTransactionTime
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/kaspanet/kaspad/app/protocol/flows/transactionrelay"
 	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
 	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
 	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
@@ -77,6 +78,10 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 		}
 		defer m.context.RemoveFromPeers(peer)
 
+		if !peer.IsOutbound() {
+			m.context.ConnectionManager().NotifyIncomingConnection()
+		}
+
 		removeHandshakeRoutes(router)
 
 		err = m.runFlows(flows, peer, errChan)
@@ -89,18 +94,8 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 
 func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection, outgoingRoute *routerpkg.Route) {
 	if protocolErr := (protocolerrors.ProtocolError{}); errors.As(err, &protocolErr) {
-		if !m.context.Config().DisableBanning && protocolErr.ShouldBan {
-			log.Warnf("Banning %s (reason: %s)", netConnection, protocolErr.Cause)
-
-			err := m.context.ConnectionManager().Ban(netConnection)
-			if !errors.Is(err, connmanager.ErrCannotBanPermanent) {
-				panic(err)
-			}
-
-			err = outgoingRoute.Enqueue(appmessage.NewMsgReject(protocolErr.Error()))
-			if err != nil && !errors.Is(err, routerpkg.ErrRouteClosed) {
-				panic(err)
-			}
+		if protocolErr.ShouldBan {
+			m.increaseBanScore(netConnection, outgoingRoute, protocolErr.BanScore, protocolErr)
 		}
 		log.Infof("Disconnecting from %s (reason: %s)", netConnection, protocolErr.Cause)
 		netConnection.Disconnect()
@@ -108,6 +103,7 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 	}
 	if errors.Is(err, routerpkg.ErrTimeout) {
 		log.Warnf("Got timeout from %s. Disconnecting...", netConnection)
+		m.increaseBanScore(netConnection, outgoingRoute, protocolerrors.BanScoreSlowResponse, err)
 		netConnection.Disconnect()
 		return
 	}
@@ -117,6 +113,31 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 	panic(err)
 }
 
+// increaseBanScore adds the given weight to the ban score of the peer at the
+// other end of the given connection, and bans it if its ban score reached the
+// ban threshold
+func (m *Manager) increaseBanScore(netConnection *netadapter.NetConnection, outgoingRoute *routerpkg.Route,
+	weight uint32, reason error) {
+
+	isWhitelisted := m.context.ConnectionManager().IsWhitelisted(netConnection)
+	banScore, shouldBan := addToBanScore(m.context.Config(), m.context.BanScore(netConnection), isWhitelisted, weight)
+	log.Debugf("The ban score of %s is %d (reason: %s)", netConnection, banScore, reason)
+	if !shouldBan {
+		return
+	}
+
+	log.Warnf("Banning %s (ban score: %d, reason: %s)", netConnection, banScore, reason)
+	err := m.context.ConnectionManager().Ban(netConnection)
+	if err != nil && !errors.Is(err, connmanager.ErrCannotBanPermanent) {
+		panic(err)
+	}
+
+	err = outgoingRoute.Enqueue(appmessage.NewMsgReject(reason.Error()))
+	if err != nil && !errors.Is(err, routerpkg.ErrRouteClosed) {
+		panic(err)
+	}
+}
+
 func (m *Manager) registerFlows(router *routerpkg.Router, errChan chan error, isStopping *uint32) (flows []*flow) {
 	flows = m.registerAddressFlows(router, isStopping, errChan)
 	flows = append(flows, m.registerBlockRelayFlows(router, isStopping, errChan)...)
@@ -158,7 +179,8 @@ func (m *Manager) registerBlockRelayFlows(router *routerpkg.Router, isStopping *
 			appmessage.CmdInvRelayBlock, appmessage.CmdBlock, appmessage.CmdBlockLocator, appmessage.CmdIBDBlock,
 			appmessage.CmdDoneHeaders, appmessage.CmdUnexpectedPruningPoint, appmessage.CmdPruningPointUTXOSetChunk,
 			appmessage.CmdBlockHeaders, appmessage.CmdPruningPointHash, appmessage.CmdIBDBlockLocatorHighestHash,
-			appmessage.CmdIBDBlockLocatorHighestHashNotFound, appmessage.CmdDonePruningPointUTXOSetChunks},
+			appmessage.CmdIBDBlockLocatorHighestHashNotFound, appmessage.CmdDonePruningPointUTXOSetChunks,
+			appmessage.CmdCompactBlock, appmessage.CmdBlockTransactions},
 			isStopping, errChan, func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
 				return blockrelay.HandleRelayInvs(m.context, incomingRoute,
 					outgoingRoute, peer)
@@ -171,6 +193,14 @@ func (m *Manager) registerBlockRelayFlows(router *routerpkg.Router, isStopping *
 			},
 		),
 
+		m.registerFlow("HandleCompactBlockRequests", router,
+			[]appmessage.MessageCommand{appmessage.CmdRequestCompactBlock, appmessage.CmdRequestBlockTransactions},
+			isStopping, errChan,
+			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
+				return blockrelay.HandleCompactBlockRequests(m.context, incomingRoute, outgoingRoute, peer)
+			},
+		),
+
 		m.registerFlow("HandleRequestBlockLocator", router,
 			[]appmessage.MessageCommand{appmessage.CmdRequestBlockLocator}, isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
@@ -193,6 +223,13 @@ func (m *Manager) registerBlockRelayFlows(router *routerpkg.Router, isStopping *
 			},
 		),
 
+		m.registerFlow("HandleArchivalBlockRequests", router,
+			[]appmessage.MessageCommand{appmessage.CmdRequestArchivalBlocks}, isStopping, errChan,
+			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
+				return blockrelay.HandleArchivalBlockRequests(m.context, incomingRoute, outgoingRoute)
+			},
+		),
+
 		m.registerFlow("HandleIBDBlockRequests", router,
 			[]appmessage.MessageCommand{appmessage.CmdRequestIBDBlocks}, isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
@@ -241,7 +278,7 @@ func (m *Manager) registerTransactionRelayFlow(router *routerpkg.Router, isStopp
 		m.registerFlowWithCapacity("HandleRelayedTransactions", 10_000, router,
 			[]appmessage.MessageCommand{appmessage.CmdInvTransaction, appmessage.CmdTx, appmessage.CmdTransactionNotFound}, isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
-				return transactionrelay.HandleRelayedTransactions(m.context, incomingRoute, outgoingRoute)
+				return transactionrelay.HandleRelayedTransactions(m.context, incomingRoute, outgoingRoute, peer)
 			},
 		),
 		m.registerFlow("HandleRequestTransactions", router,
@@ -352,3 +389,21 @@ func removeHandshakeRoutes(router *routerpkg.Router) {
 		panic(err)
 	}
 }
+
+// addToBanScore adds the given weight to the given ban score, and returns
+// the new ban score and whether it reached the ban threshold. A weight of
+// protocolerrors.BanScoreBanThreshold stands for the ban threshold itself.
+// The ban scores of whitelisted peers, and of all peers if banning is
+// disabled, are left as is.
+func addToBanScore(cfg *config.Config, banScore *peerpkg.BanScore, isWhitelisted bool,
+	weight uint32) (newBanScore uint32, shouldBan bool) {
+
+	if cfg.DisableBanning || isWhitelisted {
+		return banScore.Value(), false
+	}
+	if weight == protocolerrors.BanScoreBanThreshold {
+		weight = cfg.BanThreshold
+	}
+	newBanScore = banScore.Increase(weight)
+	return newBanScore, newBanScore >= cfg.BanThreshold
+}
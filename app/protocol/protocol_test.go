@@ -0,0 +1,128 @@
+package protocol
+
+import (
+	"testing"
+
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
+	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/pkg/errors"
+)
+
+func TestAddToBanScore(t *testing.T) {
+	tests := []struct {
+		name           string
+		banThreshold   uint32
+		disableBanning bool
+		isWhitelisted  bool
+		weights        []uint32
+
+		// expectedBanIndex is the index of the offense expected to ban
+		// the peer, or -1 if no offense is expected to ban it
+		expectedBanIndex int
+	}{
+		{
+			name:             "banning errors ban at once",
+			banThreshold:     100,
+			weights:          []uint32{protocolerrors.BanScoreBanThreshold},
+			expectedBanIndex: 0,
+		},
+		{
+			name:             "banning errors ban at once with a raised threshold",
+			banThreshold:     1000,
+			weights:          []uint32{protocolerrors.BanScoreSlowResponse, protocolerrors.BanScoreBanThreshold},
+			expectedBanIndex: 1,
+		},
+		{
+			name: "lower weights add up to the threshold",
+			// The ban score decays a little between offenses, so three
+			// offenses of weight 5 add up to a bit less than 15
+			banThreshold:     14,
+			weights:          []uint32{5, 5, 5, 5},
+			expectedBanIndex: 2,
+		},
+		{
+			name:             "a single invalid block doesn't ban",
+			banThreshold:     100,
+			weights:          []uint32{protocolerrors.BanScoreInvalidBlock},
+			expectedBanIndex: -1,
+		},
+		{
+			name: "repeated invalid blocks ban",
+			// Two offenses add up to a bit less than 100 due to decay
+			banThreshold: 100,
+			weights: []uint32{protocolerrors.BanScoreInvalidBlock, protocolerrors.BanScoreInvalidBlock,
+				protocolerrors.BanScoreInvalidBlock},
+			expectedBanIndex: 2,
+		},
+		{
+			name:             "a single unrequested message doesn't ban",
+			banThreshold:     100,
+			weights:          []uint32{protocolerrors.BanScoreUnrequestedMessage},
+			expectedBanIndex: -1,
+		},
+		{
+			name:             "whitelisted peers are never banned",
+			banThreshold:     100,
+			isWhitelisted:    true,
+			weights:          []uint32{protocolerrors.BanScoreBanThreshold, protocolerrors.BanScoreBanThreshold},
+			expectedBanIndex: -1,
+		},
+		{
+			name:             "no peers are banned when banning is disabled",
+			banThreshold:     100,
+			disableBanning:   true,
+			weights:          []uint32{protocolerrors.BanScoreBanThreshold, protocolerrors.BanScoreBanThreshold},
+			expectedBanIndex: -1,
+		},
+	}
+
+	for _, test := range tests {
+		cfg := config.DefaultConfig()
+		cfg.BanThreshold = test.banThreshold
+		cfg.DisableBanning = test.disableBanning
+
+		banScore := peerpkg.NewBanScore()
+		banIndex := -1
+		for i, weight := range test.weights {
+			_, shouldBan := addToBanScore(cfg, banScore, test.isWhitelisted, weight)
+			if shouldBan {
+				banIndex = i
+				break
+			}
+		}
+		if banIndex != test.expectedBanIndex {
+			t.Errorf("%s: Unexpected offense banned the peer. Want: %d, got: %d",
+				test.name, test.expectedBanIndex, banIndex)
+		}
+		if test.isWhitelisted || test.disableBanning {
+			if value := banScore.Value(); value != 0 {
+				t.Errorf("%s: Expected the ban score to be left as is, got %d", test.name, value)
+			}
+		}
+	}
+}
+
+func TestSingleOffenseDoesNotBan(t *testing.T) {
+	offenses := []error{
+		protocolerrors.ConvertToBanningProtocolErrorIfRuleError(ruleerrors.ErrBadMerkleRoot, "invalid block"),
+		protocolerrors.WrapfWithBanScore(protocolerrors.BanScoreInvalidBlock, ruleerrors.ErrBadMerkleRoot,
+			"got invalid block from relay"),
+		protocolerrors.ErrorfWithBanScore(protocolerrors.BanScoreUnrequestedMessage, "got unrequested block"),
+	}
+
+	for _, offense := range offenses {
+		protocolErr := protocolerrors.ProtocolError{}
+		if !errors.As(offense, &protocolErr) || !protocolErr.ShouldBan {
+			t.Fatalf("%s: expected a banning protocol error", offense)
+		}
+		banScore, shouldBan := addToBanScore(config.DefaultConfig(), peerpkg.NewBanScore(), false, protocolErr.BanScore)
+		if shouldBan {
+			t.Errorf("%s: a single offense unexpectedly banned the peer with a ban score of %d", offense, banScore)
+		}
+		if banScore == 0 {
+			t.Errorf("%s: expected the offense to increase the ban score", offense)
+		}
+	}
+}
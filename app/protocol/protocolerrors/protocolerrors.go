@@ -5,11 +5,36 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Ban score weights of the different kinds of misbehavior. The weight of an
+// offense is added to the ban score of the offending peer, and the peer is
+// banned once its ban score reaches the ban threshold.
+const (
+	// BanScoreBanThreshold stands for the configured ban threshold, so that
+	// the offense bans the peer at once. It's the weight of every banning
+	// ProtocolError that doesn't opt into a lower weight.
+	BanScoreBanThreshold uint32 = 0
+
+	// BanScoreInvalidBlock is the weight of relaying blocks, headers or UTXO
+	// sets that violate the consensus rules
+	BanScoreInvalidBlock uint32 = 50
+
+	// BanScoreUnrequestedMessage is the weight of sending blocks or other
+	// messages that weren't requested
+	BanScoreUnrequestedMessage uint32 = 20
+
+	// BanScoreSlowResponse is the weight of not responding to a request in time
+	BanScoreSlowResponse uint32 = 5
+)
+
 // ProtocolError is an error that signifies a violation
 // of the peer-to-peer protocol
 type ProtocolError struct {
 	ShouldBan bool
 	Cause     error
+
+	// BanScore is the weight added to the ban score of the peer
+	// if ShouldBan is set. It defaults to BanScoreBanThreshold.
+	BanScore uint32
 }
 
 func (e ProtocolError) Error() string {
@@ -56,12 +81,32 @@ func Wrapf(shouldBan bool, err error, format string, args ...interface{}) error
 }
 
 // ConvertToBanningProtocolErrorIfRuleError converts the given error to
-// a banning protocol error if it's a rule error, and otherwise keep it
-// as is.
+// a banning protocol error with the BanScoreInvalidBlock weight if it's
+// a rule error, and otherwise keep it as is.
 func ConvertToBanningProtocolErrorIfRuleError(err error, format string, args ...interface{}) error {
 	if !errors.As(err, &ruleerrors.RuleError{}) {
 		return err
 	}
 
-	return Wrapf(true, err, format, args...)
+	return WrapfWithBanScore(BanScoreInvalidBlock, err, format, args...)
+}
+
+// ErrorfWithBanScore formats according to a format specifier and returns the string
+// as a banning ProtocolError with the given ban score weight.
+func ErrorfWithBanScore(banScore uint32, format string, args ...interface{}) error {
+	return ProtocolError{
+		ShouldBan: true,
+		Cause:     errors.Errorf(format, args...),
+		BanScore:  banScore,
+	}
+}
+
+// WrapfWithBanScore wraps the given error with the given format and returns it as a
+// banning ProtocolError with the given ban score weight.
+func WrapfWithBanScore(banScore uint32, err error, format string, args ...interface{}) error {
+	return ProtocolError{
+		ShouldBan: true,
+		Cause:     errors.Wrapf(err, format, args...),
+		BanScore:  banScore,
+	}
 }
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"os"
+
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+	"github.com/pkg/errors"
+)
+
+// verifyNoDatabase makes sure that restoring a snapshot
+// won't mix it with the data of an existing database
+func verifyNoDatabase(cfg *config.Config) error {
+	dbPath := databasePath(cfg)
+	_, err := os.Stat(dbPath)
+	if err == nil {
+		return errors.Errorf("cannot restore the database from %s since a database "+
+			"already exists at %s. Add --reset-db to replace it", cfg.RestoreDatabase, dbPath)
+	}
+	if !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// restoreDatabase fills the given new database with the snapshot given
+// in --restore-db. If the restore fails, the database is closed and
+// deleted, so that kaspad never starts with a partially restored one.
+func restoreDatabase(cfg *config.Config, db database.Database) error {
+	log.Infof("Restoring the database from %s", cfg.RestoreDatabase)
+	entryCount, err := dbsnapshot.Restore(cfg.RestoreDatabase, db, cfg.ActiveNetParams.Name)
+	if err == nil {
+		// The snapshot is verified like the database of a node
+		// that was shut down uncleanly
+		err = db.Delete(cleanShutdownKey)
+	}
+	if err != nil {
+		closeErr := db.Close()
+		if closeErr != nil {
+			log.Errorf("Failed to close the database: %s", closeErr)
+		}
+		removeErr := removeDatabase(cfg)
+		if removeErr != nil {
+			log.Errorf("Failed to delete the partially restored database: %s", removeErr)
+		}
+		return errors.Wrapf(err, "failed to restore the database from %s", cfg.RestoreDatabase)
+	}
+
+	log.Infof("Restored %d database entries from %s", entryCount, cfg.RestoreDatabase)
+	return nil
+}
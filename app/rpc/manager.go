@@ -8,6 +8,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
@@ -23,6 +24,7 @@ type Manager struct {
 func NewManager(
 	cfg *config.Config,
 	domain domain.Domain,
+	db database.Database,
 	netAdapter *netadapter.NetAdapter,
 	protocolManager *protocol.Manager,
 	connectionManager *connmanager.ConnectionManager,
@@ -34,6 +36,7 @@ func NewManager(
 		context: rpccontext.NewContext(
 			cfg,
 			domain,
+			db,
 			netAdapter,
 			protocolManager,
 			connectionManager,
@@ -42,6 +42,17 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdBanRequestMessage:                                         rpchandlers.HandleBan,
 	appmessage.CmdUnbanRequestMessage:                                       rpchandlers.HandleUnban,
 	appmessage.CmdGetInfoRequestMessage:                                     rpchandlers.HandleGetInfo,
+	appmessage.CmdGetNetTotalsRequestMessage:                                rpchandlers.HandleGetNetTotals,
+	appmessage.CmdDisconnectPeerRequestMessage:                              rpchandlers.HandleDisconnectPeer,
+	appmessage.CmdGetConnectionRequestsRequestMessage:                       rpchandlers.HandleGetConnectionRequests,
+	appmessage.CmdRemovePeerRequestMessage:                                  rpchandlers.HandleRemovePeer,
+	appmessage.CmdCreateDatabaseSnapshotRequestMessage:                      rpchandlers.HandleCreateDatabaseSnapshot,
+	appmessage.CmdExportPruningPointUTXOSetRequestMessage:                   rpchandlers.HandleExportPruningPointUTXOSet,
+	appmessage.CmdGetDatabaseStatsRequestMessage:                            rpchandlers.HandleGetDatabaseStats,
+	appmessage.CmdCompactDatabaseRequestMessage:                             rpchandlers.HandleCompactDatabase,
+	appmessage.CmdResetUTXOIndexRequestMessage:                              rpchandlers.HandleResetUTXOIndex,
+	appmessage.CmdGetCacheStatsRequestMessage:                               rpchandlers.HandleGetCacheStats,
+	appmessage.CmdGetBlockAcceptanceDataRequestMessage:                      rpchandlers.HandleGetBlockAcceptanceData,
 	appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage:           rpchandlers.HandleNotifyPruningPointUTXOSetOverrideRequest,
 	appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage:    rpchandlers.HandleStopNotifyingPruningPointUTXOSetOverrideRequest,
 }
@@ -22,24 +22,33 @@ func (ctx *Context) ConvertVirtualSelectedParentChainChangesToChainChangedNotifi
 		if err != nil {
 			return nil, err
 		}
-		acceptedBlocks := make([]*appmessage.AcceptedBlock, len(acceptanceData))
-		for j, acceptedBlock := range acceptanceData {
-			acceptedTransactionIDs := make([]string, len(acceptedBlock.TransactionAcceptanceData))
-			for k, transaction := range acceptedBlock.TransactionAcceptanceData {
-				transactionID := consensushashing.TransactionID(transaction.Transaction)
-				acceptedTransactionIDs[k] = transactionID.String()
-			}
-			acceptedBlocks[j] = &appmessage.AcceptedBlock{
-				Hash:                   acceptedBlock.BlockHash.String(),
-				AcceptedTransactionIDs: acceptedTransactionIDs,
-			}
-		}
 
 		addedChainBlocks[i] = &appmessage.ChainBlock{
 			Hash:           added.String(),
-			AcceptedBlocks: acceptedBlocks,
+			AcceptedBlocks: ConvertAcceptanceDataToAcceptedBlocks(acceptanceData),
 		}
 	}
 
 	return appmessage.NewVirtualSelectedParentChainChangedNotificationMessage(removedChainBlockHashes, addedChainBlocks), nil
 }
+
+// ConvertAcceptanceDataToAcceptedBlocks converts the AcceptanceData of a
+// chain block to the AcceptedBlocks that list its accepted transactions
+func ConvertAcceptanceDataToAcceptedBlocks(acceptanceData externalapi.AcceptanceData) []*appmessage.AcceptedBlock {
+	acceptedBlocks := make([]*appmessage.AcceptedBlock, len(acceptanceData))
+	for i, acceptedBlock := range acceptanceData {
+		acceptedTransactionIDs := make([]string, 0, len(acceptedBlock.TransactionAcceptanceData))
+		for _, transaction := range acceptedBlock.TransactionAcceptanceData {
+			if !transaction.IsAccepted {
+				continue
+			}
+			transactionID := consensushashing.TransactionID(transaction.Transaction)
+			acceptedTransactionIDs = append(acceptedTransactionIDs, transactionID.String())
+		}
+		acceptedBlocks[i] = &appmessage.AcceptedBlock{
+			Hash:                   acceptedBlock.BlockHash.String(),
+			AcceptedTransactionIDs: acceptedTransactionIDs,
+		}
+	}
+	return acceptedBlocks
+}
@@ -0,0 +1,63 @@
+package rpccontext
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func TestConvertAcceptanceDataToAcceptedBlocks(t *testing.T) {
+	newTransaction := func(lockTime uint64) *externalapi.DomainTransaction {
+		return &externalapi.DomainTransaction{
+			Inputs:   []*externalapi.DomainTransactionInput{},
+			Outputs:  []*externalapi.DomainTransactionOutput{},
+			LockTime: lockTime,
+		}
+	}
+	acceptedTransaction := newTransaction(1)
+	rejectedTransaction := newTransaction(2)
+	otherAcceptedTransaction := newTransaction(3)
+
+	blockHash := externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	otherBlockHash := externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2})
+	acceptanceData := externalapi.AcceptanceData{
+		{
+			BlockHash: blockHash,
+			TransactionAcceptanceData: []*externalapi.TransactionAcceptanceData{
+				{Transaction: acceptedTransaction, IsAccepted: true},
+				{Transaction: rejectedTransaction, IsAccepted: false},
+			},
+		},
+		{
+			BlockHash: otherBlockHash,
+			TransactionAcceptanceData: []*externalapi.TransactionAcceptanceData{
+				{Transaction: rejectedTransaction, IsAccepted: false},
+				{Transaction: otherAcceptedTransaction, IsAccepted: true},
+			},
+		},
+	}
+
+	acceptedBlocks := ConvertAcceptanceDataToAcceptedBlocks(acceptanceData)
+	if len(acceptedBlocks) != 2 {
+		t.Fatalf("Unexpected number of accepted blocks. Want: 2, got: %d", len(acceptedBlocks))
+	}
+
+	// Rejected transactions must not be reported as accepted
+	expectedTransactionIDs := [][]string{
+		{consensushashing.TransactionID(acceptedTransaction).String()},
+		{consensushashing.TransactionID(otherAcceptedTransaction).String()},
+	}
+	expectedHashes := []string{blockHash.String(), otherBlockHash.String()}
+	for i, acceptedBlock := range acceptedBlocks {
+		if acceptedBlock.Hash != expectedHashes[i] {
+			t.Errorf("Unexpected hash for accepted block %d. Want: %s, got: %s",
+				i, expectedHashes[i], acceptedBlock.Hash)
+		}
+		if !reflect.DeepEqual(acceptedBlock.AcceptedTransactionIDs, expectedTransactionIDs[i]) {
+			t.Errorf("Unexpected accepted transaction IDs for accepted block %d. Want: %v, got: %v",
+				i, expectedTransactionIDs[i], acceptedBlock.AcceptedTransactionIDs)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/kaspanet/kaspad/domain"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
@@ -15,6 +16,7 @@ type Context struct {
 	Config            *config.Config
 	NetAdapter        *netadapter.NetAdapter
 	Domain            domain.Domain
+	Database          database.Database
 	ProtocolManager   *protocol.Manager
 	ConnectionManager *connmanager.ConnectionManager
 	AddressManager    *addressmanager.AddressManager
@@ -27,6 +29,7 @@ type Context struct {
 // NewContext creates a new RPC context
 func NewContext(cfg *config.Config,
 	domain domain.Domain,
+	db database.Database,
 	netAdapter *netadapter.NetAdapter,
 	protocolManager *protocol.Manager,
 	connectionManager *connmanager.ConnectionManager,
@@ -38,6 +41,7 @@ func NewContext(cfg *config.Config,
 		Config:            cfg,
 		NetAdapter:        netAdapter,
 		Domain:            domain,
+		Database:          db,
 		ProtocolManager:   protocolManager,
 		ConnectionManager: connectionManager,
 		AddressManager:    addressManager,
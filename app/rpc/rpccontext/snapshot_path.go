@@ -0,0 +1,41 @@
+package rpccontext
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SnapshotPath returns the path that an RPC command that writes a file
+// should write it at, given the path the RPC client requested. Relative
+// paths are resolved against the snapshot directory, and paths outside of
+// it are rejected, so that RPC clients can't have the node write files
+// anywhere else. The snapshot directory is created if it doesn't exist.
+func (ctx *Context) SnapshotPath(path string) (string, error) {
+	if ctx.Config.SnapshotDir == "" {
+		return "", errors.New("no snapshot directory is configured")
+	}
+	snapshotDir, err := filepath.Abs(ctx.Config.SnapshotDir)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(snapshotDir, path)
+	}
+	path = filepath.Clean(path)
+	relativePath, err := filepath.Rel(snapshotDir, path)
+	if err != nil || relativePath == "." || relativePath == ".." ||
+		strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+
+		return "", errors.Errorf("%s is not inside the snapshot directory %s", path, snapshotDir)
+	}
+
+	err = os.MkdirAll(snapshotDir, 0700)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return path, nil
+}
@@ -0,0 +1,55 @@
+package rpccontext
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/config"
+)
+
+func TestSnapshotPath(t *testing.T) {
+	snapshotDir := filepath.Join(t.TempDir(), "snapshots")
+	cfg := config.DefaultConfig()
+	cfg.SnapshotDir = snapshotDir
+	context := &Context{Config: cfg}
+
+	tests := []struct {
+		path          string
+		expectedPath  string
+		expectedError bool
+	}{
+		{path: "snapshot", expectedPath: filepath.Join(snapshotDir, "snapshot")},
+		{path: "daily/../snapshot", expectedPath: filepath.Join(snapshotDir, "snapshot")},
+		{path: filepath.Join(snapshotDir, "snapshot"), expectedPath: filepath.Join(snapshotDir, "snapshot")},
+		{path: "", expectedError: true},
+		{path: ".", expectedError: true},
+		{path: "../snapshot", expectedError: true},
+		{path: "daily/../../snapshot", expectedError: true},
+		{path: snapshotDir, expectedError: true},
+		{path: snapshotDir + "-other/snapshot", expectedError: true},
+		{path: filepath.Join(filepath.Dir(snapshotDir), "snapshot"), expectedError: true},
+	}
+
+	for _, test := range tests {
+		path, err := context.SnapshotPath(test.path)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%q: expected an error, got path %s", test.path, path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", test.path, err)
+			continue
+		}
+		if path != test.expectedPath {
+			t.Errorf("%q: unexpected path. Want: %s, got: %s", test.path, test.expectedPath, path)
+		}
+	}
+
+	context.Config.SnapshotDir = ""
+	_, err := context.SnapshotPath("snapshot")
+	if err == nil {
+		t.Errorf("Expected an error when no snapshot directory is configured")
+	}
+}
@@ -48,6 +48,16 @@ func (ctx *Context) BuildBlockVerboseData(blockHeader externalapi.BlockHeader, b
 			"invalid block")
 	}
 
+	// Blocks below the pruning point are StatusHeaderOnly, but archival
+	// nodes still have their transactions
+	hasBody := blockInfo.BlockStatus != externalapi.StatusHeaderOnly
+	if !hasBody {
+		hasBody, err = ctx.Domain.Consensus().HasBlockBody(hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	childrenHashes, err := ctx.Domain.Consensus().GetBlockChildren(hash)
 	if err != nil {
 		return nil, err
@@ -67,10 +77,10 @@ func (ctx *Context) BuildBlockVerboseData(blockHeader externalapi.BlockHeader, b
 		Bits:                 strconv.FormatInt(int64(blockHeader.Bits()), 16),
 		Difficulty:           ctx.GetDifficultyRatio(blockHeader.Bits(), ctx.Config.ActiveNetParams),
 		BlueScore:            blockInfo.BlueScore,
-		IsHeaderOnly:         blockInfo.BlockStatus == externalapi.StatusHeaderOnly,
+		IsHeaderOnly:         !hasBody,
 	}
 
-	if blockInfo.BlockStatus != externalapi.StatusHeaderOnly {
+	if hasBody {
 		if block == nil {
 			block, err = ctx.Domain.Consensus().GetBlock(hash)
 			if err != nil {
@@ -17,7 +17,12 @@ func HandleAddPeer(context *rpccontext.Context, _ *router.Router, request appmes
 		return errorMessage, nil
 	}
 
-	context.ConnectionManager.AddConnectionRequest(address, AddPeerRequest.IsPermanent)
+	err = context.ConnectionManager.AddConnectionRequest(address, AddPeerRequest.IsPermanent)
+	if err != nil {
+		errorMessage := &appmessage.AddPeerResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not add peer: %s", err)
+		return errorMessage, nil
+	}
 
 	response := appmessage.NewAddPeerResponseMessage()
 	return response, nil
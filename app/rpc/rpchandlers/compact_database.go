@@ -0,0 +1,19 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleCompactDatabase handles the respectively named RPC command
+func HandleCompactDatabase(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	err := context.Database.Compact()
+	if err != nil {
+		log.Warnf("Failed to compact the database: %s", err)
+		errorMessage := &appmessage.CompactDatabaseResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not compact the database: %s", err)
+		return errorMessage, nil
+	}
+	return appmessage.NewCompactDatabaseResponseMessage(), nil
+}
@@ -0,0 +1,35 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleCreateDatabaseSnapshot handles the respectively named RPC command
+func HandleCreateDatabaseSnapshot(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	createDatabaseSnapshotRequest := request.(*appmessage.CreateDatabaseSnapshotRequestMessage)
+
+	path, err := context.SnapshotPath(createDatabaseSnapshotRequest.Path)
+	if err != nil {
+		errorMessage := &appmessage.CreateDatabaseSnapshotResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Invalid snapshot path: %s", err)
+		return errorMessage, nil
+	}
+
+	log.Infof("Creating a database snapshot at %s", path)
+	result, err := dbsnapshot.Create(context.Database, path, context.Config.ActiveNetParams.Name,
+		createDatabaseSnapshotRequest.Compress)
+	if err != nil {
+		log.Warnf("Failed to create a database snapshot at %s: %s", path, err)
+		errorMessage := &appmessage.CreateDatabaseSnapshotResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not create the database snapshot: %s", err)
+		return errorMessage, nil
+	}
+	log.Infof("Created a database snapshot of %d entries (%d bytes) at %s",
+		result.EntryCount, result.Size, path)
+
+	response := appmessage.NewCreateDatabaseSnapshotResponseMessage(result.EntryCount, uint64(result.Size))
+	return response, nil
+}
@@ -0,0 +1,21 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleDisconnectPeer handles the respectively named RPC command
+func HandleDisconnectPeer(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	disconnectPeerRequest := request.(*appmessage.DisconnectPeerRequestMessage)
+	err := context.ConnectionManager.Disconnect(disconnectPeerRequest.Address)
+	if err != nil {
+		errorMessage := &appmessage.DisconnectPeerResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not disconnect peer: %s", err)
+		return errorMessage, nil
+	}
+
+	response := appmessage.NewDisconnectPeerResponseMessage()
+	return response, nil
+}
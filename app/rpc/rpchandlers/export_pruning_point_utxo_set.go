@@ -0,0 +1,32 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/pruningpointfile"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleExportPruningPointUTXOSet handles the respectively named RPC command
+func HandleExportPruningPointUTXOSet(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	exportPruningPointUTXOSetRequest := request.(*appmessage.ExportPruningPointUTXOSetRequestMessage)
+
+	path, err := context.SnapshotPath(exportPruningPointUTXOSetRequest.Path)
+	if err != nil {
+		errorMessage := &appmessage.ExportPruningPointUTXOSetResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Invalid export path: %s", err)
+		return errorMessage, nil
+	}
+
+	summary, err := pruningpointfile.Export(context.Domain.Consensus(), context.Config.ActiveNetParams, path)
+	if err != nil {
+		log.Warnf("Failed to export the pruning point to %s: %s", path, err)
+		errorMessage := &appmessage.ExportPruningPointUTXOSetResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not export the pruning point: %s", err)
+		return errorMessage, nil
+	}
+
+	response := appmessage.NewExportPruningPointUTXOSetResponseMessage(summary.PruningPointHash.String(),
+		summary.HeaderCount, summary.UTXOCount)
+	return response, nil
+}
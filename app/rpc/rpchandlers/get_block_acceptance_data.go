@@ -0,0 +1,46 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetBlockAcceptanceData handles the respectively named RPC command
+func HandleGetBlockAcceptanceData(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getBlockAcceptanceDataRequest := request.(*appmessage.GetBlockAcceptanceDataRequestMessage)
+
+	hash, err := externalapi.NewDomainHashFromString(getBlockAcceptanceDataRequest.Hash)
+	if err != nil {
+		errorMessage := &appmessage.GetBlockAcceptanceDataResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Hash could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	blockInfo, err := context.Domain.Consensus().GetBlockInfo(hash)
+	if err != nil {
+		return nil, err
+	}
+	if !blockInfo.Exists {
+		errorMessage := &appmessage.GetBlockAcceptanceDataResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Block %s not found", hash)
+		return errorMessage, nil
+	}
+
+	acceptanceData, err := context.Domain.Consensus().GetBlockAcceptanceData(hash)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			errorMessage := &appmessage.GetBlockAcceptanceDataResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Block %s has no acceptance data. "+
+				"Either it is not a selected parent chain block, or its data was pruned", hash)
+			return errorMessage, nil
+		}
+		return nil, err
+	}
+
+	return appmessage.NewGetBlockAcceptanceDataResponseMessage(
+		rpccontext.ConvertAcceptanceDataToAcceptedBlocks(acceptanceData)), nil
+}
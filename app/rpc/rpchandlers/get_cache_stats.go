@@ -0,0 +1,24 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetCacheStats handles the respectively named RPC command
+func HandleGetCacheStats(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	allStats := context.Domain.Consensus().GetCacheStats()
+
+	caches := make([]*appmessage.CacheStats, len(allStats))
+	for i, stats := range allStats {
+		caches[i] = &appmessage.CacheStats{
+			Name:     stats.Name,
+			Size:     uint64(stats.Size),
+			Capacity: uint64(stats.Capacity),
+			Hits:     stats.Hits,
+			Misses:   stats.Misses,
+		}
+	}
+	return appmessage.NewGetCacheStatsResponseMessage(caches), nil
+}
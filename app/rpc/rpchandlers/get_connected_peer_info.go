@@ -12,6 +12,9 @@ func HandleGetConnectedPeerInfo(context *rpccontext.Context, _ *router.Router, _
 	ibdPeer := context.ProtocolManager.IBDPeer()
 	infos := make([]*appmessage.GetConnectedPeerInfoMessage, 0, len(peers))
 	for _, peer := range peers {
+		trafficCounters := peer.Connection().TrafficCounters()
+		sent := trafficCounters.Sent()
+		received := trafficCounters.Received()
 		info := &appmessage.GetConnectedPeerInfoMessage{
 			ID:                        peer.ID().String(),
 			Address:                   peer.Address(),
@@ -22,6 +25,14 @@ func HandleGetConnectedPeerInfo(context *rpccontext.Context, _ *router.Router, _
 			AdvertisedProtocolVersion: peer.AdvertisedProtocolVersion(),
 			TimeConnected:             peer.TimeConnected().Milliseconds(),
 			IsIBDPeer:                 peer == ibdPeer,
+			BanScore:                  peer.BanScore().Value(),
+			BytesSent:                 sent.ByteCount,
+			BytesReceived:             received.ByteCount,
+			MessagesSent:              sent.MessageCount,
+			MessagesReceived:          received.MessageCount,
+			SentPerCommand:            messageTrafficStats(trafficCounters.SentPerCommand()),
+			ReceivedPerCommand:        messageTrafficStats(trafficCounters.ReceivedPerCommand()),
+			IsArchival:                peer.HasService(appmessage.SFNodeArchival),
 		}
 		infos = append(infos, info)
 	}
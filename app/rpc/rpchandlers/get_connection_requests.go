@@ -0,0 +1,29 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/util/mstime"
+)
+
+// HandleGetConnectionRequests handles the respectively named RPC command
+func HandleGetConnectionRequests(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	connectionRequests := context.ConnectionManager.ConnectionRequests()
+	connectionRequestInfos := make([]*appmessage.ConnectionRequestInfo, len(connectionRequests))
+	for i, connectionRequest := range connectionRequests {
+		var nextAttemptTimestamp int64
+		if !connectionRequest.NextAttempt.IsZero() {
+			nextAttemptTimestamp = mstime.ToMSTime(connectionRequest.NextAttempt).UnixMilliseconds()
+		}
+		connectionRequestInfos[i] = &appmessage.ConnectionRequestInfo{
+			Address:              connectionRequest.Address,
+			IsPermanent:          connectionRequest.IsPermanent,
+			IsConnected:          connectionRequest.IsConnected,
+			NextAttemptTimestamp: nextAttemptTimestamp,
+		}
+	}
+
+	response := appmessage.NewGetConnectionRequestsResponseMessage(connectionRequestInfos)
+	return response, nil
+}
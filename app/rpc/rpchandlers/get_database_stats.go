@@ -0,0 +1,30 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbinspect"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetDatabaseStats handles the respectively named RPC command
+func HandleGetDatabaseStats(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	allStats, err := dbinspect.Stats(context.Database)
+	if err != nil {
+		log.Warnf("Failed to collect the database stats: %s", err)
+		errorMessage := &appmessage.GetDatabaseStatsResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not collect the database stats: %s", err)
+		return errorMessage, nil
+	}
+
+	stores := make([]*appmessage.DatabaseStoreStats, len(allStats))
+	for i, stats := range allStats {
+		stores[i] = &appmessage.DatabaseStoreStats{
+			Name:       stats.Name,
+			KeyCount:   stats.KeyCount,
+			KeysSize:   stats.KeysSize,
+			ValuesSize: stats.ValuesSize,
+		}
+	}
+	return appmessage.NewGetDatabaseStatsResponseMessage(stores), nil
+}
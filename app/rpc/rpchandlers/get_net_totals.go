@@ -0,0 +1,38 @@
+package rpchandlers
+
+import (
+	"sort"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+)
+
+// HandleGetNetTotals handles the respectively named RPC command
+func HandleGetNetTotals(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	trafficCounters := context.NetAdapter.P2PTrafficCounters()
+	sent := trafficCounters.Sent()
+	received := trafficCounters.Received()
+	response := appmessage.NewGetNetTotalsResponseMessage(sent.ByteCount, received.ByteCount,
+		sent.MessageCount, received.MessageCount,
+		messageTrafficStats(trafficCounters.SentPerCommand()), messageTrafficStats(trafficCounters.ReceivedPerCommand()))
+	return response, nil
+}
+
+// messageTrafficStats converts the given per command traffic counters to
+// MessageTrafficStats, sorted by command
+func messageTrafficStats(perCommand map[string]server.TrafficCounter) []*appmessage.MessageTrafficStats {
+	stats := make([]*appmessage.MessageTrafficStats, 0, len(perCommand))
+	for command, counter := range perCommand {
+		stats = append(stats, &appmessage.MessageTrafficStats{
+			Command:      command,
+			MessageCount: counter.MessageCount,
+			ByteCount:    counter.ByteCount,
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Command < stats[j].Command
+	})
+	return stats
+}
@@ -1,9 +1,6 @@
 package rpchandlers
 
 import (
-	"net"
-	"strconv"
-
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
@@ -14,15 +11,13 @@ func HandleGetPeerAddresses(context *rpccontext.Context, _ *router.Router, _ app
 	netAddresses := context.AddressManager.Addresses()
 	addressMessages := make([]*appmessage.GetPeerAddressesKnownAddressMessage, len(netAddresses))
 	for i, netAddress := range netAddresses {
-		addressWithPort := net.JoinHostPort(netAddress.IP.String(), strconv.FormatUint(uint64(netAddress.Port), 10))
-		addressMessages[i] = &appmessage.GetPeerAddressesKnownAddressMessage{Addr: addressWithPort}
+		addressMessages[i] = &appmessage.GetPeerAddressesKnownAddressMessage{Addr: netAddress.String()}
 	}
 
 	bannedAddresses := context.AddressManager.BannedAddresses()
 	bannedAddressMessages := make([]*appmessage.GetPeerAddressesKnownAddressMessage, len(bannedAddresses))
 	for i, netAddress := range bannedAddresses {
-		addressWithPort := net.JoinHostPort(netAddress.IP.String(), strconv.FormatUint(uint64(netAddress.Port), 10))
-		bannedAddressMessages[i] = &appmessage.GetPeerAddressesKnownAddressMessage{Addr: addressWithPort}
+		bannedAddressMessages[i] = &appmessage.GetPeerAddressesKnownAddressMessage{Addr: netAddress.String()}
 	}
 
 	response := appmessage.NewGetPeerAddressesResponseMessage(addressMessages, bannedAddressMessages)
@@ -4,8 +4,10 @@ import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
 )
 
 // HandleGetUTXOsByAddresses handles the respectively named RPC command
@@ -33,6 +35,11 @@ func HandleGetUTXOsByAddresses(context *rpccontext.Context, _ *router.Router, re
 			return errorMessage, nil
 		}
 		utxoOutpointEntryPairs, err := context.UTXOIndex.UTXOs(scriptPublicKey)
+		if errors.Is(err, utxoindex.ErrNotReady) {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("UTXO index is not ready: %s", err)
+			return errorMessage, nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,29 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/util/network"
+)
+
+// HandleRemovePeer handles the respectively named RPC command
+func HandleRemovePeer(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	removePeerRequest := request.(*appmessage.RemovePeerRequestMessage)
+	address, err := network.NormalizeAddress(removePeerRequest.Address, context.Config.ActiveNetParams.DefaultPort)
+	if err != nil {
+		errorMessage := &appmessage.RemovePeerResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse address: %s", err)
+		return errorMessage, nil
+	}
+
+	err = context.ConnectionManager.RemoveConnection(address)
+	if err != nil {
+		errorMessage := &appmessage.RemovePeerResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not remove peer: %s", err)
+		return errorMessage, nil
+	}
+
+	response := appmessage.NewRemovePeerResponseMessage()
+	return response, nil
+}
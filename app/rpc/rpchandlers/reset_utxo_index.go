@@ -0,0 +1,24 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleResetUTXOIndex handles the respectively named RPC command
+func HandleResetUTXOIndex(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.UTXOIndex {
+		errorMessage := &appmessage.ResetUTXOIndexResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --utxoindex")
+		return errorMessage, nil
+	}
+
+	err := context.UTXOIndex.Rebuild()
+	if err != nil {
+		errorMessage := &appmessage.ResetUTXOIndexResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not rebuild the UTXO index: %s", err)
+		return errorMessage, nil
+	}
+	return appmessage.NewResetUTXOIndexResponseMessage(), nil
+}
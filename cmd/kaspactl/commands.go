@@ -10,10 +10,14 @@ import (
 
 var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.KaspadMessage_AddPeerRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_DisconnectPeerRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_RemovePeerRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetConnectionRequestsRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetConnectedPeerInfoRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetPeerAddressesRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetCurrentNetworkRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetInfoRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetNetTotalsRequest{}),
 
 	reflect.TypeOf(protowire.KaspadMessage_GetBlockRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetBlocksRequest{}),
@@ -36,6 +40,14 @@ var commandTypes = []reflect.Type{
 
 	reflect.TypeOf(protowire.KaspadMessage_BanRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_UnbanRequest{}),
+
+	reflect.TypeOf(protowire.KaspadMessage_CreateDatabaseSnapshotRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_ExportPruningPointUTXOSetRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetDatabaseStatsRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_CompactDatabaseRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_ResetUTXOIndexRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetCacheStatsRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetBlockAcceptanceDataRequest{}),
 }
 
 type commandDescription struct {
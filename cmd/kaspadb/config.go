@@ -0,0 +1,119 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	statsSubCmd   = "stats"
+	bucketsSubCmd = "buckets"
+	dumpSubCmd    = "dump"
+)
+
+const (
+	defaultDbType    = "leveldb"
+	defaultDumpLimit = 10
+)
+
+var defaultDataDir = filepath.Join(config.DefaultHomeDir, "data")
+
+type databaseFlags struct {
+	DataDir string `short:"b" long:"datadir" description:"The data directory of the kaspad node"`
+	DbType  string `long:"dbtype" description:"The database backend of the kaspad node {leveldb, bbolt}"`
+	config.NetworkFlags
+}
+
+type statsConfig struct {
+	databaseFlags
+}
+
+type bucketsConfig struct{}
+
+type dumpConfig struct {
+	Bucket string `long:"bucket" description:"The bucket to dump. Run the buckets sub-command to list them" required:"true"`
+	Key    string `long:"key" description:"The key to dump without its bucket, encoded in hex. If omitted, the first entries of the bucket are dumped"`
+	Limit  int    `long:"limit" description:"The maximum number of entries to dump when --key is omitted"`
+	databaseFlags
+}
+
+func parseCommandLine() (subCommand string, config interface{}) {
+	cfg := &struct{}{}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+
+	statsConf := &statsConfig{databaseFlags: defaultDatabaseFlags()}
+	parser.AddCommand(statsSubCmd, "Shows the number of keys and their sizes in each store",
+		"Walks the buckets of each store in the database of a stopped kaspad node, and shows their "+
+			"key counts and the sizes of their keys and values", statsConf)
+
+	bucketsConf := &bucketsConfig{}
+	parser.AddCommand(bucketsSubCmd, "Lists the buckets that can be dumped",
+		"Lists the names of the database buckets that can be given to the dump sub-command", bucketsConf)
+
+	dumpConf := &dumpConfig{Limit: defaultDumpLimit, databaseFlags: defaultDatabaseFlags()}
+	parser.AddCommand(dumpSubCmd, "Dumps database entries",
+		"Dumps entries of a bucket in the database of a stopped kaspad node, with their values "+
+			"decoded through the protobufs they were serialized with", dumpConf)
+
+	_, err := parser.Parse()
+
+	if err != nil {
+		var flagsErr *flags.Error
+		if ok := errors.As(err, &flagsErr); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		} else {
+			os.Exit(1)
+		}
+		return "", nil
+	}
+
+	switch parser.Command.Active.Name {
+	case statsSubCmd:
+		err := statsConf.resolve(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = statsConf
+	case bucketsSubCmd:
+		config = bucketsConf
+	case dumpSubCmd:
+		err := dumpConf.resolve(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = dumpConf
+	}
+
+	return parser.Command.Active.Name, config
+}
+
+func defaultDatabaseFlags() databaseFlags {
+	return databaseFlags{
+		DataDir: defaultDataDir,
+		DbType:  defaultDbType,
+	}
+}
+
+func (flags *databaseFlags) resolve(parser *flags.Parser) error {
+	err := flags.ResolveNetwork(parser)
+	if err != nil {
+		return err
+	}
+
+	// The memory backend loses its data when kaspad shuts down,
+	// so there is nothing to inspect
+	if flags.DbType != "leveldb" && flags.DbType != "bbolt" {
+		return errors.Errorf("unsupported --dbtype %s", flags.DbType)
+	}
+	return nil
+}
+
+// databasePath returns the path to the database of the kaspad node
+// in the data directory, the same way kaspad builds it
+func (flags *databaseFlags) databasePath() string {
+	return filepath.Join(flags.DataDir, flags.NetParams().Name, "db")
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+
+	// Register the database backends selectable with --dbtype
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/boltdb"
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+)
+
+const databaseCacheSizeMiB = 64
+
+func openDatabase(flags *databaseFlags) (database.Database, error) {
+	// database.Open creates a missing database, which would
+	// hide a wrong --datadir or network
+	dbPath := flags.databasePath()
+	_, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find a %s database", flags.NetParams().Name)
+	}
+
+	db, err := database.Open(flags.DbType, dbPath, &database.Options{CacheSizeMiB: databaseCacheSizeMiB})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open the database at %s. Make sure kaspad is not running", dbPath)
+	}
+	return db, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/dbinspect"
+	"github.com/pkg/errors"
+)
+
+func buckets(_ *bucketsConfig) error {
+	for _, name := range dbinspect.BucketNames() {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+func dump(conf *dumpConfig) error {
+	db, err := openDatabase(&conf.databaseFlags)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if conf.Key != "" {
+		keySuffix, err := hex.DecodeString(conf.Key)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode --key")
+		}
+		entry, err := dbinspect.DumpKey(db, conf.Bucket, keySuffix)
+		if err != nil {
+			return err
+		}
+		printEntry(entry)
+		return nil
+	}
+
+	entries, err := dbinspect.DumpBucket(db, conf.Bucket, conf.Limit)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		printEntry(entry)
+	}
+	return nil
+}
+
+func printEntry(entry *dbinspect.Entry) {
+	fmt.Printf("%s\n%s\n\n", entry.Key, entry.Value)
+}
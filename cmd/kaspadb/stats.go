@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/dbinspect"
+)
+
+func stats(conf *statsConfig) error {
+	db, err := openDatabase(&conf.databaseFlags)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	allStats, err := dbinspect.Stats(db)
+	if err != nil {
+		return err
+	}
+
+	const format = "%-20s %12s %14s %14s\n"
+	fmt.Printf(format, "Store", "Keys", "Keys size", "Values size")
+	total := &dbinspect.StoreStats{Name: "total"}
+	for _, stats := range allStats {
+		printStats(format, stats)
+		total.KeyCount += stats.KeyCount
+		total.KeysSize += stats.KeysSize
+		total.ValuesSize += stats.ValuesSize
+	}
+	printStats(format, total)
+	return nil
+}
+
+func printStats(format string, stats *dbinspect.StoreStats) {
+	fmt.Printf(format, stats.Name, fmt.Sprint(stats.KeyCount),
+		formatSize(stats.KeysSize), formatSize(stats.ValuesSize))
+}
+
+func formatSize(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	divisor, exponent := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		divisor *= unit
+		exponent++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(divisor), "KMGTPE"[exponent])
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/pruningpointfile"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+
+	// Register the database backends selectable with --dbtype
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/boltdb"
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+)
+
+const databaseCacheSizeMiB = 64
+
+func main() {
+	cfg, err := parseConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing command-line arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	logger.InitLogStdout(logger.LevelInfo)
+	defer logger.BackendLog.Close()
+
+	err = exportPruningPoint(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting the pruning point: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func exportPruningPoint(cfg *configFlags) error {
+	// database.Open creates a missing database, which would
+	// hide a wrong --datadir or network
+	dbPath := cfg.databasePath()
+	_, err := os.Stat(dbPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not find a %s database", cfg.NetParams().Name)
+	}
+
+	db, err := database.Open(cfg.DbType, dbPath, &database.Options{CacheSizeMiB: databaseCacheSizeMiB})
+	if err != nil {
+		return errors.Wrapf(err, "could not open the database at %s. Make sure kaspad is not running", dbPath)
+	}
+	defer db.Close()
+
+	consensusInstance, err := consensus.NewFactory().NewConsensus(cfg.NetParams(), db, false,
+		consensus.DefaultCacheSizes(cfg.NetParams()))
+	if err != nil {
+		return err
+	}
+
+	summary, err := pruningpointfile.Export(consensusInstance, cfg.NetParams(), cfg.Output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported pruning point %s with %d headers and %d UTXOs to %s\n",
+		summary.PruningPointHash, summary.HeaderCount, summary.UTXOCount, cfg.Output)
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+const (
+	disasmSubCmd = "disasm"
+	asmSubCmd    = "asm"
+	debugSubCmd  = "debug"
+)
+
+type disasmConfig struct {
+	Script  string `long:"script" short:"s" description:"The script to disassemble (encoded in hex)" required:"true"`
+	Version uint16 `long:"script-version" description:"The version of the script"`
+	OneLine bool   `long:"oneline" description:"Replace small integer opcodes with their values and omit data push opcodes. The output of this form can't always be assembled back into the same script"`
+}
+
+type asmConfig struct {
+	Text string `long:"text" short:"t" description:"The script to assemble, as printed by the disasm command" required:"true"`
+}
+
+type debugConfig struct {
+	Transaction            string `long:"transaction" short:"x" description:"The transaction to debug, in the JSON format accepted by the submitTransaction RPC command" required:"true"`
+	InputIndex             int    `long:"input-index" short:"i" description:"The index of the transaction input to execute"`
+	ScriptPublicKey        string `long:"utxo-script-public-key" short:"p" description:"The script public key of the UTXO entry spent by the input (encoded in hex)" required:"true"`
+	ScriptPublicKeyVersion uint16 `long:"utxo-script-version" description:"The version of the script public key of the UTXO entry spent by the input"`
+}
+
+func parseCommandLine() (subCommand string, config interface{}) {
+	cfg := &struct{}{}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+
+	disasmConf := &disasmConfig{}
+	parser.AddCommand(disasmSubCmd, "Disassembles a script",
+		"Disassembles a script public key or a signature script into opcode form", disasmConf)
+
+	asmConf := &asmConfig{}
+	parser.AddCommand(asmSubCmd, "Assembles a script",
+		"Assembles the opcode form of a script back into its bytes", asmConf)
+
+	debugConf := &debugConfig{}
+	parser.AddCommand(debugSubCmd, "Step-executes a transaction input",
+		"Executes a transaction input against the script public key of the UTXO entry it spends, "+
+			"printing the stacks after each opcode", debugConf)
+
+	_, err := parser.Parse()
+
+	if err != nil {
+		var flagsErr *flags.Error
+		if ok := errors.As(err, &flagsErr); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		} else {
+			os.Exit(1)
+		}
+		return "", nil
+	}
+
+	switch parser.Command.Active.Name {
+	case disasmSubCmd:
+		config = disasmConf
+	case asmSubCmd:
+		config = asmConf
+	case debugSubCmd:
+		config = debugConf
+	}
+
+	return parser.Command.Active.Name, config
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func debug(conf *debugConfig) error {
+	transaction, err := parseTransaction(conf.Transaction)
+	if err != nil {
+		return err
+	}
+	if conf.InputIndex < 0 || conf.InputIndex >= len(transaction.Inputs) {
+		return errors.Errorf("Input index %d is out of range: the transaction has %d inputs",
+			conf.InputIndex, len(transaction.Inputs))
+	}
+
+	script, err := hex.DecodeString(conf.ScriptPublicKey)
+	if err != nil {
+		return errors.Wrap(err, "error decoding script public key")
+	}
+	if conf.ScriptPublicKeyVersion > constants.MaxScriptPublicKeyVersion {
+		return errors.Errorf("Script public key version %d is unknown. Scripts of unknown versions "+
+			"are not executed and always pass", conf.ScriptPublicKeyVersion)
+	}
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: script, Version: conf.ScriptPublicKeyVersion}
+
+	signatureScript := transaction.Inputs[conf.InputIndex].SignatureScript
+	printScript("Signature script", 0, signatureScript)
+	printScript("Script public key", conf.ScriptPublicKeyVersion, script)
+	if txscript.IsPayToScriptHash(scriptPublicKey) {
+		fmt.Println("The script public key is pay-to-script-hash: the redeem script " +
+			"is executed once both scripts above pass")
+	}
+	fmt.Println()
+
+	vm, err := txscript.NewEngine(scriptPublicKey, transaction, conf.InputIndex, txscript.ScriptNoFlags, nil)
+	if err != nil {
+		return errors.Wrap(err, "error creating script engine")
+	}
+
+	for step := 1; ; step++ {
+		opcode, err := vm.DisasmPC()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Step %d: %s\n", step, opcode)
+
+		done, err := vm.Step()
+		if err != nil {
+			return errors.Wrapf(err, "Execution failed at step %d", step)
+		}
+		printStack("Stack", vm.GetStack())
+		printStack("Alt stack", vm.GetAltStack())
+
+		if done {
+			break
+		}
+	}
+
+	err = vm.CheckErrorCondition(true)
+	if err != nil {
+		return errors.Wrap(err, "Execution failed")
+	}
+	fmt.Println("\nExecution succeeded")
+	return nil
+}
+
+// parseTransaction parses a transaction in the JSON format used by the
+// submitTransaction RPC command
+func parseTransaction(transactionJSON string) (*externalapi.DomainTransaction, error) {
+	rpcTransaction := &protowire.RpcTransaction{}
+	err := protojson.Unmarshal([]byte(transactionJSON), rpcTransaction)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing transaction")
+	}
+
+	kaspadMessage := &protowire.KaspadMessage{
+		Payload: &protowire.KaspadMessage_SubmitTransactionRequest{
+			SubmitTransactionRequest: &protowire.SubmitTransactionRequestMessage{
+				Transaction: rpcTransaction,
+			},
+		},
+	}
+	appMessage, err := kaspadMessage.ToAppMessage()
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting transaction")
+	}
+	request := appMessage.(*appmessage.SubmitTransactionRequestMessage)
+
+	transaction, err := appmessage.RPCTransactionToDomainTransaction(request.Transaction)
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting transaction")
+	}
+	return transaction, nil
+}
+
+func printScript(title string, version uint16, script []byte) {
+	disassembly, err := txscript.DisasmFullString(version, script)
+	if err != nil {
+		fmt.Printf("%s: %s (%s)\n", title, disassembly, err)
+		return
+	}
+	fmt.Printf("%s: %s\n", title, disassembly)
+}
+
+func printStack(title string, stack [][]byte) {
+	if len(stack) == 0 {
+		return
+	}
+	fmt.Printf("  %s (top last):\n", title)
+	for i, item := range stack {
+		fmt.Printf("    %d: %x\n", i, item)
+	}
+}
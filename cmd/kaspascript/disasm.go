@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/pkg/errors"
+)
+
+func disasm(conf *disasmConfig) error {
+	script, err := hex.DecodeString(conf.Script)
+	if err != nil {
+		return errors.Wrap(err, "error decoding script")
+	}
+
+	var disassembly string
+	if conf.OneLine {
+		disassembly, err = txscript.DisasmString(conf.Version, script)
+	} else {
+		disassembly, err = txscript.DisasmFullString(conf.Version, script)
+	}
+	// The disassembly up to the point of failure is still useful, so print it before the error
+	fmt.Println(disassembly)
+	return err
+}
+
+func asm(conf *asmConfig) error {
+	script, err := txscript.AssembleScript(conf.Text)
+	if err != nil {
+		return err
+	}
+	fmt.Println(hex.EncodeToString(script))
+	return nil
+}
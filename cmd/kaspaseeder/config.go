@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/kaspanet/kaspad/version"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLogFilename    = "kaspaseeder.log"
+	defaultErrLogFilename = "kaspaseeder_err.log"
+	defaultListen         = "0.0.0.0:5354"
+	defaultGRPCListen     = "0.0.0.0:3737"
+	defaultThreads        = 8
+)
+
+var (
+	// Default configuration options
+	defaultAppDir = util.AppDataDir("kaspaseeder", false)
+)
+
+type configFlags struct {
+	ShowVersion bool     `short:"V" long:"version" description:"Display version information and exit"`
+	AppDir      string   `short:"b" long:"appdir" description:"Directory to store data"`
+	KnownPeers  []string `short:"p" long:"peers" description:"Peers to start crawling from, in host[:port] form. If omitted, the network's DNS seeds are used"`
+	Host        string   `long:"host" description:"Seed DNS hostname, for example seed.example.com"`
+	Nameserver  string   `long:"nameserver" description:"Hostname of the nameserver that is authoritative for --host"`
+	Listen      string   `long:"listen" description:"Address to listen on for DNS requests"`
+	GRPCListen  string   `long:"grpclisten" description:"Address to listen on for gRPC PeerService requests"`
+	Threads     int      `long:"threads" description:"Number of peers to crawl concurrently"`
+	Profile     string   `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	config.NetworkFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		AppDir:     defaultAppDir,
+		Listen:     defaultListen,
+		GRPCListen: defaultGRPCListen,
+		Threads:    defaultThreads,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+
+	// Show the version and exit if the version flag was specified.
+	if cfg.ShowVersion {
+		appName := filepath.Base(os.Args[0])
+		appName = strings.TrimSuffix(appName, filepath.Ext(appName))
+		fmt.Println(appName, "version", version.Version())
+		os.Exit(0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Host == "" {
+		return nil, errors.New("--host is required")
+	}
+
+	if cfg.Threads <= 0 {
+		return nil, errors.New("--threads must be positive")
+	}
+
+	if cfg.Profile != "" {
+		profilePort, err := strconv.Atoi(cfg.Profile)
+		if err != nil || profilePort < 1024 || profilePort > 65535 {
+			return nil, errors.New("The profile port must be between 1024 and 65535")
+		}
+	}
+
+	for i, knownPeer := range cfg.KnownPeers {
+		cfg.KnownPeers[i], err = normalizePeerAddress(knownPeer, cfg.NetParams().DefaultPort)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg.AppDir = filepath.Join(cfg.AppDir, cfg.NetParams().Name)
+	err = os.MkdirAll(cfg.AppDir, 0700)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating app directory %s", cfg.AppDir)
+	}
+
+	logDir := filepath.Join(cfg.AppDir, "logs")
+	initLog(filepath.Join(logDir, defaultLogFilename), filepath.Join(logDir, defaultErrLogFilename))
+
+	return cfg, nil
+}
+
+// normalizePeerAddress adds the default port to the given address if it's
+// missing one, and makes sure the result is a valid host:port pair
+func normalizePeerAddress(address string, defaultPort string) (string, error) {
+	_, _, err := net.SplitHostPort(address)
+	if err != nil {
+		address = net.JoinHostPort(address, defaultPort)
+	}
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid peer address %s", address)
+	}
+	_, err = strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid port in peer address %s", address)
+	}
+	return address, nil
+}
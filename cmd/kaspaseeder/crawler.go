@@ -0,0 +1,150 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/standalone"
+	"github.com/pkg/errors"
+)
+
+const (
+	crawlTickInterval = 10 * time.Second
+	pruneInterval     = 10 * time.Minute
+	saveInterval      = 10 * time.Minute
+)
+
+// crawler connects to the peers known to the manager, handshakes with
+// them, and feeds the manager with the results and with the addresses
+// the peers know about
+type crawler struct {
+	cfg     *configFlags
+	manager *manager
+
+	adapters    []*standalone.MinimalNetAdapter
+	addressChan chan string
+	quit        chan struct{}
+	wg          sync.WaitGroup
+}
+
+func newCrawler(cfg *configFlags, manager *manager) (*crawler, error) {
+	adapterConfig := config.DefaultConfig()
+	adapterConfig.NetworkFlags = cfg.NetworkFlags
+	adapterConfig.Listeners = nil
+	adapterConfig.RPCListeners = nil
+
+	// Every thread gets its own adapter, since an adapter handshakes
+	// with a single peer at a time
+	adapters := make([]*standalone.MinimalNetAdapter, cfg.Threads)
+	for i := range adapters {
+		adapter, err := standalone.NewMinimalNetAdapter(adapterConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating net adapter")
+		}
+		adapters[i] = adapter
+	}
+
+	return &crawler{
+		cfg:         cfg,
+		manager:     manager,
+		adapters:    adapters,
+		addressChan: make(chan string),
+		quit:        make(chan struct{}),
+	}, nil
+}
+
+func (c *crawler) start() {
+	for i, adapter := range c.adapters {
+		adapter := adapter
+		c.wg.Add(1)
+		spawn("crawler.crawlLoop", func() {
+			defer c.wg.Done()
+			c.crawlLoop(adapter)
+		})
+		log.Debugf("Started crawler thread %d", i)
+	}
+
+	c.wg.Add(1)
+	spawn("crawler.dispatchLoop", func() {
+		defer c.wg.Done()
+		c.dispatchLoop()
+	})
+}
+
+func (c *crawler) stop() {
+	close(c.quit)
+	c.wg.Wait()
+}
+
+// dispatchLoop periodically hands the addresses that are due for crawling
+// to the crawler threads, and takes care of the manager's housekeeping
+func (c *crawler) dispatchLoop() {
+	crawlTicker := time.NewTicker(crawlTickInterval)
+	defer crawlTicker.Stop()
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+	saveTicker := time.NewTicker(saveInterval)
+	defer saveTicker.Stop()
+
+	for {
+		c.dispatch()
+
+		select {
+		case <-crawlTicker.C:
+		case <-pruneTicker.C:
+			pruned := c.manager.prune()
+			if pruned > 0 {
+				log.Infof("Pruned %d stale peers", pruned)
+			}
+		case <-saveTicker.C:
+			err := c.manager.save()
+			if err != nil {
+				log.Errorf("Error saving peers: %s", err)
+			}
+			known, good := c.manager.counts()
+			log.Infof("Known peers: %d, good peers: %d", known, good)
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+func (c *crawler) dispatch() {
+	for _, address := range c.manager.addressesToCrawl(c.cfg.Threads * 10) {
+		select {
+		case c.addressChan <- address:
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+func (c *crawler) crawlLoop(adapter *standalone.MinimalNetAdapter) {
+	for {
+		select {
+		case address := <-c.addressChan:
+			c.crawl(adapter, address)
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+func (c *crawler) crawl(adapter *standalone.MinimalNetAdapter, address string) {
+	log.Debugf("Crawling %s", address)
+
+	routes, err := adapter.Connect(address)
+	if err != nil {
+		log.Debugf("Could not crawl %s: %s", address, err)
+		c.manager.markBad(address)
+		return
+	}
+	defer routes.Disconnect()
+
+	version := routes.PeerVersion()
+	c.manager.markGood(address, version)
+	added := c.manager.addAddresses(routes.PeerAddresses())
+	log.Debugf("Crawled %s (%s, protocol version %d): received %d addresses, %d of them new",
+		address, version.UserAgent, version.ProtocolVersion, len(routes.PeerAddresses()), added)
+}
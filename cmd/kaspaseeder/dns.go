@@ -0,0 +1,232 @@
+package main
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed"
+	"github.com/pkg/errors"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	dnsTTL = 30
+
+	// The maximum number of addresses in a single response, chosen so that
+	// a response fits in a 512-byte UDP packet
+	maxARecords    = 25
+	maxAAAARecords = 15
+)
+
+// dnsServer answers A, AAAA and NS queries for the seed hostname with
+// the good peers known to the manager. The queried name may be prefixed
+// with the labels built by dnsseed.SeedFromDNS to filter the peers by
+// service flags and subnetwork.
+type dnsServer struct {
+	hostname   string
+	nameserver string
+	port       uint16
+	manager    *manager
+
+	conn net.PacketConn
+}
+
+func newDNSServer(hostname string, nameserver string, defaultPort string, manager *manager) (*dnsServer, error) {
+	port, err := strconv.ParseUint(defaultPort, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid default port %s", defaultPort)
+	}
+	return &dnsServer{
+		hostname:   strings.ToLower(strings.TrimSuffix(hostname, ".")),
+		nameserver: strings.TrimSuffix(nameserver, "."),
+		port:       uint16(port),
+		manager:    manager,
+	}, nil
+}
+
+func (s *dnsServer) start(listen string) error {
+	conn, err := net.ListenPacket("udp", listen)
+	if err != nil {
+		return errors.Wrapf(err, "error listening for DNS requests on %s", listen)
+	}
+	s.conn = conn
+	log.Infof("DNS server listening on %s", conn.LocalAddr())
+
+	spawn("dnsServer.serve", s.serve)
+	return nil
+}
+
+func (s *dnsServer) stop() error {
+	return s.conn.Close()
+}
+
+func (s *dnsServer) serve() {
+	buffer := make([]byte, 512)
+	for {
+		n, address, err := s.conn.ReadFrom(buffer)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			log.Errorf("Error reading DNS request: %s", err)
+			continue
+		}
+
+		response, err := s.handleRequest(buffer[:n])
+		if err != nil {
+			log.Debugf("Ignoring DNS request from %s: %s", address, err)
+			continue
+		}
+		_, err = s.conn.WriteTo(response, address)
+		if err != nil {
+			log.Debugf("Error writing DNS response to %s: %s", address, err)
+		}
+	}
+}
+
+func (s *dnsServer) handleRequest(request []byte) ([]byte, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(request)
+	if err != nil {
+		return nil, err
+	}
+	if header.Response {
+		return nil, errors.New("received a response instead of a request")
+	}
+	question, err := parser.Question()
+	if err != nil {
+		return nil, err
+	}
+
+	responseHeader := dnsmessage.Header{
+		ID:                 header.ID,
+		Response:           true,
+		OpCode:             header.OpCode,
+		Authoritative:      true,
+		RecursionDesired:   header.RecursionDesired,
+		RecursionAvailable: false,
+		RCode:              dnsmessage.RCodeSuccess,
+	}
+
+	filter, err := s.parseQueryName(question.Name.String())
+	if err != nil {
+		log.Debugf("Bad DNS query name %s: %s", question.Name, err)
+		responseHeader.RCode = dnsmessage.RCodeNameError
+	} else if filter == nil {
+		responseHeader.RCode = dnsmessage.RCodeRefused
+	}
+
+	builder := dnsmessage.NewBuilder(make([]byte, 0, 512), responseHeader)
+	builder.EnableCompression()
+	err = builder.StartQuestions()
+	if err != nil {
+		return nil, err
+	}
+	err = builder.Question(question)
+	if err != nil {
+		return nil, err
+	}
+	err = builder.StartAnswers()
+	if err != nil {
+		return nil, err
+	}
+	if responseHeader.RCode == dnsmessage.RCodeSuccess {
+		err = s.buildAnswers(&builder, question, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return builder.Finish()
+}
+
+func (s *dnsServer) buildAnswers(builder *dnsmessage.Builder, question dnsmessage.Question, filter *peerFilter) error {
+	resourceHeader := dnsmessage.ResourceHeader{
+		Name:  question.Name,
+		Class: dnsmessage.ClassINET,
+		TTL:   dnsTTL,
+	}
+
+	switch question.Type {
+	case dnsmessage.TypeA:
+		filter.ipv4 = true
+		for _, address := range s.manager.goodAddresses(filter, maxARecords) {
+			resource := dnsmessage.AResource{}
+			copy(resource.A[:], address.IP.To4())
+			err := builder.AResource(resourceHeader, resource)
+			if err != nil {
+				return err
+			}
+		}
+	case dnsmessage.TypeAAAA:
+		filter.ipv6 = true
+		for _, address := range s.manager.goodAddresses(filter, maxAAAARecords) {
+			resource := dnsmessage.AAAAResource{}
+			copy(resource.AAAA[:], address.IP.To16())
+			err := builder.AAAAResource(resourceHeader, resource)
+			if err != nil {
+				return err
+			}
+		}
+	case dnsmessage.TypeNS:
+		if s.nameserver == "" {
+			return nil
+		}
+		nameserver, err := dnsmessage.NewName(s.nameserver + ".")
+		if err != nil {
+			return err
+		}
+		resourceHeader.TTL = 86400
+		return builder.NSResource(resourceHeader, dnsmessage.NSResource{NS: nameserver})
+	}
+	return nil
+}
+
+// parseQueryName returns the peer filter encoded in the prefix labels of
+// the given name, or nil if the name is outside the seed's zone
+func (s *dnsServer) parseQueryName(name string) (*peerFilter, error) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	filter := &peerFilter{
+		services:              appmessage.SFNodeNetwork,
+		includeAllSubnetworks: true,
+		port:                  s.port,
+	}
+	if name == s.hostname {
+		return filter, nil
+	}
+	if !strings.HasSuffix(name, "."+s.hostname) {
+		return nil, nil
+	}
+
+	prefix := strings.TrimSuffix(name, "."+s.hostname)
+	for _, label := range strings.Split(prefix, ".") {
+		if label == "" {
+			return nil, errors.New("empty label")
+		}
+		switch label[0] {
+		case dnsseed.ServiceFlagPrefixChar:
+			services, err := strconv.ParseUint(label[1:], 16, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid service flags in label %s", label)
+			}
+			filter.services = appmessage.ServiceFlag(services)
+		case dnsseed.SubnetworkIDPrefixChar:
+			filter.includeAllSubnetworks = false
+			if len(label) == 1 {
+				filter.subnetworkID = nil
+				continue
+			}
+			subnetworkID, err := subnetworks.FromString(label[1:])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid subnetwork ID in label %s", label)
+			}
+			filter.subnetworkID = subnetworkID
+		default:
+			return nil, errors.Errorf("unknown label %s", label)
+		}
+	}
+	return filter, nil
+}
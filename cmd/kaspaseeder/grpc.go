@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"net"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed/pb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// peerServiceServer implements the PeerService consumed by dnsseed.SeedFromGRPC
+type peerServiceServer struct {
+	pb.UnimplementedPeerServiceServer
+	manager *manager
+
+	server *grpc.Server
+}
+
+func newPeerServiceServer(manager *manager) *peerServiceServer {
+	s := &peerServiceServer{
+		manager: manager,
+		server:  grpc.NewServer(),
+	}
+	pb.RegisterPeerServiceServer(s.server, s)
+	return s
+}
+
+func (s *peerServiceServer) start(listen string) error {
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return errors.Wrapf(err, "error listening for gRPC requests on %s", listen)
+	}
+	log.Infof("gRPC server listening on %s", listener.Addr())
+
+	spawn("peerServiceServer.serve", func() {
+		err := s.server.Serve(listener)
+		if err != nil {
+			log.Errorf("Error serving gRPC requests: %s", err)
+		}
+	})
+	return nil
+}
+
+func (s *peerServiceServer) stop() {
+	s.server.Stop()
+}
+
+// GetPeersList returns the good peers that match the given request
+func (s *peerServiceServer) GetPeersList(_ context.Context, request *pb.GetPeersListRequest) (*pb.GetPeersListResponse, error) {
+	filter := &peerFilter{
+		services:              appmessage.ServiceFlag(request.ServiceFlag),
+		includeAllSubnetworks: request.IncludeAllSubnetworks,
+		ipv4:                  true,
+		ipv6:                  true,
+	}
+	if len(request.SubnetworkID) > 0 {
+		subnetworkID, err := subnetworks.FromBytes(request.SubnetworkID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid subnetwork ID: %s", err)
+		}
+		filter.subnetworkID = subnetworkID
+	}
+
+	addresses := s.manager.goodAddresses(filter, appmessage.MaxAddressesPerMsg)
+	response := &pb.GetPeersListResponse{
+		Addresses: make([]*pb.NetAddress, len(addresses)),
+	}
+	for i, address := range addresses {
+		response.Addresses[i] = &pb.NetAddress{
+			Timestamp: address.Timestamp.UnixMilliseconds(),
+			Services:  uint64(address.Services),
+			IP:        address.IP,
+			Port:      uint32(address.Port),
+		}
+	}
+	return response, nil
+}
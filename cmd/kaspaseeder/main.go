@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	_ "net/http/pprof"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed"
+	"github.com/kaspanet/kaspad/infrastructure/os/signal"
+	"github.com/kaspanet/kaspad/util/panics"
+	"github.com/kaspanet/kaspad/util/profiling"
+	"github.com/kaspanet/kaspad/version"
+	"github.com/pkg/errors"
+)
+
+func main() {
+	defer panics.HandlePanic(log, "MAIN", nil)
+	interrupt := signal.InterruptListener()
+
+	cfg, err := parseConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing command-line arguments: %s\n", err)
+		os.Exit(1)
+	}
+	defer backendLog.Close()
+
+	// Show version at startup.
+	log.Infof("Version %s", version.Version())
+
+	// Enable http profiling server if requested.
+	if cfg.Profile != "" {
+		profiling.Start(cfg.Profile, log)
+	}
+
+	seeder, err := startSeeder(cfg)
+	if err != nil {
+		log.Criticalf("Error starting the seeder: %+v", err)
+		return
+	}
+	defer seeder.stop()
+
+	<-interrupt
+}
+
+// seeder ties together the crawler and the servers that serve its results
+type seeder struct {
+	manager           *manager
+	crawler           *crawler
+	dnsServer         *dnsServer
+	peerServiceServer *peerServiceServer
+}
+
+func startSeeder(cfg *configFlags) (*seeder, error) {
+	manager, err := newManager(cfg.AppDir, cfg.NetParams())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.KnownPeers) > 0 {
+		for _, knownPeer := range cfg.KnownPeers {
+			address, err := resolvePeerAddress(knownPeer)
+			if err != nil {
+				return nil, err
+			}
+			manager.addKnownPeer(address)
+		}
+	} else {
+		dnsseed.SeedFromDNS(cfg.NetParams(), "", appmessage.SFNodeNetwork, true, nil, net.LookupIP,
+			func(addresses []*appmessage.NetAddress) {
+				for _, address := range addresses {
+					manager.addKnownPeer(address)
+				}
+			})
+	}
+
+	crawler, err := newCrawler(cfg, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsServer, err := newDNSServer(cfg.Host, cfg.Nameserver, cfg.NetParams().DefaultPort, manager)
+	if err != nil {
+		return nil, err
+	}
+	err = dnsServer.start(cfg.Listen)
+	if err != nil {
+		return nil, err
+	}
+
+	peerServiceServer := newPeerServiceServer(manager)
+	err = peerServiceServer.start(cfg.GRPCListen)
+	if err != nil {
+		return nil, err
+	}
+
+	crawler.start()
+
+	return &seeder{
+		manager:           manager,
+		crawler:           crawler,
+		dnsServer:         dnsServer,
+		peerServiceServer: peerServiceServer,
+	}, nil
+}
+
+func (s *seeder) stop() {
+	s.crawler.stop()
+	s.peerServiceServer.stop()
+
+	err := s.dnsServer.stop()
+	if err != nil {
+		log.Errorf("Error stopping the DNS server: %s", err)
+	}
+	err = s.manager.save()
+	if err != nil {
+		log.Errorf("Error saving peers: %s", err)
+	}
+}
+
+func resolvePeerAddress(address string) (*appmessage.NetAddress, error) {
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port in peer address %s", address)
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error resolving peer address %s", address)
+	}
+	return appmessage.NewNetAddressIPPort(ips[0], uint16(port), appmessage.SFNodeNetwork), nil
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+const (
+	peersFilename = "peers.json"
+
+	// maxPeers is the maximum number of peers the manager keeps track of
+	maxPeers = 100000
+
+	// goodPeerRecrawlInterval is how often a peer that answered its last
+	// crawl is crawled again
+	goodPeerRecrawlInterval = 10 * time.Minute
+
+	// badPeerRecrawlInterval is how often a peer that didn't answer its last
+	// crawl is retried
+	badPeerRecrawlInterval = 30 * time.Minute
+
+	// stalePeerTimeout is how long a peer may go without answering a crawl
+	// before it's forgotten
+	stalePeerTimeout = 24 * time.Hour
+)
+
+// peer is everything the seeder knows about a single network address.
+// Its fields are exported so that it can be persisted as JSON.
+type peer struct {
+	IP              net.IP
+	Port            uint16
+	Services        appmessage.ServiceFlag
+	SubnetworkID    *externalapi.DomainSubnetworkID
+	ProtocolVersion uint32
+	UserAgent       string
+	FirstSeen       time.Time
+	LastAttempt     time.Time
+	LastSuccess     time.Time
+	LastFailure     time.Time
+}
+
+func (p *peer) key() string {
+	return net.JoinHostPort(p.IP.String(), strconv.Itoa(int(p.Port)))
+}
+
+func (p *peer) netAddress() *appmessage.NetAddress {
+	return appmessage.NewNetAddressTimestamp(mstime.ToMSTime(p.LastSuccess), p.Services, p.IP, p.Port)
+}
+
+// isGood returns whether the peer answered its most recent crawl
+func (p *peer) isGood() bool {
+	return !p.LastSuccess.IsZero() && p.LastSuccess.After(p.LastFailure)
+}
+
+func (p *peer) isStale(now time.Time) bool {
+	lastSuccess := p.LastSuccess
+	if lastSuccess.IsZero() {
+		lastSuccess = p.FirstSeen
+	}
+	return now.Sub(lastSuccess) > stalePeerTimeout
+}
+
+func (p *peer) needsCrawl(now time.Time) bool {
+	if p.LastAttempt.IsZero() {
+		return true
+	}
+	if p.isGood() {
+		return now.Sub(p.LastAttempt) > goodPeerRecrawlInterval
+	}
+	return now.Sub(p.LastAttempt) > badPeerRecrawlInterval
+}
+
+// peerFilter selects the peers that are returned to a seeding client
+type peerFilter struct {
+	services              appmessage.ServiceFlag
+	includeAllSubnetworks bool
+	subnetworkID          *externalapi.DomainSubnetworkID
+	port                  uint16 // Zero means any port
+	ipv4                  bool
+	ipv6                  bool
+}
+
+func (f *peerFilter) matches(p *peer) bool {
+	if p.Services&f.services != f.services {
+		return false
+	}
+	if !f.includeAllSubnetworks {
+		if f.subnetworkID == nil && p.SubnetworkID != nil {
+			return false
+		}
+		if f.subnetworkID != nil && (p.SubnetworkID == nil || !p.SubnetworkID.Equal(f.subnetworkID)) {
+			return false
+		}
+	}
+	if f.port != 0 && p.Port != f.port {
+		return false
+	}
+	isIPv4 := p.IP.To4() != nil
+	return (isIPv4 && f.ipv4) || (!isIPv4 && f.ipv6)
+}
+
+// manager keeps track of all the peers the crawler knows about
+type manager struct {
+	peersFile string
+	params    *dagconfig.Params
+
+	peers map[string]*peer
+	lock  sync.Mutex
+}
+
+func newManager(appDir string, params *dagconfig.Params) (*manager, error) {
+	m := &manager{
+		peersFile: filepath.Join(appDir, peersFilename),
+		params:    params,
+		peers:     make(map[string]*peer),
+	}
+	err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// addKnownPeer adds a peer the crawler was explicitly told about. Unlike
+// addresses learned from the network, it's accepted even if it isn't routable.
+func (m *manager) addKnownPeer(address *appmessage.NetAddress) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.addAddressNoLock(address)
+}
+
+// addAddresses adds the routable addresses among the given addresses and
+// returns how many of them were previously unknown
+func (m *manager) addAddresses(addresses []*appmessage.NetAddress) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	added := 0
+	for _, address := range addresses {
+		// .onion addresses can be neither crawled nor served over DNS
+		if address.IsOnion() || !addressmanager.IsRoutable(address, m.params.AcceptUnroutable) {
+			continue
+		}
+		if m.addAddressNoLock(address) {
+			added++
+		}
+	}
+	return added
+}
+
+func (m *manager) addAddressNoLock(address *appmessage.NetAddress) bool {
+	if len(m.peers) >= maxPeers {
+		return false
+	}
+	p := &peer{
+		IP:        address.IP,
+		Port:      address.Port,
+		Services:  address.Services,
+		FirstSeen: time.Now(),
+	}
+	key := p.key()
+	if _, ok := m.peers[key]; ok {
+		return false
+	}
+	m.peers[key] = p
+	return true
+}
+
+// addressesToCrawl returns up to maxCount addresses that are due for
+// crawling, and marks them as attempted so that they aren't returned again
+// until they're due once more
+func (m *manager) addressesToCrawl(maxCount int) []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	addresses := make([]string, 0, maxCount)
+	for key, p := range m.peers {
+		if len(addresses) == maxCount {
+			break
+		}
+		if !p.needsCrawl(now) {
+			continue
+		}
+		p.LastAttempt = now
+		addresses = append(addresses, key)
+	}
+	return addresses
+}
+
+// markGood records a successful crawl of the given address
+func (m *manager) markGood(address string, version *appmessage.MsgVersion) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p, ok := m.peers[address]
+	if !ok {
+		return
+	}
+	p.LastSuccess = time.Now()
+	p.Services = version.Services
+	p.SubnetworkID = version.SubnetworkID
+	p.ProtocolVersion = version.ProtocolVersion
+	p.UserAgent = version.UserAgent
+}
+
+// markBad records a failed crawl of the given address
+func (m *manager) markBad(address string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p, ok := m.peers[address]
+	if !ok {
+		return
+	}
+	p.LastFailure = time.Now()
+}
+
+// prune forgets all the peers that haven't answered a crawl for too long
+func (m *manager) prune() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	pruned := 0
+	for key, p := range m.peers {
+		if p.isStale(now) {
+			delete(m.peers, key)
+			pruned++
+		}
+	}
+	return pruned
+}
+
+// goodAddresses returns up to maxCount random good peers that match the given filter
+func (m *manager) goodAddresses(filter *peerFilter, maxCount int) []*appmessage.NetAddress {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var addresses []*appmessage.NetAddress
+	for _, p := range m.peers {
+		if p.isGood() && filter.matches(p) {
+			addresses = append(addresses, p.netAddress())
+		}
+	}
+	rand.Shuffle(len(addresses), func(i, j int) {
+		addresses[i], addresses[j] = addresses[j], addresses[i]
+	})
+	if len(addresses) > maxCount {
+		addresses = addresses[:maxCount]
+	}
+	return addresses
+}
+
+// counts returns the number of known peers and how many of them are good
+func (m *manager) counts() (known int, good int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, p := range m.peers {
+		if p.isGood() {
+			good++
+		}
+	}
+	return len(m.peers), good
+}
+
+func (m *manager) load() error {
+	peersJSON, err := ioutil.ReadFile(m.peersFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "error reading %s", m.peersFile)
+	}
+
+	var peers []*peer
+	err = json.Unmarshal(peersJSON, &peers)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing %s", m.peersFile)
+	}
+	for _, p := range peers {
+		m.peers[p.key()] = p
+	}
+	return nil
+}
+
+func (m *manager) save() error {
+	m.lock.Lock()
+	peers := make([]*peer, 0, len(m.peers))
+	for _, p := range m.peers {
+		peers = append(peers, p)
+	}
+	peersJSON, err := json.Marshal(peers)
+	m.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a crash mid-write doesn't
+	// leave a truncated peers file behind
+	tempFile := m.peersFile + ".tmp"
+	err = ioutil.WriteFile(tempFile, peersJSON, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "error writing %s", tempFile)
+	}
+	return os.Rename(tempFile, m.peersFile)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app"
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed"
+	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed/pb"
+)
+
+const (
+	testHost       = "seed.kaspa.test"
+	testDNSListen  = "127.0.0.1:15354"
+	testGRPCListen = "127.0.0.1:13737"
+	testTimeout    = 10 * time.Second
+)
+
+// startKaspad starts a simnet kaspad that listens for p2p connections on
+// the given address
+func startKaspad(t *testing.T, p2pAddress string) (teardown func()) {
+	dataDir, err := ioutil.TempDir("", "kaspaseeder-test-kaspad")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+
+	cfg := config.DefaultConfig()
+	params := dagconfig.SimnetParams
+	cfg.ActiveNetParams = &params
+	cfg.Simnet = true
+	cfg.DataDir = dataDir
+	cfg.Listeners = []string{p2pAddress}
+	cfg.RPCListeners = nil
+	cfg.TargetOutboundPeers = 0
+	cfg.DisableDNSSeed = true
+
+	db, err := ldb.NewLevelDB(filepath.Join(dataDir, "db"), 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	componentManager, err := app.NewComponentManager(cfg, db, make(chan struct{}))
+	if err != nil {
+		t.Fatalf("NewComponentManager: %s", err)
+	}
+	componentManager.Start()
+
+	return func() {
+		componentManager.Stop()
+		err := db.Close()
+		if err != nil {
+			t.Errorf("Close: %s", err)
+		}
+		os.RemoveAll(dataDir)
+	}
+}
+
+func TestSeeder(t *testing.T) {
+	// One kaspad listens on the default port, so it's expected to be
+	// served over both DNS and gRPC. The other only over gRPC, since DNS
+	// clients can't learn about non-default ports.
+	defaultPortAddress := net.JoinHostPort("127.0.0.1", dagconfig.SimnetParams.DefaultPort)
+	otherPortAddress := "127.0.0.1:54331"
+
+	teardownKaspad1 := startKaspad(t, defaultPortAddress)
+	defer teardownKaspad1()
+	teardownKaspad2 := startKaspad(t, otherPortAddress)
+	defer teardownKaspad2()
+
+	appDir, err := ioutil.TempDir("", "kaspaseeder-test")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	defer os.RemoveAll(appDir)
+
+	cfg := &configFlags{
+		AppDir:     appDir,
+		KnownPeers: []string{defaultPortAddress, otherPortAddress},
+		Host:       testHost,
+		Nameserver: "ns." + testHost,
+		Listen:     testDNSListen,
+		GRPCListen: testGRPCListen,
+		Threads:    2,
+	}
+	cfg.Simnet = true
+	cfg.ActiveNetParams = &dagconfig.SimnetParams
+
+	seeder, err := startSeeder(cfg)
+	if err != nil {
+		t.Fatalf("startSeeder: %+v", err)
+	}
+	defer seeder.stop()
+
+	deadline := time.Now().Add(testTimeout)
+	for {
+		_, good := seeder.manager.counts()
+		if good == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for the crawler to handshake with both peers")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	addresses := seedAddresses(t, func(seedFn dnsseed.OnSeed) {
+		dnsseed.SeedFromGRPC(cfg.NetParams(), testGRPCListen, appmessage.SFNodeNetwork, true, nil, seedFn)
+	})
+	expectedAddresses := []string{defaultPortAddress, otherPortAddress}
+	sort.Strings(expectedAddresses)
+	if !equalStrings(addresses, expectedAddresses) {
+		t.Fatalf("Unexpected gRPC seed addresses. Want: %s, got: %s", expectedAddresses, addresses)
+	}
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "udp", testDNSListen)
+		},
+	}
+	lookupFn := func(host string) ([]net.IP, error) {
+		return resolver.LookupIP(context.Background(), "ip4", host)
+	}
+	addresses = seedAddresses(t, func(seedFn dnsseed.OnSeed) {
+		dnsseed.SeedFromDNS(cfg.NetParams(), testHost, appmessage.SFNodeNetwork, false, nil, lookupFn, seedFn)
+	})
+	if !equalStrings(addresses, []string{defaultPortAddress}) {
+		t.Fatalf("Unexpected DNS seed addresses. Want: %s, got: %s", defaultPortAddress, addresses)
+	}
+
+	// Both kaspads are full nodes, so none of them belongs to any other subnetwork
+	response, err := seeder.peerServiceServer.GetPeersList(context.Background(), &pb.GetPeersListRequest{
+		ServiceFlag:  uint64(appmessage.SFNodeNetwork),
+		SubnetworkID: subnetworks.SubnetworkIDRegistry[:],
+	})
+	if err != nil {
+		t.Fatalf("GetPeersList: %s", err)
+	}
+	if len(response.Addresses) != 0 {
+		t.Fatalf("Expected no peers in the registry subnetwork, got %d", len(response.Addresses))
+	}
+}
+
+// seedAddresses runs the given seeding function and returns the sorted
+// addresses it seeded
+func seedAddresses(t *testing.T, seed func(seedFn dnsseed.OnSeed)) []string {
+	addressesChan := make(chan []*appmessage.NetAddress)
+	seed(func(addresses []*appmessage.NetAddress) {
+		addressesChan <- addresses
+	})
+
+	var addresses []*appmessage.NetAddress
+	select {
+	case addresses = <-addressesChan:
+	case <-time.After(testTimeout):
+		t.Fatalf("Timed out waiting for seeded addresses")
+	}
+
+	addressStrings := make([]string, len(addresses))
+	for i, address := range addresses {
+		addressStrings[i] = address.TCPAddress().String()
+	}
+	sort.Strings(addressStrings)
+	return addressStrings
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
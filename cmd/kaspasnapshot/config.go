@@ -0,0 +1,52 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/pkg/errors"
+)
+
+const defaultDbType = "leveldb"
+
+var defaultDataDir = filepath.Join(config.DefaultHomeDir, "data")
+
+type configFlags struct {
+	DataDir  string `short:"b" long:"datadir" description:"The data directory of the kaspad node to snapshot the database of"`
+	DbType   string `long:"dbtype" description:"The database backend of the kaspad node {leveldb, bbolt}"`
+	Output   string `short:"o" long:"output" description:"The path to write the snapshot file to" required:"true"`
+	Compress bool   `short:"z" long:"compress" description:"Compress the snapshot file with gzip"`
+	config.NetworkFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		DataDir: defaultDataDir,
+		DbType:  defaultDbType,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	// The memory backend loses its data when kaspad shuts down,
+	// so there is nothing to snapshot
+	if cfg.DbType != "leveldb" && cfg.DbType != "bbolt" {
+		return nil, errors.Errorf("unsupported --dbtype %s", cfg.DbType)
+	}
+
+	return cfg, nil
+}
+
+// databasePath returns the path to the database of the kaspad node
+// in the data directory, the same way kaspad builds it
+func (cfg *configFlags) databasePath() string {
+	return filepath.Join(cfg.DataDir, cfg.NetParams().Name, "db")
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+
+	// Register the database backends selectable with --dbtype
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/boltdb"
+	_ "github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+)
+
+const databaseCacheSizeMiB = 64
+
+func main() {
+	cfg, err := parseConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing command-line arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	logger.InitLogStdout(logger.LevelInfo)
+	defer logger.BackendLog.Close()
+
+	err = createSnapshot(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating the database snapshot: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func createSnapshot(cfg *configFlags) error {
+	// database.Open creates a missing database, which would
+	// hide a wrong --datadir or network
+	dbPath := cfg.databasePath()
+	_, err := os.Stat(dbPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not find a %s database", cfg.NetParams().Name)
+	}
+
+	db, err := database.Open(cfg.DbType, dbPath, &database.Options{CacheSizeMiB: databaseCacheSizeMiB})
+	if err != nil {
+		return errors.Wrapf(err, "could not open the database at %s. Make sure kaspad is not running", dbPath)
+	}
+	defer db.Close()
+
+	result, err := dbsnapshot.Create(db, cfg.Output, cfg.NetParams().Name, cfg.Compress)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote a snapshot of %d entries (%d bytes) to %s\n", result.EntryCount, result.Size, cfg.Output)
+	return nil
+}
@@ -9,9 +9,12 @@ import (
 )
 
 const (
-	createSubCmd  = "create"
-	balanceSubCmd = "balance"
-	sendSubCmd    = "send"
+	createSubCmd        = "create"
+	balanceSubCmd       = "balance"
+	sendSubCmd          = "send"
+	historySubCmd       = "history"
+	signMessageSubCmd   = "sign-message"
+	verifyMessageSubCmd = "verify-message"
 )
 
 type createConfig struct {
@@ -25,10 +28,33 @@ type balanceConfig struct {
 }
 
 type sendConfig struct {
-	RPCServer  string  `long:"rpcserver" short:"s" description:"RPC server to connect to"`
-	PrivateKey string  `long:"private-key" short:"k" description:"The private key of the sender (encoded in hex)" required:"true"`
-	ToAddress  string  `long:"to-address" short:"t" description:"The public address to send Kaspa to" required:"true"`
-	SendAmount float64 `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)" required:"true"`
+	RPCServer   string  `long:"rpcserver" short:"s" description:"RPC server to connect to"`
+	PrivateKey  string  `long:"private-key" short:"k" description:"The private key of the sender (encoded in hex)" required:"true"`
+	ToAddress   string  `long:"to-address" short:"t" description:"The public address to send Kaspa to" required:"true"`
+	SendAmount  float64 `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)" required:"true"`
+	PayToPubKey bool    `long:"p2pk" description:"Spend from (and send change to) the pay-to-pubkey address of the private key, rather than its pay-to-pubkey-hash address"`
+	WalletDir   string  `long:"wallet-dir" short:"w" description:"Directory in which the record of sent transactions is kept"`
+	config.NetworkFlags
+}
+
+type signMessageConfig struct {
+	PrivateKey string `long:"private-key" short:"k" description:"The private key of the signer (encoded in hex)" required:"true"`
+	Message    string `long:"message" short:"m" description:"The message to sign" required:"true"`
+	config.NetworkFlags
+}
+
+type verifyMessageConfig struct {
+	Address   string `long:"address" short:"d" description:"The public address that allegedly signed the message" required:"true"`
+	Message   string `long:"message" short:"m" description:"The message that was signed" required:"true"`
+	Signature string `long:"signature" short:"g" description:"The signature to verify (encoded in hex)" required:"true"`
+	config.NetworkFlags
+}
+
+type historyConfig struct {
+	RPCServer string   `long:"rpcserver" short:"s" description:"RPC server to connect to"`
+	Addresses []string `long:"address" short:"d" description:"A public address to show the unspent outputs of. May be specified multiple times"`
+	WalletDir string   `long:"wallet-dir" short:"w" description:"Directory in which the record of sent transactions is kept"`
+	Watch     bool     `long:"watch" description:"Keep running and print changes to the watched addresses and sent transactions as they happen"`
 	config.NetworkFlags
 }
 
@@ -44,10 +70,23 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(balanceSubCmd, "Shows the balance of a public address",
 		"Shows the balance for a public address in Kaspa", balanceConf)
 
-	sendConf := &sendConfig{}
+	sendConf := &sendConfig{WalletDir: defaultWalletDir}
 	parser.AddCommand(sendSubCmd, "Sends a Kaspa transaction to a public address",
 		"Sends a Kaspa transaction to a public address", sendConf)
 
+	historyConf := &historyConfig{WalletDir: defaultWalletDir}
+	parser.AddCommand(historySubCmd, "Shows sent transactions and received outputs",
+		"Shows the status of transactions sent by this wallet (in mempool, accepted, or dropped) "+
+			"and the unspent outputs of the given public addresses", historyConf)
+
+	signMessageConf := &signMessageConfig{}
+	parser.AddCommand(signMessageSubCmd, "Signs a message with a private key",
+		"Signs a message, proving control over the public address of the given private key", signMessageConf)
+
+	verifyMessageConf := &verifyMessageConfig{}
+	parser.AddCommand(verifyMessageSubCmd, "Verifies a signed message",
+		"Verifies that a message was signed by the owner of a public address", verifyMessageConf)
+
 	_, err := parser.Parse()
 
 	if err != nil {
@@ -79,6 +118,24 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = sendConf
+	case historySubCmd:
+		err := historyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = historyConf
+	case signMessageSubCmd:
+		err := signMessageConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = signMessageConf
+	case verifyMessageSubCmd:
+		err := verifyMessageConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = verifyMessageConf
 	}
 
 	return parser.Command.Active.Name, config
@@ -33,5 +33,12 @@ func create(conf *createConfig) error {
 	}
 	fmt.Printf("Address (%s):\t%s\n", conf.ActiveNetParams.Name, addr)
 
+	fmt.Println("\nThis is your pay-to-pubkey address. It may be used instead of the above, and is cheaper to spend from.")
+	pubKeyAddr, err := util.NewAddressPubKey(publicKeySerialized[:], conf.ActiveNetParams.Prefix)
+	if err != nil {
+		return errors.Wrap(err, "Failed to generate p2pk address")
+	}
+	fmt.Printf("Address (%s):\t%s\n", conf.ActiveNetParams.Name, pubKeyAddr)
+
 	return nil
 }
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/os/signal"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+func history(conf *historyConfig) error {
+	store, err := openTransactionStore(conf.WalletDir, conf.ActiveNetParams.Name)
+	if err != nil {
+		return err
+	}
+
+	client, err := rpcclient.NewRPCClient(conf.RPCServer)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = refreshAndPrintHistory(conf, client, store)
+	if err != nil {
+		return err
+	}
+	if !conf.Watch {
+		return nil
+	}
+
+	addresses := watchedAddresses(conf, store)
+	if len(addresses) == 0 {
+		return errors.New("Nothing to watch: no addresses were given and no transactions were sent")
+	}
+
+	utxosChanged := make(chan *appmessage.UTXOsChangedNotificationMessage)
+	err = client.RegisterForUTXOsChangedNotifications(addresses,
+		func(notification *appmessage.UTXOsChangedNotificationMessage) {
+			utxosChanged <- notification
+		})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("\nWatching for changes. Press Ctrl+C to stop.")
+	interrupt := signal.InterruptListener()
+	for {
+		select {
+		case <-interrupt:
+			return nil
+		case notification := <-utxosChanged:
+			fmt.Printf("\n%s\n", time.Now().Format(time.RFC3339))
+			printUTXOsChanged(notification)
+			err := refreshAndPrintHistory(conf, client, store)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func refreshAndPrintHistory(conf *historyConfig, client *rpcclient.RPCClient, store *transactionStore) error {
+	virtualSelectedParentBlueScoreResponse, err := client.GetVirtualSelectedParentBlueScore()
+	if err != nil {
+		return err
+	}
+	virtualSelectedParentBlueScore := virtualSelectedParentBlueScoreResponse.BlueScore
+
+	err = updateTransactionStatuses(client, store)
+	if err != nil {
+		return err
+	}
+	err = store.save()
+	if err != nil {
+		return err
+	}
+	printSentTransactions(store, virtualSelectedParentBlueScore)
+
+	if len(conf.Addresses) > 0 {
+		getUTXOsByAddressesResponse, err := client.GetUTXOsByAddresses(conf.Addresses)
+		if err != nil {
+			return err
+		}
+		printReceivedUTXOs(getUTXOsByAddressesResponse.Entries, virtualSelectedParentBlueScore)
+	}
+	return nil
+}
+
+// historyClient is the subset of the RPC client that is used to update the
+// statuses of sent transactions
+type historyClient interface {
+	GetMempoolEntry(txID string) (*appmessage.GetMempoolEntryResponseMessage, error)
+	GetVirtualSelectedParentChainFromBlock(startHash string) (
+		*appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage, error)
+	GetBlock(hash string, includeTransactionVerboseData bool) (*appmessage.GetBlockResponseMessage, error)
+}
+
+// updateTransactionStatuses re-evaluates the status of every sent transaction.
+// A transaction is "in mempool" if the node's mempool still contains it, "accepted"
+// if a block in the virtual selected parent chain accepted it, and "dropped" otherwise.
+func updateTransactionStatuses(client historyClient, store *transactionStore) error {
+	chainScanner := newChainScanner(client)
+	for _, transaction := range store.transactions {
+		isInMempool, err := isTransactionInMempool(client, transaction.TransactionID)
+		if err != nil {
+			return err
+		}
+		if isInMempool {
+			transaction.Status = transactionStatusMempool
+			transaction.AcceptingBlockHash = ""
+			transaction.AcceptingBlockBlueScore = 0
+			continue
+		}
+
+		acceptingBlockHash, found, err := chainScanner.findAcceptingBlock(transaction.StartHash, transaction.TransactionID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			transaction.Status = transactionStatusDropped
+			transaction.AcceptingBlockHash = ""
+			transaction.AcceptingBlockBlueScore = 0
+			continue
+		}
+
+		if transaction.AcceptingBlockHash != acceptingBlockHash {
+			getBlockResponse, err := client.GetBlock(acceptingBlockHash, false)
+			if err != nil {
+				return err
+			}
+			transaction.AcceptingBlockHash = acceptingBlockHash
+			transaction.AcceptingBlockBlueScore = getBlockResponse.BlockVerboseData.BlueScore
+		}
+		transaction.Status = transactionStatusAccepted
+	}
+	return nil
+}
+
+func isTransactionInMempool(client historyClient, transactionID string) (bool, error) {
+	_, err := client.GetMempoolEntry(transactionID)
+	if err != nil {
+		if isTransactionNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isTransactionNotFoundError returns whether err is the RPC error that the node
+// responds with when the requested transaction is not in its mempool (see
+// HandleGetMempoolEntry). Any other error, including other RPC errors, says
+// nothing about the transaction's status.
+func isTransactionNotFoundError(err error) bool {
+	return errors.Is(err, rpcclient.ErrRPC) && strings.Contains(err.Error(), "was not found")
+}
+
+// chainScanner looks up accepting blocks in the virtual selected parent chain.
+// Chains are cached by start hash, since transactions sent together commonly
+// share the same one.
+type chainScanner struct {
+	client historyClient
+	chains map[string][]*appmessage.ChainBlock
+}
+
+func newChainScanner(client historyClient) *chainScanner {
+	return &chainScanner{
+		client: client,
+		chains: make(map[string][]*appmessage.ChainBlock),
+	}
+}
+
+func (cs *chainScanner) findAcceptingBlock(startHash string, transactionID string) (
+	acceptingBlockHash string, found bool, err error) {
+
+	chain, ok := cs.chains[startHash]
+	if !ok {
+		response, err := cs.client.GetVirtualSelectedParentChainFromBlock(startHash)
+		if err != nil {
+			return "", false, err
+		}
+		chain = response.AddedChainBlocks
+		cs.chains[startHash] = chain
+	}
+
+	for _, chainBlock := range chain {
+		for _, acceptedBlock := range chainBlock.AcceptedBlocks {
+			for _, acceptedTransactionID := range acceptedBlock.AcceptedTransactionIDs {
+				if acceptedTransactionID == transactionID {
+					return chainBlock.Hash, true, nil
+				}
+			}
+		}
+	}
+	return "", false, nil
+}
+
+func watchedAddresses(conf *historyConfig, store *transactionStore) []string {
+	addressSet := make(map[string]struct{})
+	addresses := make([]string, 0, len(conf.Addresses))
+	addAddress := func(address string) {
+		if _, ok := addressSet[address]; ok {
+			return
+		}
+		addressSet[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+	for _, address := range conf.Addresses {
+		addAddress(address)
+	}
+	for _, transaction := range store.transactions {
+		addAddress(transaction.FromAddress)
+	}
+	return addresses
+}
+
+func confirmations(blockBlueScore uint64, virtualSelectedParentBlueScore uint64) uint64 {
+	if blockBlueScore > virtualSelectedParentBlueScore {
+		return 0
+	}
+	return virtualSelectedParentBlueScore - blockBlueScore + 1
+}
+
+func printSentTransactions(store *transactionStore, virtualSelectedParentBlueScore uint64) {
+	if len(store.transactions) == 0 {
+		fmt.Println("No sent transactions")
+		return
+	}
+
+	fmt.Println("Sent transactions:")
+	for _, transaction := range store.transactions {
+		fmt.Printf("\n  Transaction ID:\t%s\n", transaction.TransactionID)
+		fmt.Printf("  Sent at:\t\t%s\n", time.Unix(0, transaction.SentAt*int64(time.Millisecond)).Format(time.RFC3339))
+		fmt.Printf("  To:\t\t\t%s\n", transaction.ToAddress)
+		fmt.Printf("  Amount:\t\tKAS %f\n", float64(transaction.Amount)/util.SompiPerKaspa)
+		fmt.Printf("  Fee:\t\t\tKAS %f\n", float64(transaction.Fee)/util.SompiPerKaspa)
+		fmt.Printf("  Status:\t\t%s\n", transaction.Status)
+		if transaction.Status == transactionStatusAccepted {
+			fmt.Printf("  Accepting block:\t%s\n", transaction.AcceptingBlockHash)
+			fmt.Printf("  Confirmations:\t%d\n",
+				confirmations(transaction.AcceptingBlockBlueScore, virtualSelectedParentBlueScore))
+		}
+	}
+}
+
+func printReceivedUTXOs(entries []*appmessage.UTXOsByAddressesEntry, virtualSelectedParentBlueScore uint64) {
+	if len(entries) == 0 {
+		fmt.Println("\nNo unspent outputs")
+		return
+	}
+
+	fmt.Println("\nUnspent outputs:")
+	for _, entry := range entries {
+		fmt.Printf("\n  Outpoint:\t\t%s:%d\n", entry.Outpoint.TransactionID, entry.Outpoint.Index)
+		fmt.Printf("  Address:\t\t%s\n", entry.Address)
+		fmt.Printf("  Amount:\t\tKAS %f\n", float64(entry.UTXOEntry.Amount)/util.SompiPerKaspa)
+		fmt.Printf("  Confirmations:\t%d\n",
+			confirmations(entry.UTXOEntry.BlockBlueScore, virtualSelectedParentBlueScore))
+		if entry.UTXOEntry.IsCoinbase {
+			fmt.Println("  Coinbase:\t\ttrue")
+		}
+	}
+}
+
+func printUTXOsChanged(notification *appmessage.UTXOsChangedNotificationMessage) {
+	for _, entry := range notification.Added {
+		fmt.Printf("Received KAS %f at %s (outpoint %s:%d)\n",
+			float64(entry.UTXOEntry.Amount)/util.SompiPerKaspa, entry.Address,
+			entry.Outpoint.TransactionID, entry.Outpoint.Index)
+	}
+	for _, entry := range notification.Removed {
+		fmt.Printf("Spent outpoint %s:%d of %s\n",
+			entry.Outpoint.TransactionID, entry.Outpoint.Index, entry.Address)
+	}
+}
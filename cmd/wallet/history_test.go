@@ -0,0 +1,131 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+type fakeHistoryClient struct {
+	mempool         map[string]struct{}
+	mempoolEntryErr error
+	chain           []*appmessage.ChainBlock
+	blueScores      map[string]uint64
+}
+
+func (c *fakeHistoryClient) GetMempoolEntry(txID string) (*appmessage.GetMempoolEntryResponseMessage, error) {
+	if c.mempoolEntryErr != nil {
+		return nil, c.mempoolEntryErr
+	}
+	if _, ok := c.mempool[txID]; !ok {
+		// This is how rpcclient reports the RPC error returned by HandleGetMempoolEntry
+		return nil, errors.Wrapf(rpcclient.ErrRPC, "Transaction %s was not found", txID)
+	}
+	return &appmessage.GetMempoolEntryResponseMessage{}, nil
+}
+
+func (c *fakeHistoryClient) GetVirtualSelectedParentChainFromBlock(_ string) (
+	*appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage, error) {
+
+	return &appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage{AddedChainBlocks: c.chain}, nil
+}
+
+func (c *fakeHistoryClient) GetBlock(hash string, _ bool) (*appmessage.GetBlockResponseMessage, error) {
+	return &appmessage.GetBlockResponseMessage{
+		BlockVerboseData: &appmessage.BlockVerboseData{Hash: hash, BlueScore: c.blueScores[hash]},
+	}, nil
+}
+
+func TestUpdateTransactionStatuses(t *testing.T) {
+	client := &fakeHistoryClient{
+		mempool: map[string]struct{}{"inMempool": {}},
+		chain: []*appmessage.ChainBlock{
+			{
+				Hash: "chainBlock",
+				AcceptedBlocks: []*appmessage.AcceptedBlock{
+					{Hash: "mergedBlock", AcceptedTransactionIDs: []string{"accepted"}},
+				},
+			},
+		},
+		blueScores: map[string]uint64{"chainBlock": 7},
+	}
+	store := &transactionStore{
+		transactions: []*sentTransaction{
+			{TransactionID: "inMempool", Status: transactionStatusPending},
+			{TransactionID: "accepted", Status: transactionStatusPending},
+			{TransactionID: "dropped", Status: transactionStatusAccepted,
+				AcceptingBlockHash: "orphanedBlock", AcceptingBlockBlueScore: 3},
+		},
+	}
+
+	err := updateTransactionStatuses(client, store)
+	if err != nil {
+		t.Fatalf("updateTransactionStatuses: %+v", err)
+	}
+
+	expectedStatuses := []transactionStatus{transactionStatusMempool, transactionStatusAccepted, transactionStatusDropped}
+	for i, transaction := range store.transactions {
+		if transaction.Status != expectedStatuses[i] {
+			t.Errorf("Unexpected status for transaction %s. Want: %s, got: %s",
+				transaction.TransactionID, expectedStatuses[i], transaction.Status)
+		}
+	}
+	accepted := store.transactions[1]
+	if accepted.AcceptingBlockHash != "chainBlock" || accepted.AcceptingBlockBlueScore != 7 {
+		t.Errorf("Unexpected accepting block for transaction %s. Want: chainBlock (blue score 7), "+
+			"got: %s (blue score %d)", accepted.TransactionID, accepted.AcceptingBlockHash, accepted.AcceptingBlockBlueScore)
+	}
+	dropped := store.transactions[2]
+	if dropped.AcceptingBlockHash != "" || dropped.AcceptingBlockBlueScore != 0 {
+		t.Errorf("Expected the accepting block of dropped transaction %s to be cleared", dropped.TransactionID)
+	}
+}
+
+func TestUpdateTransactionStatusesMempoolEntryErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "other RPC error", err: errors.Wrap(rpcclient.ErrRPC, "Transaction ID could not be parsed")},
+		{name: "connection error", err: errors.New("timeout expired")},
+	}
+
+	for _, test := range tests {
+		client := &fakeHistoryClient{mempoolEntryErr: test.err}
+		store := &transactionStore{
+			transactions: []*sentTransaction{{TransactionID: "pending", Status: transactionStatusPending}},
+		}
+
+		err := updateTransactionStatuses(client, store)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: Expected updateTransactionStatuses to return %v, got: %v", test.name, test.err, err)
+		}
+		// A failed lookup must not mark the transaction as dropped
+		if store.transactions[0].Status != transactionStatusPending {
+			t.Errorf("%s: Unexpected status. Want: %s, got: %s",
+				test.name, transactionStatusPending, store.transactions[0].Status)
+		}
+	}
+}
+
+func TestConfirmations(t *testing.T) {
+	tests := []struct {
+		blockBlueScore                 uint64
+		virtualSelectedParentBlueScore uint64
+		expected                       uint64
+	}{
+		{blockBlueScore: 5, virtualSelectedParentBlueScore: 5, expected: 1},
+		{blockBlueScore: 5, virtualSelectedParentBlueScore: 14, expected: 10},
+		{blockBlueScore: 6, virtualSelectedParentBlueScore: 5, expected: 0},
+	}
+
+	for _, test := range tests {
+		result := confirmations(test.blockBlueScore, test.virtualSelectedParentBlueScore)
+		if result != test.expected {
+			t.Errorf("confirmations(%d, %d): Want: %d, got: %d",
+				test.blockBlueScore, test.virtualSelectedParentBlueScore, test.expected, result)
+		}
+	}
+}
@@ -15,6 +15,12 @@ func main() {
 		err = balance(config.(*balanceConfig))
 	case sendSubCmd:
 		err = send(config.(*sendConfig))
+	case historySubCmd:
+		err = history(config.(*historyConfig))
+	case signMessageSubCmd:
+		err = signMessage(config.(*signMessageConfig))
+	case verifyMessageSubCmd:
+		err = verifyMessage(config.(*verifyMessageConfig))
 	default:
 		err = errors.Errorf("Unknown sub-command '%s'\n", subCmd)
 	}
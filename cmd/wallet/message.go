@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/kaspanet/kaspad/util"
+	"github.com/kaspanet/kaspad/util/message"
+	"github.com/pkg/errors"
+)
+
+func signMessage(conf *signMessageConfig) error {
+	keyPair, publicKey, err := parsePrivateKey(conf.PrivateKey)
+	if err != nil {
+		return err
+	}
+	serializedPublicKey, err := publicKey.Serialize()
+	if err != nil {
+		return err
+	}
+	address, err := util.NewAddressPubKeyHashFromPublicKey(serializedPublicKey[:], conf.ActiveNetParams.Prefix)
+	if err != nil {
+		return err
+	}
+
+	signature, err := message.Sign(keyPair, conf.Message)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Address:\t%s\n", address)
+	fmt.Printf("Signature:\t%s\n", hex.EncodeToString(signature))
+	return nil
+}
+
+func verifyMessage(conf *verifyMessageConfig) error {
+	address, err := util.DecodeAddress(conf.Address, conf.ActiveNetParams.Prefix)
+	if err != nil {
+		return err
+	}
+	signature, err := hex.DecodeString(conf.Signature)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing signature hex")
+	}
+
+	err = message.Verify(address, conf.Message, signature)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("The signature is valid: the message was signed by the owner of %s\n", address)
+	return nil
+}
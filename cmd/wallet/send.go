@@ -13,6 +13,7 @@ import (
 	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/pkg/errors"
+	"time"
 )
 
 const feeSompis uint64 = 1000
@@ -32,7 +33,12 @@ func send(conf *sendConfig) error {
 	if err != nil {
 		return err
 	}
-	fromAddress, err := util.NewAddressPubKeyHashFromPublicKey(serializedPublicKey[:], conf.ActiveNetParams.Prefix)
+	var fromAddress util.Address
+	if conf.PayToPubKey {
+		fromAddress, err = util.NewAddressPubKey(serializedPublicKey[:], conf.ActiveNetParams.Prefix)
+	} else {
+		fromAddress, err = util.NewAddressPubKeyHashFromPublicKey(serializedPublicKey[:], conf.ActiveNetParams.Prefix)
+	}
 	if err != nil {
 		return err
 	}
@@ -59,6 +65,17 @@ func send(conf *sendConfig) error {
 		return err
 	}
 
+	store, err := openTransactionStore(conf.WalletDir, conf.ActiveNetParams.Name)
+	if err != nil {
+		return err
+	}
+	// The selected tip is fetched before the transaction is submitted, so that
+	// the block accepting it is guaranteed to be found in the chain above it
+	getSelectedTipHashResponse, err := client.GetSelectedTipHash()
+	if err != nil {
+		return err
+	}
+
 	transactionID, err := sendTransaction(client, rpcTransaction)
 	if err != nil {
 		return err
@@ -67,6 +84,21 @@ func send(conf *sendConfig) error {
 	fmt.Println("Transaction was sent successfully")
 	fmt.Printf("Transaction ID: \t%s\n", transactionID)
 
+	store.add(&sentTransaction{
+		TransactionID: transactionID,
+		FromAddress:   fromAddress.String(),
+		ToAddress:     toAddress.String(),
+		Amount:        sendAmountSompi,
+		Fee:           feeSompis,
+		SentAt:        time.Now().UnixNano() / int64(time.Millisecond),
+		StartHash:     getSelectedTipHashResponse.SelectedTipHash,
+		Status:        transactionStatusPending,
+	})
+	err = store.save()
+	if err != nil {
+		return errors.Wrap(err, "Transaction was sent, but could not be recorded in the wallet history")
+	}
+
 	return nil
 }
 
@@ -180,8 +212,12 @@ func generateTransaction(keyPair *secp256k1.SchnorrKeyPair, selectedUTXOs []*app
 		PayloadHash:  externalapi.DomainHash{},
 	}
 
+	signatureScriptFunc := txscript.SignatureScript
+	if _, ok := fromAddress.(*util.AddressPubKey); ok {
+		signatureScriptFunc = txscript.PubKeySignatureScript
+	}
 	for i, input := range domainTransaction.Inputs {
-		signatureScript, err := txscript.SignatureScript(domainTransaction, i, fromScript, txscript.SigHashAll, keyPair)
+		signatureScript, err := signatureScriptFunc(domainTransaction, i, fromScript, txscript.SigHashAll, keyPair)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+const sentTransactionsFilename = "sent-transactions.json"
+
+var defaultWalletDir = util.AppDataDir("kaspawallet", false)
+
+type transactionStatus string
+
+const (
+	transactionStatusPending  transactionStatus = "pending"
+	transactionStatusMempool  transactionStatus = "in mempool"
+	transactionStatusAccepted transactionStatus = "accepted"
+	transactionStatusDropped  transactionStatus = "dropped"
+)
+
+// sentTransaction is the local record of a transaction that was
+// broadcast by this wallet
+type sentTransaction struct {
+	TransactionID string `json:"transactionId"`
+	FromAddress   string `json:"fromAddress"`
+	ToAddress     string `json:"toAddress"`
+	Amount        uint64 `json:"amount"`
+	Fee           uint64 `json:"fee"`
+	SentAt        int64  `json:"sentAt"`
+
+	// StartHash is the selected tip at the time the transaction was
+	// sent. The selected parent chain is scanned from this block when
+	// looking for the block that accepted the transaction.
+	StartHash string `json:"startHash"`
+
+	Status                  transactionStatus `json:"status"`
+	AcceptingBlockHash      string            `json:"acceptingBlockHash,omitempty"`
+	AcceptingBlockBlueScore uint64            `json:"acceptingBlockBlueScore,omitempty"`
+}
+
+// transactionStore keeps a record of sent transactions in a JSON file
+// inside the wallet directory of the active network
+type transactionStore struct {
+	path         string
+	transactions []*sentTransaction
+}
+
+func openTransactionStore(walletDir string, networkName string) (*transactionStore, error) {
+	path := filepath.Join(walletDir, networkName, sentTransactionsFilename)
+	store := &transactionStore{
+		path:         path,
+		transactions: []*sentTransaction{},
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return store, nil
+		}
+		return nil, errors.Wrapf(err, "error reading %s", path)
+	}
+	err = json.Unmarshal(content, &store.transactions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s", path)
+	}
+	return store, nil
+}
+
+func (ts *transactionStore) add(transaction *sentTransaction) {
+	ts.transactions = append(ts.transactions, transaction)
+}
+
+func (ts *transactionStore) save() error {
+	content, err := json.MarshalIndent(ts.transactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(ts.path), 0700)
+	if err != nil {
+		return errors.Wrapf(err, "error creating wallet directory")
+	}
+
+	// Write to a temporary file first so that a crash mid-write
+	// doesn't corrupt the existing record
+	temporaryPath := ts.path + ".tmp"
+	err = ioutil.WriteFile(temporaryPath, content, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "error writing %s", temporaryPath)
+	}
+	return os.Rename(temporaryPath, ts.path)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTransactionStore(t *testing.T) {
+	walletDir, err := ioutil.TempDir("", "TestTransactionStore")
+	if err != nil {
+		t.Fatalf("TempDir: %+v", err)
+	}
+	defer os.RemoveAll(walletDir)
+
+	store, err := openTransactionStore(walletDir, "kaspa-simnet")
+	if err != nil {
+		t.Fatalf("openTransactionStore: %+v", err)
+	}
+	if len(store.transactions) != 0 {
+		t.Fatalf("Expected a new store to be empty, got %d transactions", len(store.transactions))
+	}
+
+	transaction := &sentTransaction{
+		TransactionID:           "transactionID",
+		FromAddress:             "fromAddress",
+		ToAddress:               "toAddress",
+		Amount:                  100,
+		Fee:                     1,
+		SentAt:                  1234,
+		StartHash:               "startHash",
+		Status:                  transactionStatusAccepted,
+		AcceptingBlockHash:      "acceptingBlockHash",
+		AcceptingBlockBlueScore: 5,
+	}
+	store.add(transaction)
+	err = store.save()
+	if err != nil {
+		t.Fatalf("save: %+v", err)
+	}
+
+	reopenedStore, err := openTransactionStore(walletDir, "kaspa-simnet")
+	if err != nil {
+		t.Fatalf("openTransactionStore: %+v", err)
+	}
+	if !reflect.DeepEqual(reopenedStore.transactions, []*sentTransaction{transaction}) {
+		t.Fatalf("Unexpected transactions after reopening. Want: %+v, got: %+v",
+			transaction, reopenedStore.transactions)
+	}
+
+	// Transactions are kept per network
+	otherNetworkStore, err := openTransactionStore(walletDir, "kaspa-testnet")
+	if err != nil {
+		t.Fatalf("openTransactionStore: %+v", err)
+	}
+	if len(otherNetworkStore.transactions) != 0 {
+		t.Fatalf("Expected the store of another network to be empty, got %d transactions",
+			len(otherNetworkStore.transactions))
+	}
+}
+
+func TestTransactionStoreCorruptFile(t *testing.T) {
+	walletDir, err := ioutil.TempDir("", "TestTransactionStoreCorruptFile")
+	if err != nil {
+		t.Fatalf("TempDir: %+v", err)
+	}
+	defer os.RemoveAll(walletDir)
+
+	path := filepath.Join(walletDir, "kaspa-simnet", sentTransactionsFilename)
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		t.Fatalf("MkdirAll: %+v", err)
+	}
+	err = ioutil.WriteFile(path, []byte("not json"), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+
+	_, err = openTransactionStore(walletDir, "kaspa-simnet")
+	if err == nil {
+		t.Fatalf("Expected openTransactionStore to fail on a corrupt file")
+	}
+}
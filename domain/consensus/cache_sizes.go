@@ -0,0 +1,110 @@
+package consensus
+
+import (
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+)
+
+// CacheSizes are the number of entries each of the consensus stores
+// keeps in its in-memory cache
+type CacheSizes struct {
+	AcceptanceData       int
+	Block                int
+	BlockHeader          int
+	BlockRelation        int
+	BlockStatus          int
+	Multiset             int
+	ReachabilityData     int
+	UTXODiff             int
+	VirtualUTXOSet       int
+	GHOSTDAGData         int
+	Finality             int
+	HeadersSelectedChain int
+}
+
+const (
+	// The part of the memory budget that goes to the database cache.
+	// The rest is split between the consensus stores.
+	databaseCacheBudgetDivisor = 4
+
+	// defaultBudgetMiB is the default --cachesize. With this budget the
+	// consensus stores get DefaultCacheSizes and the database gets a
+	// 256 MiB cache.
+	defaultBudgetMiB = 1024
+
+	minDatabaseCacheSizeMiB = 8
+
+	// Caches smaller than this are more overhead than help
+	minCacheSize = 16
+)
+
+// DefaultCacheSizes returns the cache sizes that fit the given DAG params
+// without regard to memory usage
+func DefaultCacheSizes(dagParams *dagconfig.Params) *CacheSizes {
+	pruningWindowSize := int(dagParams.PruningDepth())
+
+	// This is used for caches that are used as part of deletePastBlocks that need to traverse until
+	// the previous pruning point.
+	pruningWindowSizePlusFinalityDepth := int(dagParams.PruningDepth() + dagParams.FinalityDepth())
+
+	return &CacheSizes{
+		AcceptanceData:       200,
+		Block:                200,
+		BlockHeader:          10_000,
+		BlockRelation:        pruningWindowSizePlusFinalityDepth,
+		BlockStatus:          pruningWindowSizePlusFinalityDepth,
+		Multiset:             200,
+		ReachabilityData:     pruningWindowSizePlusFinalityDepth,
+		UTXODiff:             200,
+		VirtualUTXOSet:       10_000,
+		GHOSTDAGData:         pruningWindowSize,
+		Finality:             200,
+		HeadersSelectedChain: pruningWindowSize,
+	}
+}
+
+// CacheSizesForBudget splits the given memory budget, in MiB, between the
+// consensus store caches and the database cache. The store caches are
+// DefaultCacheSizes scaled by the ratio between their share of the budget
+// and their share of the default budget.
+func CacheSizesForBudget(dagParams *dagconfig.Params, budgetMiB int) (
+	cacheSizes *CacheSizes, databaseCacheSizeMiB int) {
+
+	databaseCacheSizeMiB = budgetMiB / databaseCacheBudgetDivisor
+	if databaseCacheSizeMiB < minDatabaseCacheSizeMiB {
+		databaseCacheSizeMiB = minDatabaseCacheSizeMiB
+	}
+
+	storesBudgetMiB := budgetMiB - databaseCacheSizeMiB
+	if storesBudgetMiB < 0 {
+		storesBudgetMiB = 0
+	}
+	defaultStoresBudgetMiB := defaultBudgetMiB - defaultBudgetMiB/databaseCacheBudgetDivisor
+	scale := float64(storesBudgetMiB) / float64(defaultStoresBudgetMiB)
+	defaultCacheSizes := DefaultCacheSizes(dagParams)
+
+	scaled := func(defaultSize int) int {
+		size := int(float64(defaultSize) * scale)
+		if size < minCacheSize && size < defaultSize {
+			if defaultSize < minCacheSize {
+				return defaultSize
+			}
+			return minCacheSize
+		}
+		return size
+	}
+
+	return &CacheSizes{
+		AcceptanceData:       scaled(defaultCacheSizes.AcceptanceData),
+		Block:                scaled(defaultCacheSizes.Block),
+		BlockHeader:          scaled(defaultCacheSizes.BlockHeader),
+		BlockRelation:        scaled(defaultCacheSizes.BlockRelation),
+		BlockStatus:          scaled(defaultCacheSizes.BlockStatus),
+		Multiset:             scaled(defaultCacheSizes.Multiset),
+		ReachabilityData:     scaled(defaultCacheSizes.ReachabilityData),
+		UTXODiff:             scaled(defaultCacheSizes.UTXODiff),
+		VirtualUTXOSet:       scaled(defaultCacheSizes.VirtualUTXOSet),
+		GHOSTDAGData:         scaled(defaultCacheSizes.GHOSTDAGData),
+		Finality:             scaled(defaultCacheSizes.Finality),
+		HeadersSelectedChain: scaled(defaultCacheSizes.HeadersSelectedChain),
+	}, databaseCacheSizeMiB
+}
@@ -0,0 +1,72 @@
+package consensus
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+)
+
+func TestCacheSizesForBudget(t *testing.T) {
+	// The default budget must give the cache sizes that were used
+	// before the budget was configurable
+	if defaultBudgetMiB != config.DefaultConfig().CacheSizeMiB {
+		t.Fatalf("defaultBudgetMiB is %d MiB while the default --cachesize is %d MiB",
+			defaultBudgetMiB, config.DefaultConfig().CacheSizeMiB)
+	}
+	for _, params := range []*dagconfig.Params{&dagconfig.MainnetParams, &dagconfig.SimnetParams} {
+		cacheSizes, databaseCacheSizeMiB := CacheSizesForBudget(params, defaultBudgetMiB)
+		if databaseCacheSizeMiB != 256 {
+			t.Fatalf("%s: unexpected database cache size. Want: 256 MiB, got: %d MiB",
+				params.Name, databaseCacheSizeMiB)
+		}
+		if !reflect.DeepEqual(cacheSizes, DefaultCacheSizes(params)) {
+			t.Fatalf("%s: unexpected cache sizes. Want: %+v, got: %+v",
+				params.Name, DefaultCacheSizes(params), cacheSizes)
+		}
+	}
+
+	mainnetCacheSizes, _ := CacheSizesForBudget(&dagconfig.MainnetParams, defaultBudgetMiB)
+	if mainnetCacheSizes.GHOSTDAGData != 244838 || mainnetCacheSizes.ReachabilityData != 331238 {
+		t.Fatalf("Unexpected mainnet cache sizes. Want: GHOSTDAG data 244838, reachability data 331238, "+
+			"got: GHOSTDAG data %d, reachability data %d",
+			mainnetCacheSizes.GHOSTDAGData, mainnetCacheSizes.ReachabilityData)
+	}
+
+	// Other budgets scale the store caches by their share of the budget
+	doubleCacheSizes, databaseCacheSizeMiB := CacheSizesForBudget(&dagconfig.MainnetParams, 2*defaultBudgetMiB)
+	if databaseCacheSizeMiB != 512 {
+		t.Fatalf("Unexpected database cache size. Want: 512 MiB, got: %d MiB", databaseCacheSizeMiB)
+	}
+	if doubleCacheSizes.BlockHeader != 2*mainnetCacheSizes.BlockHeader {
+		t.Fatalf("Unexpected block header cache size. Want: %d, got: %d",
+			2*mainnetCacheSizes.BlockHeader, doubleCacheSizes.BlockHeader)
+	}
+}
+
+func TestCacheSizesForBudgetKeepsProportions(t *testing.T) {
+	params := &dagconfig.MainnetParams
+	smallCacheSizes, _ := CacheSizesForBudget(params, 1024)
+	largeCacheSizes, _ := CacheSizesForBudget(params, 4096)
+
+	if largeCacheSizes.GHOSTDAGData <= smallCacheSizes.GHOSTDAGData*3 {
+		t.Fatalf("The GHOSTDAG data cache didn't grow with the budget. 1024 MiB: %d, 4096 MiB: %d",
+			smallCacheSizes.GHOSTDAGData, largeCacheSizes.GHOSTDAGData)
+	}
+	if smallCacheSizes.BlockStatus != smallCacheSizes.BlockRelation {
+		t.Fatalf("Caches of equal default sizes got different sizes. Block status: %d, block relation: %d",
+			smallCacheSizes.BlockStatus, smallCacheSizes.BlockRelation)
+	}
+}
+
+func TestCacheSizesForBudgetMinimums(t *testing.T) {
+	cacheSizes, databaseCacheSizeMiB := CacheSizesForBudget(&dagconfig.MainnetParams, 1)
+	if databaseCacheSizeMiB != minDatabaseCacheSizeMiB {
+		t.Fatalf("Unexpected database cache size. Want: %d MiB, got: %d MiB",
+			minDatabaseCacheSizeMiB, databaseCacheSizeMiB)
+	}
+	if cacheSizes.Block != minCacheSize {
+		t.Fatalf("Unexpected block cache size. Want: %d, got: %d", minCacheSize, cacheSizes.Block)
+	}
+}
@@ -157,6 +157,16 @@ func (s *consensus) GetBlockInfo(blockHash *externalapi.DomainHash) (*externalap
 	return blockInfo, nil
 }
 
+// HasBlockBody returns whether the transactions of the given block are
+// stored. Blocks below the pruning point are StatusHeaderOnly, but archival
+// nodes keep their bodies.
+func (s *consensus) HasBlockBody(blockHash *externalapi.DomainHash) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.blockStore.HasBlock(s.databaseContext, blockHash)
+}
+
 func (s *consensus) GetBlockChildren(blockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error) {
 	blockRelation, err := s.blockRelationStore.BlockRelation(s.databaseContext, blockHash)
 	if err != nil {
@@ -448,3 +458,41 @@ func (s *consensus) Anticone(blockHash *externalapi.DomainHash) ([]*externalapi.
 
 	return s.dagTraversalManager.Anticone(blockHash)
 }
+
+// VerifyAndRepairVirtualState verifies that the virtual state is consistent
+// with the rest of the stored consensus data, and rebuilds the virtual UTXO
+// set if it isn't. It returns an error if the state can't be repaired.
+func (s *consensus) VerifyAndRepairVirtualState() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.consensusStateManager.VerifyAndRepairVirtualState()
+}
+
+// GetCacheStats returns the sizes and hit/miss counters of the in-memory
+// caches of the consensus stores
+func (s *consensus) GetCacheStats() []*externalapi.CacheStats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	cachedStores := []model.CachedStore{
+		s.acceptanceDataStore,
+		s.blockStore,
+		s.blockHeaderStore,
+		s.ghostdagDataStore,
+		s.blockRelationStore,
+		s.blockStatusStore,
+		s.consensusStateStore,
+		s.multisetStore,
+		s.reachabilityDataStore,
+		s.utxoDiffStore,
+		s.finalityStore,
+		s.headersSelectedChainStore,
+	}
+
+	var cacheStats []*externalapi.CacheStats
+	for _, store := range cachedStores {
+		cacheStats = append(cacheStats, store.CacheStats()...)
+	}
+	return cacheStats
+}
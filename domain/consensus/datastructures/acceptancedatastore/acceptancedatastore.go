@@ -36,6 +36,10 @@ func (ads *acceptanceDataStore) IsStaged() bool {
 	return len(ads.staging) != 0 || len(ads.toDelete) != 0
 }
 
+func (ads *acceptanceDataStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{ads.cache.Stats("acceptance-data")}
+}
+
 func (ads *acceptanceDataStore) Discard() {
 	ads.staging = make(map[externalapi.DomainHash]externalapi.AcceptanceData)
 	ads.toDelete = make(map[externalapi.DomainHash]struct{})
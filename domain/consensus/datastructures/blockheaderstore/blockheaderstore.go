@@ -65,6 +65,10 @@ func (bhs *blockHeaderStore) IsStaged() bool {
 	return len(bhs.staging) != 0 || len(bhs.toDelete) != 0
 }
 
+func (bhs *blockHeaderStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{bhs.cache.Stats("block-headers")}
+}
+
 func (bhs *blockHeaderStore) Discard() {
 	bhs.staging = make(map[externalapi.DomainHash]externalapi.BlockHeader)
 	bhs.toDelete = make(map[externalapi.DomainHash]struct{})
@@ -33,6 +33,10 @@ func (brs *blockRelationStore) IsStaged() bool {
 	return len(brs.staging) != 0
 }
 
+func (brs *blockRelationStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{brs.cache.Stats("block-relations")}
+}
+
 func (brs *blockRelationStore) Discard() {
 	brs.staging = make(map[externalapi.DomainHash]*model.BlockRelations)
 }
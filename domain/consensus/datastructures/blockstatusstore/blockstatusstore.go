@@ -34,6 +34,10 @@ func (bss *blockStatusStore) IsStaged() bool {
 	return len(bss.staging) != 0
 }
 
+func (bss *blockStatusStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{bss.cache.Stats("block-statuses")}
+}
+
 func (bss *blockStatusStore) Discard() {
 	bss.staging = make(map[externalapi.DomainHash]externalapi.BlockStatus)
 }
@@ -66,6 +66,10 @@ func (bs *blockStore) IsStaged() bool {
 	return len(bs.staging) != 0 || len(bs.toDelete) != 0
 }
 
+func (bs *blockStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{bs.cache.Stats("blocks")}
+}
+
 func (bs *blockStore) Discard() {
 	bs.staging = make(map[externalapi.DomainHash]*externalapi.DomainBlock)
 	bs.toDelete = make(map[externalapi.DomainHash]struct{})
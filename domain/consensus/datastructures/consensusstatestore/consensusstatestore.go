@@ -23,6 +23,10 @@ func New(utxoSetCacheSize int, preallocate bool) model.ConsensusStateStore {
 	}
 }
 
+func (css *consensusStateStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{css.virtualUTXOSetCache.Stats("virtual-utxo-set")}
+}
+
 func (css *consensusStateStore) Discard() {
 	css.tipsStaging = nil
 	css.virtualUTXODiffStaging = nil
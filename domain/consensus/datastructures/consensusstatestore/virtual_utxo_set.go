@@ -37,6 +37,27 @@ func (css *consensusStateStore) ImportPruningPointUTXOSetIntoVirtualUTXOSet(dbCo
 			"without calling StartImportingPruningPointUTXOSet first")
 	}
 
+	return css.replaceVirtualUTXOSet(dbContext, pruningPointUTXOSetIterator)
+}
+
+// ReplaceVirtualUTXOSet overwrites the virtual UTXO set with the given UTXO set.
+// It's used to repair a virtual UTXO set that is inconsistent with the rest of
+// the consensus state, so unlike the pruning point import it doesn't require
+// any marker: if it's interrupted, the inconsistency is detected again on the
+// next startup.
+func (css *consensusStateStore) ReplaceVirtualUTXOSet(dbContext model.DBWriter,
+	utxoSetIterator externalapi.ReadOnlyUTXOSetIterator) error {
+
+	if css.virtualUTXODiffStaging != nil {
+		return errors.New("cannot replace virtual UTXO set while virtual UTXO diff is staged")
+	}
+
+	return css.replaceVirtualUTXOSet(dbContext, utxoSetIterator)
+}
+
+func (css *consensusStateStore) replaceVirtualUTXOSet(dbContext model.DBWriter,
+	utxoSetIterator externalapi.ReadOnlyUTXOSetIterator) error {
+
 	// Clear the cache
 	css.virtualUTXOSetCache.Clear()
 
@@ -58,8 +79,8 @@ func (css *consensusStateStore) ImportPruningPointUTXOSetIntoVirtualUTXOSet(dbCo
 	}
 
 	// Insert all the new UTXOs into the database
-	for ok := pruningPointUTXOSetIterator.First(); ok; ok = pruningPointUTXOSetIterator.Next() {
-		outpoint, entry, err := pruningPointUTXOSetIterator.Get()
+	for ok := utxoSetIterator.First(); ok; ok = utxoSetIterator.Next() {
+		outpoint, entry, err := utxoSetIterator.Get()
 		if err != nil {
 			return err
 		}
@@ -51,6 +51,10 @@ func (fs *finalityStore) FinalityPoint(
 	return finalityPointHash, nil
 }
 
+func (fs *finalityStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{fs.cache.Stats("finality-points")}
+}
+
 func (fs *finalityStore) Discard() {
 	fs.staging = make(map[externalapi.DomainHash]*externalapi.DomainHash)
 }
@@ -34,6 +34,10 @@ func (gds *ghostdagDataStore) IsStaged() bool {
 	return len(gds.staging) != 0
 }
 
+func (gds *ghostdagDataStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{gds.cache.Stats("block-ghostdag-data")}
+}
+
 func (gds *ghostdagDataStore) Discard() {
 	gds.staging = make(map[externalapi.DomainHash]*model.BlockGHOSTDAGData)
 }
@@ -37,6 +37,13 @@ func New(cacheSize int, preallocate bool) model.HeadersSelectedChainStore {
 	}
 }
 
+func (hscs *headersSelectedChainStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{
+		hscs.cacheByIndex.Stats("chain-block-hash-by-index"),
+		hscs.cacheByHash.Stats("chain-block-index-by-hash"),
+	}
+}
+
 // Stage stages the given chain changes
 func (hscs *headersSelectedChainStore) Stage(dbContext model.DBReader,
 	chainChanges *externalapi.SelectedChainPath) error {
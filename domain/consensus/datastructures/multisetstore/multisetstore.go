@@ -36,6 +36,10 @@ func (ms *multisetStore) IsStaged() bool {
 	return len(ms.staging) != 0 || len(ms.toDelete) != 0
 }
 
+func (ms *multisetStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{ms.cache.Stats("multisets")}
+}
+
 func (ms *multisetStore) Discard() {
 	ms.staging = make(map[externalapi.DomainHash]model.Multiset)
 	ms.toDelete = make(map[externalapi.DomainHash]struct{})
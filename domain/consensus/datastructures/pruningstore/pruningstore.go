@@ -247,6 +247,14 @@ func (ps *pruningStore) PruningPointUTXOs(dbContext model.DBReader,
 	return outpointAndUTXOEntryPairs, nil
 }
 
+func (ps *pruningStore) PruningPointUTXOIterator(dbContext model.DBReader) (externalapi.ReadOnlyUTXOSetIterator, error) {
+	cursor, err := dbContext.Cursor(pruningPointUTXOSetBucket)
+	if err != nil {
+		return nil, err
+	}
+	return ps.newCursorUTXOSetIterator(cursor), nil
+}
+
 func (ps *pruningStore) StageStartUpdatingPruningPointUTXOSet() {
 	ps.startUpdatingPruningPointUTXOSetStaging = true
 }
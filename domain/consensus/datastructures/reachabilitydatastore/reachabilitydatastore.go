@@ -44,6 +44,10 @@ func (rds *reachabilityDataStore) IsAnythingStaged() bool {
 	return len(rds.reachabilityDataStaging) != 0 || rds.reachabilityReindexRootStaging != nil
 }
 
+func (rds *reachabilityDataStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{rds.reachabilityDataCache.Stats("reachability-data")}
+}
+
 func (rds *reachabilityDataStore) Discard() {
 	rds.reachabilityDataStaging = make(map[externalapi.DomainHash]model.ReachabilityData)
 	rds.reachabilityReindexRootStaging = nil
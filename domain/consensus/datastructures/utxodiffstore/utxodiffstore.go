@@ -54,6 +54,13 @@ func (uds *utxoDiffStore) IsBlockHashStaged(blockHash *externalapi.DomainHash) b
 	return ok
 }
 
+func (uds *utxoDiffStore) CacheStats() []*externalapi.CacheStats {
+	return []*externalapi.CacheStats{
+		uds.utxoDiffCache.Stats("utxo-diffs"),
+		uds.utxoDiffChildCache.Stats("utxo-diff-children"),
+	}
+}
+
 func (uds *utxoDiffStore) Discard() {
 	uds.utxoDiffStaging = make(map[externalapi.DomainHash]externalapi.UTXODiff)
 	uds.utxoDiffChildStaging = make(map[externalapi.DomainHash]*externalapi.DomainHash)
@@ -54,8 +54,8 @@ const (
 
 // Factory instantiates new Consensuses
 type Factory interface {
-	NewConsensus(dagParams *dagconfig.Params, db infrastructuredatabase.Database, isArchivalNode bool) (
-		externalapi.Consensus, error)
+	NewConsensus(dagParams *dagconfig.Params, db infrastructuredatabase.Database, isArchivalNode bool,
+		cacheSizes *CacheSizes) (externalapi.Consensus, error)
 	NewTestConsensus(dagParams *dagconfig.Params, isArchivalNode bool, testName string) (
 		tc testapi.TestConsensus, teardown func(keepDataDir bool), err error)
 
@@ -86,13 +86,11 @@ func NewFactory() Factory {
 }
 
 // NewConsensus instantiates a new Consensus
-func (f *factory) NewConsensus(dagParams *dagconfig.Params, db infrastructuredatabase.Database, isArchivalNode bool) (
-	externalapi.Consensus, error) {
+func (f *factory) NewConsensus(dagParams *dagconfig.Params, db infrastructuredatabase.Database, isArchivalNode bool,
+	cacheSizes *CacheSizes) (externalapi.Consensus, error) {
 
 	dbManager := consensusdatabase.New(db)
 
-	pruningWindowSizeForCaches := int(dagParams.PruningDepth())
-
 	var preallocateCaches bool
 	if f.preallocateCaches != nil {
 		preallocateCaches = *f.preallocateCaches
@@ -100,32 +98,28 @@ func (f *factory) NewConsensus(dagParams *dagconfig.Params, db infrastructuredat
 		preallocateCaches = defaultPreallocateCaches
 	}
 
-	// This is used for caches that are used as part of deletePastBlocks that need to traverse until
-	// the previous pruning point.
-	pruningWindowSizePlusFinalityDepthForCache := int(dagParams.PruningDepth() + dagParams.FinalityDepth())
-
 	// Data Structures
-	acceptanceDataStore := acceptancedatastore.New(200, preallocateCaches)
-	blockStore, err := blockstore.New(dbManager, 200, preallocateCaches)
+	acceptanceDataStore := acceptancedatastore.New(cacheSizes.AcceptanceData, preallocateCaches)
+	blockStore, err := blockstore.New(dbManager, cacheSizes.Block, preallocateCaches)
 	if err != nil {
 		return nil, err
 	}
-	blockHeaderStore, err := blockheaderstore.New(dbManager, 10_000, preallocateCaches)
+	blockHeaderStore, err := blockheaderstore.New(dbManager, cacheSizes.BlockHeader, preallocateCaches)
 	if err != nil {
 		return nil, err
 	}
-	blockRelationStore := blockrelationstore.New(pruningWindowSizePlusFinalityDepthForCache, preallocateCaches)
+	blockRelationStore := blockrelationstore.New(cacheSizes.BlockRelation, preallocateCaches)
 
-	blockStatusStore := blockstatusstore.New(pruningWindowSizePlusFinalityDepthForCache, preallocateCaches)
-	multisetStore := multisetstore.New(200, preallocateCaches)
+	blockStatusStore := blockstatusstore.New(cacheSizes.BlockStatus, preallocateCaches)
+	multisetStore := multisetstore.New(cacheSizes.Multiset, preallocateCaches)
 	pruningStore := pruningstore.New()
-	reachabilityDataStore := reachabilitydatastore.New(pruningWindowSizePlusFinalityDepthForCache, preallocateCaches)
-	utxoDiffStore := utxodiffstore.New(200, preallocateCaches)
-	consensusStateStore := consensusstatestore.New(10_000, preallocateCaches)
-	ghostdagDataStore := ghostdagdatastore.New(pruningWindowSizeForCaches, preallocateCaches)
+	reachabilityDataStore := reachabilitydatastore.New(cacheSizes.ReachabilityData, preallocateCaches)
+	utxoDiffStore := utxodiffstore.New(cacheSizes.UTXODiff, preallocateCaches)
+	consensusStateStore := consensusstatestore.New(cacheSizes.VirtualUTXOSet, preallocateCaches)
+	ghostdagDataStore := ghostdagdatastore.New(cacheSizes.GHOSTDAGData, preallocateCaches)
 	headersSelectedTipStore := headersselectedtipstore.New()
-	finalityStore := finalitystore.New(200, preallocateCaches)
-	headersSelectedChainStore := headersselectedchainstore.New(pruningWindowSizeForCaches, preallocateCaches)
+	finalityStore := finalitystore.New(cacheSizes.Finality, preallocateCaches)
+	headersSelectedChainStore := headersselectedchainstore.New(cacheSizes.HeadersSelectedChain, preallocateCaches)
 
 	// Processes
 	reachabilityManager := reachabilitymanager.New(
@@ -433,7 +427,7 @@ func (f *factory) NewTestConsensus(dagParams *dagconfig.Params, isArchivalNode b
 	if err != nil {
 		return nil, nil, err
 	}
-	consensusAsInterface, err := f.NewConsensus(dagParams, db, isArchivalNode)
+	consensusAsInterface, err := f.NewConsensus(dagParams, db, isArchivalNode, DefaultCacheSizes(dagParams))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -23,7 +23,7 @@ func TestNewConsensus(t *testing.T) {
 		t.Fatalf("error in NewLevelDB: %s", err)
 	}
 
-	_, err = f.NewConsensus(dagParams, db, false)
+	_, err = f.NewConsensus(dagParams, db, false, DefaultCacheSizes(dagParams))
 	if err != nil {
 		t.Fatalf("error in NewConsensus: %+v", err)
 	}
@@ -0,0 +1,11 @@
+package externalapi
+
+// CacheStats holds the size of an in-memory cache and the number of
+// lookups that were and weren't served from it
+type CacheStats struct {
+	Name     string
+	Size     int
+	Capacity int
+	Hits     uint64
+	Misses   uint64
+}
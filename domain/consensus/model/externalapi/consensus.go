@@ -9,6 +9,7 @@ type Consensus interface {
 	GetBlock(blockHash *DomainHash) (*DomainBlock, error)
 	GetBlockHeader(blockHash *DomainHash) (BlockHeader, error)
 	GetBlockInfo(blockHash *DomainHash) (*BlockInfo, error)
+	HasBlockBody(blockHash *DomainHash) (bool, error)
 	GetBlockChildren(blockHash *DomainHash) ([]*DomainHash, error)
 	GetBlockAcceptanceData(blockHash *DomainHash) (AcceptanceData, error)
 
@@ -32,4 +33,6 @@ type Consensus interface {
 	IsInSelectedParentChainOf(blockHashA *DomainHash, blockHashB *DomainHash) (bool, error)
 	GetHeadersSelectedTip() (*DomainHash, error)
 	Anticone(blockHash *DomainHash) ([]*DomainHash, error)
+	VerifyAndRepairVirtualState() error
+	GetCacheStats() []*CacheStats
 }
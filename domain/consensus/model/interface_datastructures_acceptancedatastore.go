@@ -4,7 +4,7 @@ import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 // AcceptanceDataStore represents a store of AcceptanceData
 type AcceptanceDataStore interface {
-	Store
+	CachedStore
 	Stage(blockHash *externalapi.DomainHash, acceptanceData externalapi.AcceptanceData)
 	IsStaged() bool
 	Get(dbContext DBReader, blockHash *externalapi.DomainHash) (externalapi.AcceptanceData, error)
@@ -4,7 +4,7 @@ import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 // BlockHeaderStore represents a store of block headers
 type BlockHeaderStore interface {
-	Store
+	CachedStore
 	Stage(blockHash *externalapi.DomainHash, blockHeader externalapi.BlockHeader)
 	IsStaged() bool
 	BlockHeader(dbContext DBReader, blockHash *externalapi.DomainHash) (externalapi.BlockHeader, error)
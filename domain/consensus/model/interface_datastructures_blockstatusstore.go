@@ -4,7 +4,7 @@ import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 // BlockStatusStore represents a store of BlockStatuses
 type BlockStatusStore interface {
-	Store
+	CachedStore
 	Stage(blockHash *externalapi.DomainHash, blockStatus externalapi.BlockStatus)
 	IsStaged() bool
 	Get(dbContext DBReader, blockHash *externalapi.DomainHash) (externalapi.BlockStatus, error)
@@ -4,7 +4,7 @@ import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 // ConsensusStateStore represents a store for the current consensus state
 type ConsensusStateStore interface {
-	Store
+	CachedStore
 	IsStaged() bool
 
 	StageVirtualUTXODiff(virtualUTXODiff externalapi.UTXODiff)
@@ -21,4 +21,5 @@ type ConsensusStateStore interface {
 	HadStartedImportingPruningPointUTXOSet(dbContext DBWriter) (bool, error)
 	ImportPruningPointUTXOSetIntoVirtualUTXOSet(dbContext DBWriter, pruningPointUTXOSetIterator externalapi.ReadOnlyUTXOSetIterator) error
 	FinishImportingPruningPointUTXOSet(dbContext DBWriter) error
+	ReplaceVirtualUTXOSet(dbContext DBWriter, utxoSetIterator externalapi.ReadOnlyUTXOSetIterator) error
 }
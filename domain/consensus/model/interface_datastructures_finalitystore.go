@@ -6,7 +6,7 @@ import (
 
 // FinalityStore represents a store for finality data
 type FinalityStore interface {
-	Store
+	CachedStore
 	IsStaged() bool
 	StageFinalityPoint(blockHash *externalapi.DomainHash, finalityPointHash *externalapi.DomainHash)
 	FinalityPoint(dbContext DBReader, blockHash *externalapi.DomainHash) (*externalapi.DomainHash, error)
@@ -4,7 +4,7 @@ import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 // HeadersSelectedChainStore represents a store of the headers selected chain
 type HeadersSelectedChainStore interface {
-	Store
+	CachedStore
 	Stage(dbContext DBReader,
 		chainChanges *externalapi.SelectedChainPath) error
 	IsStaged() bool
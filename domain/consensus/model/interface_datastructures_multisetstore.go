@@ -4,7 +4,7 @@ import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 // MultisetStore represents a store of Multisets
 type MultisetStore interface {
-	Store
+	CachedStore
 	Stage(blockHash *externalapi.DomainHash, multiset Multiset)
 	IsStaged() bool
 	Get(dbContext DBReader, blockHash *externalapi.DomainHash) (Multiset, error)
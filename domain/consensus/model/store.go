@@ -1,7 +1,15 @@
 package model
 
+import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+
 // Store is a common interface for data stores
 type Store interface {
 	Discard()
 	Commit(dbTx DBTransaction) error
 }
+
+// CachedStore is a Store that keeps some of its data in in-memory caches
+type CachedStore interface {
+	Store
+	CacheStats() []*externalapi.CacheStats
+}
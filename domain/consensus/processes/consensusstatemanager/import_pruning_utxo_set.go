@@ -131,6 +131,12 @@ func (csm *consensusStateManager) importPruningPoint(newPruningPoint *externalap
 
 	log.Debugf("Staging the new pruning point multiset")
 	csm.multisetStore.Stage(newPruningPointHash, importedPruningPointMultiset)
+
+	// The virtual UTXO set is replaced by the pruning point UTXO set, so
+	// the virtual multiset is updated to match it until the virtual is
+	// resolved again
+	log.Debugf("Staging the pruning point multiset as the virtual multiset")
+	csm.multisetStore.Stage(model.VirtualBlockHash, importedPruningPointMultiset)
 	return nil
 }
 
@@ -0,0 +1,199 @@
+package consensusstatemanager
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// VerifyAndRepairVirtualState verifies that the data of the virtual's
+// selected parent is fully stored, and that the virtual UTXO set matches
+// the virtual multiset. A virtual UTXO set that doesn't match is rebuilt
+// from the pruning point UTXO set and the UTXO diffs between the pruning
+// point and the virtual.
+func (csm *consensusStateManager) VerifyAndRepairVirtualState() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "VerifyAndRepairVirtualState")
+	defer onEnd()
+
+	err := csm.verifyVirtualParentsData()
+	if err != nil {
+		return err
+	}
+
+	virtualMultiset, err := csm.multisetStore.Get(csm.databaseContext, model.VirtualBlockHash)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the virtual multiset")
+	}
+
+	virtualUTXOSetIterator, err := csm.consensusStateStore.VirtualUTXOSetIterator(csm.databaseContext)
+	if err != nil {
+		return err
+	}
+	defer virtualUTXOSetIterator.Close()
+
+	virtualUTXOSetMultiset, err := calculateUTXOSetMultiset(virtualUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	if virtualUTXOSetMultiset.Hash().Equal(virtualMultiset.Hash()) {
+		log.Infof("Verified the virtual UTXO set against its commitment %s", virtualMultiset.Hash())
+		return nil
+	}
+
+	log.Warnf("The virtual UTXO set doesn't match its commitment. Commitment: %s, "+
+		"calculated: %s. Attempting to rebuild it from the pruning point...",
+		virtualMultiset.Hash(), virtualUTXOSetMultiset.Hash())
+	err = csm.rebuildVirtualUTXOSet(virtualMultiset)
+	if err != nil {
+		return err
+	}
+	log.Warnf("The virtual UTXO set was successfully rebuilt")
+	return nil
+}
+
+// verifyVirtualParentsData makes sure that all the virtual parents are stored,
+// and that everything that resolving the virtual stores for its selected parent
+// is present
+func (csm *consensusStateManager) verifyVirtualParentsData() error {
+	virtualGHOSTDAGData, err := csm.ghostdagDataStore.Get(csm.databaseContext, model.VirtualBlockHash)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the virtual GHOSTDAG data")
+	}
+	virtualRelations, err := csm.blockRelationStore.BlockRelation(csm.databaseContext, model.VirtualBlockHash)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the virtual parents")
+	}
+	for _, parent := range virtualRelations.Parents {
+		hasHeader, err := csm.blockHeaderStore.HasBlockHeader(csm.databaseContext, parent)
+		if err != nil {
+			return err
+		}
+		if !hasHeader {
+			return errors.Errorf("the header of virtual parent %s is missing", parent)
+		}
+		hasBlock, err := csm.blockStore.HasBlock(csm.databaseContext, parent)
+		if err != nil {
+			return err
+		}
+		if !hasBlock {
+			return errors.Errorf("the body of virtual parent %s is missing", parent)
+		}
+	}
+
+	selectedParent := virtualGHOSTDAGData.SelectedParent()
+	status, err := csm.blockStatusStore.Get(csm.databaseContext, selectedParent)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the status of the virtual selected parent %s", selectedParent)
+	}
+	if status != externalapi.StatusUTXOValid {
+		return errors.Errorf("the virtual selected parent %s has status %s instead of %s",
+			selectedParent, status, externalapi.StatusUTXOValid)
+	}
+	_, err = csm.multisetStore.Get(csm.databaseContext, selectedParent)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the multiset of the virtual selected parent %s", selectedParent)
+	}
+	_, err = csm.acceptanceDataStore.Get(csm.databaseContext, selectedParent)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the acceptance data of the virtual selected parent %s", selectedParent)
+	}
+	_, err = csm.utxoDiffStore.UTXODiff(csm.databaseContext, selectedParent)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the UTXO diff of the virtual selected parent %s", selectedParent)
+	}
+	return nil
+}
+
+// rebuildVirtualUTXOSet rebuilds the virtual UTXO set by applying the reverse
+// of the UTXO diff between the virtual and the pruning point to the pruning point
+// UTXO set. The result is written only if it matches the given virtual multiset.
+func (csm *consensusStateManager) rebuildVirtualUTXOSet(virtualMultiset model.Multiset) error {
+	pruningPoint, err := csm.pruningStore.PruningPoint(csm.databaseContext)
+	if err != nil {
+		return err
+	}
+
+	// The genesis commits to nothing, and its past UTXO set is empty by definition
+	if !pruningPoint.Equal(csm.genesisHash) {
+		err := csm.verifyPruningPointUTXOSet(pruningPoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The pruning point past UTXO set is the virtual UTXO set with
+	// pruningPointDiff applied, so applying the reverse of that diff
+	// to the pruning point UTXO set results in the virtual UTXO set
+	pruningPointDiff, err := csm.restorePastUTXO(pruningPoint)
+	if err != nil {
+		return err
+	}
+	reversedDiff, err := utxo.NewUTXODiffFromCollections(pruningPointDiff.ToRemove(), pruningPointDiff.ToAdd())
+	if err != nil {
+		return err
+	}
+	pruningPointUTXOSetIterator, err := csm.pruningStore.PruningPointUTXOIterator(csm.databaseContext)
+	if err != nil {
+		return err
+	}
+	rebuiltUTXOSetIterator, err := utxo.IteratorWithDiff(pruningPointUTXOSetIterator, reversedDiff)
+	if err != nil {
+		pruningPointUTXOSetIterator.Close()
+		return err
+	}
+	defer rebuiltUTXOSetIterator.Close()
+
+	rebuiltUTXOSetMultiset, err := calculateUTXOSetMultiset(rebuiltUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	if !rebuiltUTXOSetMultiset.Hash().Equal(virtualMultiset.Hash()) {
+		return errors.Errorf("cannot rebuild the virtual UTXO set: the rebuilt UTXO set doesn't "+
+			"match the virtual commitment. Commitment: %s, calculated: %s",
+			virtualMultiset.Hash(), rebuiltUTXOSetMultiset.Hash())
+	}
+
+	return csm.consensusStateStore.ReplaceVirtualUTXOSet(csm.databaseContext, rebuiltUTXOSetIterator)
+}
+
+func (csm *consensusStateManager) verifyPruningPointUTXOSet(pruningPoint *externalapi.DomainHash) error {
+	pruningPointUTXOSetIterator, err := csm.pruningStore.PruningPointUTXOIterator(csm.databaseContext)
+	if err != nil {
+		return err
+	}
+	defer pruningPointUTXOSetIterator.Close()
+
+	pruningPointUTXOSetMultiset, err := calculateUTXOSetMultiset(pruningPointUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	pruningPointHeader, err := csm.blockHeaderStore.BlockHeader(csm.databaseContext, pruningPoint)
+	if err != nil {
+		return err
+	}
+	if !pruningPointHeader.UTXOCommitment().Equal(pruningPointUTXOSetMultiset.Hash()) {
+		return errors.Errorf("cannot rebuild the virtual UTXO set: the UTXO set of pruning point %s "+
+			"doesn't match its commitment either. Commitment: %s, calculated: %s",
+			pruningPoint, pruningPointHeader.UTXOCommitment(), pruningPointUTXOSetMultiset.Hash())
+	}
+	return nil
+}
+
+func calculateUTXOSetMultiset(utxoSetIterator externalapi.ReadOnlyUTXOSetIterator) (model.Multiset, error) {
+	utxoSetMultiset := multiset.New()
+	for ok := utxoSetIterator.First(); ok; ok = utxoSetIterator.Next() {
+		outpoint, entry, err := utxoSetIterator.Get()
+		if err != nil {
+			return nil, err
+		}
+		serializedUTXO, err := utxo.SerializeUTXO(entry, outpoint)
+		if err != nil {
+			return nil, err
+		}
+		utxoSetMultiset.Add(serializedUTXO)
+	}
+	return utxoSetMultiset, nil
+}
@@ -0,0 +1,151 @@
+package consensusstatemanager_test
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/model/testapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+)
+
+func TestVerifyAndRepairVirtualState(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, params *dagconfig.Params) {
+		// This is done to reduce the pruning depth to 6 blocks
+		params.FinalityDuration = 2 * params.TargetTimePerBlock
+		params.K = 0
+		params.BlockCoinbaseMaturity = 0
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(params, false, "TestVerifyAndRepairVirtualState")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tipHash := params.GenesisHash
+		for i := 0; i < 3; i++ {
+			tipHash, _, err = tc.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+		}
+
+		// The pruning point is still the genesis
+		testRepairVirtualUTXOSet(t, tc, "genesis pruning point")
+
+		for {
+			tipHash, _, err = tc.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			pruningPoint, err := tc.PruningPoint()
+			if err != nil {
+				t.Fatalf("PruningPoint: %+v", err)
+			}
+			if !pruningPoint.Equal(params.GenesisHash) {
+				break
+			}
+		}
+		for i := 0; i < 3; i++ {
+			tipHash, _, err = tc.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+		}
+
+		testRepairVirtualUTXOSet(t, tc, "non-genesis pruning point")
+
+		// Corrupt the virtual multiset, so that the virtual
+		// UTXO set can't be rebuilt to match it
+		corruptedMultiset := multiset.New()
+		corruptedMultiset.Add([]byte{1, 2, 3})
+		tc.MultisetStore().Stage(model.VirtualBlockHash, corruptedMultiset)
+		commitStores(t, tc, tc.MultisetStore())
+
+		err = tc.VerifyAndRepairVirtualState()
+		if err == nil {
+			t.Fatalf("VerifyAndRepairVirtualState unexpectedly succeeded with a corrupted virtual multiset")
+		}
+	})
+}
+
+// testRepairVirtualUTXOSet deletes the virtual UTXO set, and makes sure that
+// VerifyAndRepairVirtualState restores it as it was
+func testRepairVirtualUTXOSet(t *testing.T, tc testapi.TestConsensus, testName string) {
+	err := tc.VerifyAndRepairVirtualState()
+	if err != nil {
+		t.Fatalf("%s: VerifyAndRepairVirtualState: %+v", testName, err)
+	}
+
+	expectedUTXOSet := virtualUTXOSet(t, tc)
+	if len(expectedUTXOSet) == 0 {
+		t.Fatalf("%s: the virtual UTXO set is unexpectedly empty", testName)
+	}
+
+	err = tc.ConsensusStateStore().ReplaceVirtualUTXOSet(tc.DatabaseContext(), utxo.NewUTXODiff().ToAdd().Iterator())
+	if err != nil {
+		t.Fatalf("%s: ReplaceVirtualUTXOSet: %+v", testName, err)
+	}
+	if len(virtualUTXOSet(t, tc)) != 0 {
+		t.Fatalf("%s: the virtual UTXO set was unexpectedly not deleted", testName)
+	}
+
+	err = tc.VerifyAndRepairVirtualState()
+	if err != nil {
+		t.Fatalf("%s: VerifyAndRepairVirtualState failed to repair the virtual UTXO set: %+v", testName, err)
+	}
+
+	repairedUTXOSet := virtualUTXOSet(t, tc)
+	if len(repairedUTXOSet) != len(expectedUTXOSet) {
+		t.Fatalf("%s: unexpected repaired virtual UTXO set size. Want: %d, got: %d",
+			testName, len(expectedUTXOSet), len(repairedUTXOSet))
+	}
+	for outpoint, expectedEntry := range expectedUTXOSet {
+		entry, ok := repairedUTXOSet[outpoint]
+		if !ok || !entry.Equal(expectedEntry) {
+			t.Fatalf("%s: the repaired virtual UTXO set has a wrong entry for %s", testName, outpoint)
+		}
+	}
+}
+
+func virtualUTXOSet(t *testing.T, tc testapi.TestConsensus) map[externalapi.DomainOutpoint]externalapi.UTXOEntry {
+	iterator, err := tc.ConsensusStateStore().VirtualUTXOSetIterator(tc.DatabaseContext())
+	if err != nil {
+		t.Fatalf("VirtualUTXOSetIterator: %+v", err)
+	}
+	defer iterator.Close()
+
+	utxoSet := make(map[externalapi.DomainOutpoint]externalapi.UTXOEntry)
+	for ok := iterator.First(); ok; ok = iterator.Next() {
+		outpoint, entry, err := iterator.Get()
+		if err != nil {
+			t.Fatalf("Get: %+v", err)
+		}
+		utxoSet[*outpoint] = entry
+	}
+	return utxoSet
+}
+
+func commitStores(t *testing.T, tc testapi.TestConsensus, stores ...model.Store) {
+	dbTx, err := tc.DatabaseContext().Begin()
+	if err != nil {
+		t.Fatalf("Begin: %+v", err)
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	for _, store := range stores {
+		err = store.Commit(dbTx)
+		if err != nil {
+			t.Fatalf("Commit: %+v", err)
+		}
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		t.Fatalf("Commit: %+v", err)
+	}
+}
@@ -317,6 +317,10 @@ func (ds *GHOSTDAGDataStoreImpl) Commit(dbTx model.DBTransaction) error {
 	panic("implement me")
 }
 
+func (ds *GHOSTDAGDataStoreImpl) CacheStats() []*externalapi.CacheStats {
+	panic("implement me")
+}
+
 func (ds *GHOSTDAGDataStoreImpl) Get(dbContext model.DBReader, blockHash *externalapi.DomainHash) (*model.BlockGHOSTDAGData, error) {
 	v, ok := ds.dagMap[*blockHash]
 	if ok {
@@ -409,6 +413,8 @@ func (b *blockHeadersStore) Discard() { panic("unimplemented") }
 
 func (b *blockHeadersStore) Commit(_ model.DBTransaction) error { panic("unimplemented") }
 
+func (b *blockHeadersStore) CacheStats() []*externalapi.CacheStats { panic("unimplemented") }
+
 func (b *blockHeadersStore) Stage(blockHash *externalapi.DomainHash, blockHeader externalapi.BlockHeader) {
 	b.dagMap[*blockHash] = blockHeader
 }
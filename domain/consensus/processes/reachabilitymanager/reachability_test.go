@@ -24,6 +24,10 @@ func (r *reachabilityDataStoreMock) Commit(_ model.DBTransaction) error {
 	panic("implement me")
 }
 
+func (r *reachabilityDataStoreMock) CacheStats() []*externalapi.CacheStats {
+	panic("implement me")
+}
+
 func (r *reachabilityDataStoreMock) StageReachabilityData(
 	blockHash *externalapi.DomainHash, reachabilityData model.ReachabilityData) {
 
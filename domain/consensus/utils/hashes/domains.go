@@ -13,6 +13,8 @@ const (
 	blockDomain              = "BlockHash"
 	proofOfWorkDomain        = "ProofOfWorkHash"
 	merkleBranchDomain       = "MerkleBranchHash"
+	personalMessageDomain    = "PersonalMessageSigningHash"
+	shortTransactionIDDomain = "ShortTransactionID"
 )
 
 // NewTransactionHashWriter Returns a new HashWriter used for transaction hashes
@@ -77,3 +79,22 @@ func NewMerkleBranchHashWriter() HashWriter {
 	}
 	return HashWriter{blake}
 }
+
+// NewPersonalMessageSigningHashWriter Returns a new HashWriter used for signing on an arbitrary message.
+// The separate domain guarantees that a message signature can never be a valid transaction signature
+func NewPersonalMessageSigningHashWriter() HashWriter {
+	blake, err := blake2b.New256([]byte(personalMessageDomain))
+	if err != nil {
+		panic(errors.Wrapf(err, "this should never happen. %s is less than 64 bytes", personalMessageDomain))
+	}
+	return HashWriter{blake}
+}
+
+// NewShortTransactionIDWriter Returns a new HashWriter used for the short transaction IDs of compact blocks
+func NewShortTransactionIDWriter() HashWriter {
+	blake, err := blake2b.New256([]byte(shortTransactionIDDomain))
+	if err != nil {
+		panic(errors.Wrapf(err, "this should never happen. %s is less than 64 bytes", shortTransactionIDDomain))
+	}
+	return HashWriter{blake}
+}
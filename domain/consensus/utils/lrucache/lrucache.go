@@ -9,6 +9,8 @@ import (
 type LRUCache struct {
 	cache    map[externalapi.DomainHash]interface{}
 	capacity int
+	hits     uint64
+	misses   uint64
 }
 
 // New creates a new LRUCache
@@ -37,6 +39,7 @@ func (c *LRUCache) Add(key *externalapi.DomainHash, value interface{}) {
 // Get returns the entry for the given key, or (nil, false) otherwise
 func (c *LRUCache) Get(key *externalapi.DomainHash) (interface{}, bool) {
 	value, ok := c.cache[*key]
+	c.countLookup(ok)
 	if !ok {
 		return nil, false
 	}
@@ -46,6 +49,7 @@ func (c *LRUCache) Get(key *externalapi.DomainHash) (interface{}, bool) {
 // Has returns whether the LRUCache contains the given key
 func (c *LRUCache) Has(key *externalapi.DomainHash) bool {
 	_, ok := c.cache[*key]
+	c.countLookup(ok)
 	return ok
 }
 
@@ -63,3 +67,23 @@ func (c *LRUCache) evictRandom() {
 	}
 	c.Remove(&keyToEvict)
 }
+
+func (c *LRUCache) countLookup(isHit bool) {
+	if isHit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+// Stats returns the size of the LRUCache and the number of
+// lookups that were and weren't found in it, under the given name
+func (c *LRUCache) Stats(name string) *externalapi.CacheStats {
+	return &externalapi.CacheStats{
+		Name:     name,
+		Size:     len(c.cache),
+		Capacity: c.capacity,
+		Hits:     c.hits,
+		Misses:   c.misses,
+	}
+}
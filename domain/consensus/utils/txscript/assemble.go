@@ -0,0 +1,175 @@
+package txscript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+)
+
+// DisasmFullString formats a disassembled script for one line printing using
+// the full opcode names, as opposed to DisasmString which replaces small
+// integer opcodes with their values and omits the data push opcodes. Every
+// data push is printed as its opcode followed by its data (and the data
+// length for the OP_PUSHDATA# opcodes), so the result can be assembled back
+// into the exact same bytes using AssembleScript.
+//
+// When the script fails to parse, the returned string will contain the
+// disassembled script up to the point the failure occurred along with the
+// string '[error]' appended.
+func DisasmFullString(version uint16, buf []byte) (string, error) {
+	if version > constants.MaxScriptPublicKeyVersion {
+		return "", scriptError(ErrPubKeyFormat, "the version of the scriptPublicHash is higher then the known version")
+	}
+
+	var disbuf bytes.Buffer
+	opcodes, err := parseScript(buf)
+	for _, pop := range opcodes {
+		disbuf.WriteString(pop.print(false))
+		disbuf.WriteByte(' ')
+	}
+	if disbuf.Len() > 0 {
+		disbuf.Truncate(disbuf.Len() - 1)
+	}
+	if err != nil {
+		disbuf.WriteString("[error]")
+	}
+	return disbuf.String(), err
+}
+
+// AssembleScript assembles the textual representation of a script into its
+// bytes. The text is a whitespace separated list of tokens, where each token
+// is one of the following:
+//   - An opcode name, either as OP_NAME or just NAME. The data push opcodes
+//     OP_DATA_# must be followed by their data, and OP_PUSHDATA# must be
+//     followed by the data length and then the data, as printed by
+//     DisasmFullString
+//   - A number in decimal, which is pushed using the smallest possible
+//     opcode, as done by ScriptBuilder.AddInt64
+//   - Data in hex, prefixed by 0x, which is pushed using the canonical push
+//     opcode for its length, as done by ScriptBuilder.AddData
+//
+// Consequently, AssembleScript accepts the output of DisasmFullString and the
+// output of DisasmScript with its program counter prefixes removed.
+func AssembleScript(text string) ([]byte, error) {
+	tokens := strings.Fields(text)
+	script := make([]byte, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		if strings.HasPrefix(token, "0x") {
+			data, err := parseAssemblyHex(token)
+			if err != nil {
+				return nil, err
+			}
+			push, err := NewScriptBuilder().AddData(data).Script()
+			if err != nil {
+				return nil, err
+			}
+			script = append(script, push...)
+			continue
+		}
+
+		if number, err := strconv.ParseInt(token, 10, 64); err == nil {
+			push, err := NewScriptBuilder().AddInt64(number).Script()
+			if err != nil {
+				return nil, err
+			}
+			script = append(script, push...)
+			continue
+		}
+
+		opcodeValue, ok := lookupOpcodeByName(token)
+		if !ok {
+			str := fmt.Sprintf("unknown opcode %q", token)
+			return nil, scriptError(ErrInvalidScriptText, str)
+		}
+		op := &opcodeArray[opcodeValue]
+		script = append(script, op.value)
+
+		switch {
+		// No data follows non-push opcodes.
+		case op.length == 1:
+
+		// OP_DATA_# opcodes are followed by exactly # bytes of data.
+		case op.length > 1:
+			data, err := nextAssemblyData(tokens, &i, op.name)
+			if err != nil {
+				return nil, err
+			}
+			if len(data) != op.length-1 {
+				str := fmt.Sprintf("opcode %s requires %d bytes of data, but %d were given",
+					op.name, op.length-1, len(data))
+				return nil, scriptError(ErrInvalidScriptText, str)
+			}
+			script = append(script, data...)
+
+		// OP_PUSHDATA# opcodes are followed by the data length and then by the data.
+		default:
+			lengthSize := -op.length
+			lengthBytes, err := nextAssemblyData(tokens, &i, op.name)
+			if err != nil {
+				return nil, err
+			}
+			if len(lengthBytes) != lengthSize {
+				str := fmt.Sprintf("opcode %s requires a %d byte data length, but %d bytes were given",
+					op.name, lengthSize, len(lengthBytes))
+				return nil, scriptError(ErrInvalidScriptText, str)
+			}
+			// The data length is printed big-endian but encoded little-endian
+			paddedLength := make([]byte, 8)
+			copy(paddedLength[8-lengthSize:], lengthBytes)
+			dataLength := binary.BigEndian.Uint64(paddedLength)
+
+			data, err := nextAssemblyData(tokens, &i, op.name)
+			if err != nil {
+				return nil, err
+			}
+			if uint64(len(data)) != dataLength {
+				str := fmt.Sprintf("opcode %s declares %d bytes of data, but %d were given",
+					op.name, dataLength, len(data))
+				return nil, scriptError(ErrInvalidScriptText, str)
+			}
+
+			encodedLength := make([]byte, 8)
+			binary.LittleEndian.PutUint64(encodedLength, dataLength)
+			script = append(script, encodedLength[:lengthSize]...)
+			script = append(script, data...)
+		}
+	}
+	return script, nil
+}
+
+// lookupOpcodeByName returns the value of the opcode with the given name,
+// which may be given with or without its OP_ prefix.
+func lookupOpcodeByName(name string) (byte, bool) {
+	if opcodeValue, ok := OpcodeByName[name]; ok {
+		return opcodeValue, true
+	}
+	opcodeValue, ok := OpcodeByName["OP_"+name]
+	return opcodeValue, ok
+}
+
+// nextAssemblyData advances the token index and parses the hex data token
+// that must follow the data push opcode with the given name.
+func nextAssemblyData(tokens []string, index *int, opcodeName string) ([]byte, error) {
+	*index++
+	if *index >= len(tokens) || !strings.HasPrefix(tokens[*index], "0x") {
+		str := fmt.Sprintf("opcode %s must be followed by hex data", opcodeName)
+		return nil, scriptError(ErrInvalidScriptText, str)
+	}
+	return parseAssemblyHex(tokens[*index])
+}
+
+func parseAssemblyHex(token string) ([]byte, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(token, "0x"))
+	if err != nil {
+		str := fmt.Sprintf("invalid hex data %q: %s", token, err)
+		return nil, scriptError(ErrInvalidScriptText, str)
+	}
+	return data, nil
+}
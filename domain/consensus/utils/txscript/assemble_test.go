@@ -0,0 +1,141 @@
+package txscript
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestAssembleScript ensures that AssembleScript assembles the supported
+// token forms into the expected scripts and rejects malformed text.
+func TestAssembleScript(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []byte
+		err      error
+	}{
+		{
+			name:     "opcode names with and without prefix",
+			text:     "OP_DUP HASH160 OP_EQUALVERIFY\tCHECKSIG\n",
+			expected: []byte{OpDup, OpHash160, OpEqualVerify, OpCheckSig},
+		},
+		{
+			name:     "aliases",
+			text:     "OP_FALSE TRUE",
+			expected: []byte{OpFalse, OpTrue},
+		},
+		{
+			name:     "small integers",
+			text:     "OP_0 OP_16 0 -1 16",
+			expected: []byte{Op0, Op16, Op0, Op1Negate, Op16},
+		},
+		{
+			name:     "large integer",
+			text:     "1000",
+			expected: []byte{OpData2, 0xe8, 0x03},
+		},
+		{
+			name:     "canonical data push",
+			text:     "0x0102",
+			expected: []byte{OpData2, 0x01, 0x02},
+		},
+		{
+			name:     "canonical data push of a small integer",
+			text:     "0x05",
+			expected: []byte{Op5},
+		},
+		{
+			name:     "explicit data push",
+			text:     "OP_DATA_1 0x05",
+			expected: []byte{OpData1, 0x05},
+		},
+		{
+			name:     "explicit OP_PUSHDATA1",
+			text:     "OP_PUSHDATA1 0x02 0x0102",
+			expected: []byte{OpPushData1, 0x02, 0x01, 0x02},
+		},
+		{
+			name:     "explicit OP_PUSHDATA2",
+			text:     "OP_PUSHDATA2 0x0002 0x0102",
+			expected: []byte{OpPushData2, 0x02, 0x00, 0x01, 0x02},
+		},
+		{
+			name: "unknown opcode",
+			text: "OP_DUP OP_NOTANOPCODE",
+			err:  scriptError(ErrInvalidScriptText, ""),
+		},
+		{
+			name: "invalid hex",
+			text: "0x0g",
+			err:  scriptError(ErrInvalidScriptText, ""),
+		},
+		{
+			name: "data push without data",
+			text: "OP_DATA_2",
+			err:  scriptError(ErrInvalidScriptText, ""),
+		},
+		{
+			name: "data push with the wrong amount of data",
+			text: "OP_DATA_2 0x01",
+			err:  scriptError(ErrInvalidScriptText, ""),
+		},
+		{
+			name: "OP_PUSHDATA1 with the wrong data length size",
+			text: "OP_PUSHDATA1 0x0002 0x0102",
+			err:  scriptError(ErrInvalidScriptText, ""),
+		},
+		{
+			name: "OP_PUSHDATA1 with the wrong amount of data",
+			text: "OP_PUSHDATA1 0x03 0x0102",
+			err:  scriptError(ErrInvalidScriptText, ""),
+		},
+	}
+
+	for _, test := range tests {
+		script, err := AssembleScript(test.text)
+		if e := checkScriptError(err, test.err); e != nil {
+			t.Errorf("%s: %v", test.name, e)
+			continue
+		}
+		if !bytes.Equal(script, test.expected) {
+			t.Errorf("%s: unexpected script - got %x, want %x",
+				test.name, script, test.expected)
+		}
+	}
+}
+
+// TestDisasmFullStringRoundTrip ensures that scripts disassembled with
+// DisasmFullString assemble back into the exact same bytes, including
+// scripts that use non-canonical data pushes.
+func TestDisasmFullStringRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	scripts := []string{
+		"DUP HASH160 DATA_20 0x433ec2ac1ffa1b7b7d027f564529c57197f9ae88 EQUALVERIFY CHECKSIG",
+		"HASH160 DATA_20 0x433ec2ac1ffa1b7b7d027f564529c57197f9ae88 EQUAL",
+		"1 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a 1 CHECKMULTISIG",
+		"0 IF 1NEGATE ELSE 16 ENDIF",
+		"0x01 0x05 PUSHDATA1 0x02 0x0102 PUSHDATA2 0x0100 0x03 PUSHDATA4 0x01000000 0x04",
+		"",
+	}
+
+	for i, scriptText := range scripts {
+		script := mustParseShortForm(scriptText, 0)
+		disassembly, err := DisasmFullString(0, script)
+		if err != nil {
+			t.Errorf("DisasmFullString #%d: unexpected error: %s", i, err)
+			continue
+		}
+		assembled, err := AssembleScript(disassembly)
+		if err != nil {
+			t.Errorf("AssembleScript #%d: unexpected error for %q: %s", i, disassembly, err)
+			continue
+		}
+		if !bytes.Equal(assembled, script) {
+			t.Errorf("round trip #%d: got %x, want %x (disassembly %q)",
+				i, assembled, script, disassembly)
+		}
+	}
+}
@@ -222,6 +222,10 @@ const (
 	// is not either an empty vector or [0x01].
 	ErrMinimalIf
 
+	// ErrInvalidScriptText is returned from AssembleScript when the provided
+	// text can't be assembled into a script.
+	ErrInvalidScriptText
+
 	// numErrorCodes is the maximum error code number used in tests. This
 	// entry MUST be the last entry in the enum.
 	numErrorCodes
@@ -270,6 +274,7 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrNegativeLockTime:          "ErrNegativeLockTime",
 	ErrUnsatisfiedLockTime:       "ErrUnsatisfiedLockTime",
 	ErrMinimalIf:                 "ErrMinimalIf",
+	ErrInvalidScriptText:         "ErrInvalidScriptText",
 }
 
 // String returns the ErrorCode as a human-readable name.
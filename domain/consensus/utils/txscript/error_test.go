@@ -57,6 +57,7 @@ func TestErrorCodeStringer(t *testing.T) {
 		{ErrNegativeLockTime, "ErrNegativeLockTime"},
 		{ErrUnsatisfiedLockTime, "ErrUnsatisfiedLockTime"},
 		{ErrMinimalIf, "ErrMinimalIf"},
+		{ErrInvalidScriptText, "ErrInvalidScriptText"},
 		{0xffff, "Unknown ErrorCode (65535)"},
 	}
 
@@ -57,6 +57,52 @@ func SignatureScript(tx *externalapi.DomainTransaction, idx int, script *externa
 	return NewScriptBuilder().AddData(sig).AddData(pkData[:]).Script()
 }
 
+// PubKeySignatureScript creates an input signature script for tx to spend KAS
+// sent from a previous pay-to-pubkey output to the owner of privKey. Unlike
+// SignatureScript, the public key is not included in the returned script, as it
+// is already part of the spent ScriptPublicKey.
+func PubKeySignatureScript(tx *externalapi.DomainTransaction, idx int, script *externalapi.ScriptPublicKey,
+	hashType SigHashType, privKey *secp256k1.SchnorrKeyPair) ([]byte, error) {
+
+	sig, err := RawTxInSignature(tx, idx, script, hashType, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewScriptBuilder().AddData(sig).Script()
+}
+
+// signMultiSig signs as many of the outputs in the provided multisig script as
+// possible. It returns the generated script and a boolean if the script fulfils
+// the contract (i.e. nRequired signatures are provided). Signatures are added
+// in the order of the public keys in the script, as required by
+// OP_CHECKMULTISIG.
+func signMultiSig(tx *externalapi.DomainTransaction, idx int, script *externalapi.ScriptPublicKey,
+	hashType SigHashType, addresses []util.Address, nRequired int, kdb KeyDB) ([]byte, bool) {
+
+	builder := NewScriptBuilder()
+	signed := 0
+	for _, address := range addresses {
+		key, err := kdb.GetKey(address)
+		if err != nil {
+			continue
+		}
+		signature, err := RawTxInSignature(tx, idx, script, hashType, key)
+		if err != nil {
+			continue
+		}
+
+		builder.AddData(signature)
+		signed++
+		if signed == nRequired {
+			break
+		}
+	}
+
+	signatureScript, _ := builder.Script()
+	return signatureScript, signed == nRequired
+}
+
 func sign(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction, idx int,
 	script *externalapi.ScriptPublicKey, hashType SigHashType, kdb KeyDB, sdb ScriptDB) ([]byte,
 	ScriptClass, util.Address, error) {
@@ -81,6 +127,27 @@ func sign(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction, idx in
 		}
 
 		return signedScript, class, address, nil
+	case PubKeyTy:
+		// look up key for address
+		key, err := kdb.GetKey(address)
+		if err != nil {
+			return nil, class, nil, err
+		}
+
+		signedScript, err := PubKeySignatureScript(tx, idx, script, hashType, key)
+		if err != nil {
+			return nil, class, nil, err
+		}
+
+		return signedScript, class, address, nil
+	case MultiSigTy:
+		_, addresses, nRequired, err := ExtractScriptPubKeyAddresses(script, dagParams)
+		if err != nil {
+			return nil, class, nil, err
+		}
+
+		signedScript, _ := signMultiSig(tx, idx, script, hashType, addresses, nRequired, kdb)
+		return signedScript, class, nil, nil
 	case ScriptHashTy:
 		script, err := sdb.GetScript(address)
 		if err != nil {
@@ -93,14 +160,123 @@ func sign(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction, idx in
 	}
 }
 
-// mergeScripts merges sigScript and prevScript assuming they are both
-// partial solutions for scriptPubKey spending output idx of tx. class, addresses
-// and nrequired are the result of extracting the addresses from scriptPubKey.
+// mergeMultiSig combines the two signature scripts sigScript and prevScript
+// that both provide signatures for scriptPubKey in output idx of tx. addresses
+// and nRequired should be the results from extracting the addresses from
+// scriptPubKey. Signatures are matched to the public keys they were made by,
+// and at most nRequired of them are kept, in the order of the public keys.
 // The return value is the best effort merging of the two scripts. Calling this
-// function with addresses, class and nrequired that do not match scriptPubKey is
-// an error and results in undefined behaviour.
+// function with addresses, and nRequired that do not match scriptPubKey is an
+// error and results in undefined behaviour.
+func mergeMultiSig(tx *externalapi.DomainTransaction, idx int, addresses []util.Address,
+	nRequired int, scriptPubKey *externalapi.ScriptPublicKey, sigScript, prevScript []byte) []byte {
+
+	// Nothing to merge if either the new or previous signature scripts are
+	// empty.
+	if len(sigScript) == 0 {
+		return prevScript
+	}
+	if len(prevScript) == 0 {
+		return sigScript
+	}
+
+	// Convenience function to avoid duplication.
+	var possibleSigs [][]byte
+	extractSigs := func(script []byte) {
+		pops, err := parseScript(script)
+		if err != nil {
+			return
+		}
+		for _, pop := range pops {
+			if len(pop.data) != 0 {
+				possibleSigs = append(possibleSigs, pop.data)
+			}
+		}
+	}
+	extractSigs(sigScript)
+	extractSigs(prevScript)
+
+	// Now we need to match the signatures to pubkeys, the only real way to
+	// do that is to try to verify them all and match it to the pubkey
+	// that verifies it. we then can go through the addresses in order
+	// to build our script. Anything that doesn't parse or doesn't verify
+	// we throw away.
+	addrToSig := make(map[string][]byte)
+	for _, sig := range possibleSigs {
+		// can't have a valid signature that doesn't at least have a
+		// hashtype, in practice it is even longer than this. but
+		// that'll be checked next.
+		if len(sig) < 1 {
+			continue
+		}
+		tSig := sig[:len(sig)-1]
+		hashType := SigHashType(sig[len(sig)-1])
+
+		parsedSig, err := secp256k1.DeserializeSchnorrSignatureFromSlice(tSig)
+		if err != nil {
+			continue
+		}
+
+		// We have to do this each round since hash types may vary
+		// between signatures and so the hash will vary. We can,
+		// however, assume no sigs etc are in the script since that
+		// would make the transaction nonstandard and thus not
+		// MultiSigTy, so we just need to hash the full thing.
+		hash, err := CalcSignatureHash(scriptPubKey, hashType, tx, idx)
+		if err != nil {
+			continue
+		}
+		secpHash := secp256k1.Hash(*hash.ByteArray())
+
+		for _, addr := range addresses {
+			// All multisig addresses should be pubkey addresses
+			// it is an error to call this internal function with
+			// bad input.
+			pubKey, err := secp256k1.DeserializeSchnorrPubKey(addr.ScriptAddress())
+			if err != nil {
+				continue
+			}
+
+			// If it matches we put it in the map. We only
+			// can take one signature per public key so if we
+			// already have one, we can throw this away.
+			if pubKey.SchnorrVerify(&secpHash, parsedSig) {
+				aStr := addr.EncodeAddress()
+				if _, ok := addrToSig[aStr]; !ok {
+					addrToSig[aStr] = sig
+				}
+			}
+		}
+	}
+
+	builder := NewScriptBuilder()
+	doneSigs := 0
+	// This assumes that addresses are in the same order as in the script.
+	for _, addr := range addresses {
+		sig, ok := addrToSig[addr.EncodeAddress()]
+		if !ok {
+			continue
+		}
+		builder.AddData(sig)
+		doneSigs++
+		if doneSigs == nRequired {
+			break
+		}
+	}
+
+	script, _ := builder.Script()
+	return script
+}
+
+// mergeScripts merges sigScript and prevScript assuming they are both
+// partial solutions for scriptPubKey spending output idx of tx. class is the
+// result of extracting the script class from scriptPubKey. The return value is
+// the best effort merging of the two scripts. Calling this function with a class
+// that does not match scriptPubKey is an error and results in undefined
+// behaviour.
 func mergeScripts(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction, idx int,
-	class ScriptClass, sigScript []byte, prevScript *externalapi.ScriptPublicKey) ([]byte, error) {
+	scriptPubKey *externalapi.ScriptPublicKey, class ScriptClass, sigScript []byte,
+	prevScript *externalapi.ScriptPublicKey) ([]byte, error) {
 
 	switch class {
 	case ScriptHashTy:
@@ -126,15 +302,16 @@ func mergeScripts(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction
 		class, _, _ :=
 			ExtractScriptPubKeyAddress(scriptPubKey, dagParams)
 
-		// regenerate scripts.
-		sigScript, _ := unparseScript(sigPops)
-		prevScriptByte, _ := unparseScript(prevPops)
+		// regenerate scripts without the redeem script, which is
+		// reappended to the merged script below.
+		sigScript, _ := unparseScript(sigPops[:len(sigPops)-1])
+		prevScriptByte, _ := unparseScript(prevPops[:len(prevPops)-1])
 		prevScript = &externalapi.ScriptPublicKey{
 			Script:  prevScriptByte,
 			Version: prevScript.Version,
 		}
 		// Merge
-		mergedScript, err := mergeScripts(dagParams, tx, idx, class, sigScript, prevScript)
+		mergedScript, err := mergeScripts(dagParams, tx, idx, scriptPubKey, class, sigScript, prevScript)
 		if err != nil {
 			return nil, err
 		}
@@ -145,6 +322,13 @@ func mergeScripts(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction
 		builder.AddData(script)
 		return builder.Script()
 
+	case MultiSigTy:
+		_, addresses, nRequired, err := ExtractScriptPubKeyAddresses(scriptPubKey, dagParams)
+		if err != nil {
+			return nil, err
+		}
+		return mergeMultiSig(tx, idx, addresses, nRequired, scriptPubKey, sigScript, prevScript.Script), nil
+
 	// It doesn't actually make sense to merge anything other than multiig
 	// and scripthash (because it could contain multisig). Everything else
 	// has either zero signature, can't be spent, or has a single signature
@@ -225,5 +409,5 @@ func SignTxOutput(dagParams *dagconfig.Params, tx *externalapi.DomainTransaction
 	}
 
 	// Merge scripts. with any previous data, if any.
-	return mergeScripts(dagParams, tx, idx, class, sigScript, previousScript)
+	return mergeScripts(dagParams, tx, idx, scriptPublicKey, class, sigScript, previousScript)
 }
@@ -258,6 +258,54 @@ func TestSignTxOutput(t *testing.T) {
 		}
 	}
 
+	// Pay to Pubkey
+	for _, hashType := range hashTypes {
+		for i := range tx.Inputs {
+			msg := fmt.Sprintf("%d:%d", hashType, i)
+
+			key, err := secp256k1.GeneratePrivateKey()
+			if err != nil {
+				t.Errorf("failed to make privKey for %s: %s",
+					msg, err)
+				break
+			}
+
+			pubKey, err := key.SchnorrPublicKey()
+			if err != nil {
+				t.Errorf("failed to make a publickey for %s: %s",
+					key, err)
+				break
+			}
+
+			serializedPubKey, err := pubKey.Serialize()
+			if err != nil {
+				t.Errorf("failed to make a pubkey for %s: %s",
+					key, err)
+				break
+			}
+
+			address, err := util.NewAddressPubKey(serializedPubKey[:], util.Bech32PrefixKaspaTest)
+			if err != nil {
+				t.Errorf("failed to make address for %s: %v",
+					msg, err)
+				break
+			}
+
+			scriptPubKey, err := PayToAddrScript(address)
+			if err != nil {
+				t.Errorf("failed to make scriptPubKey "+
+					"for %s: %v", msg, err)
+			}
+			if err := signAndCheck(msg, tx, i, scriptPubKey, hashType,
+				mkGetKey(map[string]*secp256k1.SchnorrKeyPair{
+					address.EncodeAddress(): key,
+				}), mkGetScript(nil), nil); err != nil {
+				t.Error(err)
+				break
+			}
+		}
+	}
+
 	// Pay to Pubkey Hash with duplicate merge
 	for _, hashType := range hashTypes {
 		for i := range tx.Inputs {
@@ -493,6 +541,222 @@ func TestSignTxOutput(t *testing.T) {
 			}
 		}
 	}
+	// Pay to Pubkey Hash with p2sh, merging with a previous signature
+	// script. The redeem script must appear only once in the merged script.
+	for _, hashType := range hashTypes {
+		for i := range tx.Inputs {
+			msg := fmt.Sprintf("%d:%d", hashType, i)
+
+			key, address, err := newKeyAndPubKeyHashAddress()
+			if err != nil {
+				t.Errorf("failed to make key for %s: %s", msg, err)
+				break
+			}
+
+			scriptPubKey, err := PayToAddrScript(address)
+			if err != nil {
+				t.Errorf("failed to make scriptPubKey for %s: %v", msg, err)
+				break
+			}
+			scriptAddr, err := util.NewAddressScriptHash(scriptPubKey.Script, util.Bech32PrefixKaspaTest)
+			if err != nil {
+				t.Errorf("failed to make p2sh addr for %s: %v", msg, err)
+				break
+			}
+			scriptScriptPubKey, err := PayToAddrScript(scriptAddr)
+			if err != nil {
+				t.Errorf("failed to make script scriptPubKey for %s: %v", msg, err)
+				break
+			}
+			getKey := mkGetKey(map[string]*secp256k1.SchnorrKeyPair{
+				address.EncodeAddress(): key,
+			})
+			getScript := mkGetScript(map[string][]byte{
+				scriptAddr.EncodeAddress(): scriptPubKey.Script,
+			})
+
+			previousSigScript, err := SignTxOutput(&dagconfig.TestnetParams,
+				tx, i, scriptScriptPubKey, hashType, getKey, getScript,
+				&externalapi.ScriptPublicKey{Script: nil, Version: 0})
+			if err != nil {
+				t.Errorf("failed to sign output %s: %v", msg, err)
+				break
+			}
+
+			sigScript, err := SignTxOutput(&dagconfig.TestnetParams,
+				tx, i, scriptScriptPubKey, hashType, getKey, getScript,
+				&externalapi.ScriptPublicKey{Script: previousSigScript, Version: 0})
+			if err != nil {
+				t.Errorf("failed to sign output %s a second time: %v", msg, err)
+				break
+			}
+
+			pushes, err := PushedData(sigScript)
+			if err != nil {
+				t.Errorf("failed to parse merged script for %s: %v", msg, err)
+				break
+			}
+			if len(pushes) != 3 {
+				t.Errorf("merged script for %s has %d pushes, want 3 (signature, "+
+					"public key and redeem script)", msg, len(pushes))
+				break
+			}
+
+			err = checkScripts(msg, tx, i, sigScript, scriptScriptPubKey)
+			if err != nil {
+				t.Errorf("merged script invalid for %s: %v", msg, err)
+				break
+			}
+		}
+	}
+
+	// Basic Multisig
+	for _, hashType := range hashTypes {
+		for i := range tx.Inputs {
+			msg := fmt.Sprintf("%d:%d", hashType, i)
+
+			key1, address1, err := newKeyAndPubKeyAddress()
+			if err != nil {
+				t.Errorf("failed to make key for %s: %s", msg, err)
+				break
+			}
+			key2, address2, err := newKeyAndPubKeyAddress()
+			if err != nil {
+				t.Errorf("failed to make key 2 for %s: %s", msg, err)
+				break
+			}
+
+			multiSigScript, err := MultiSigScript([]*util.AddressPubKey{address1, address2}, 2)
+			if err != nil {
+				t.Errorf("failed to make multisig script for %s: %v", msg, err)
+				break
+			}
+			scriptPubKey := &externalapi.ScriptPublicKey{Script: multiSigScript, Version: 0}
+
+			if err := signAndCheck(msg, tx, i, scriptPubKey, hashType,
+				mkGetKey(map[string]*secp256k1.SchnorrKeyPair{
+					address1.EncodeAddress(): key1,
+					address2.EncodeAddress(): key2,
+				}), mkGetScript(nil), nil); err != nil {
+				t.Error(err)
+				break
+			}
+		}
+	}
+
+	// Two part multisig with p2sh, sign with one key then the other
+	for _, hashType := range hashTypes {
+		for i := range tx.Inputs {
+			msg := fmt.Sprintf("%d:%d", hashType, i)
+
+			key1, address1, err := newKeyAndPubKeyAddress()
+			if err != nil {
+				t.Errorf("failed to make key for %s: %s", msg, err)
+				break
+			}
+			key2, address2, err := newKeyAndPubKeyAddress()
+			if err != nil {
+				t.Errorf("failed to make key 2 for %s: %s", msg, err)
+				break
+			}
+
+			redeemScript, err := MultiSigScript([]*util.AddressPubKey{address1, address2}, 2)
+			if err != nil {
+				t.Errorf("failed to make multisig script for %s: %v", msg, err)
+				break
+			}
+			scriptAddr, err := util.NewAddressScriptHash(redeemScript, util.Bech32PrefixKaspaTest)
+			if err != nil {
+				t.Errorf("failed to make p2sh addr for %s: %v", msg, err)
+				break
+			}
+			scriptScriptPubKey, err := PayToAddrScript(scriptAddr)
+			if err != nil {
+				t.Errorf("failed to make script scriptPubKey for %s: %v", msg, err)
+				break
+			}
+			getScript := mkGetScript(map[string][]byte{
+				scriptAddr.EncodeAddress(): redeemScript,
+			})
+
+			sigScript, err := SignTxOutput(&dagconfig.TestnetParams,
+				tx, i, scriptScriptPubKey, hashType,
+				mkGetKey(map[string]*secp256k1.SchnorrKeyPair{
+					address1.EncodeAddress(): key1,
+				}), getScript, &externalapi.ScriptPublicKey{Script: nil, Version: 0})
+			if err != nil {
+				t.Errorf("failed to sign output %s: %v", msg, err)
+				break
+			}
+
+			// Only 1 out of 2 signed, this *should* fail.
+			if checkScripts(msg, tx, i, sigScript, scriptScriptPubKey) == nil {
+				t.Errorf("part signed script valid for %s", msg)
+				break
+			}
+
+			// Sign with the other key and merge
+			sigScript, err = SignTxOutput(&dagconfig.TestnetParams,
+				tx, i, scriptScriptPubKey, hashType,
+				mkGetKey(map[string]*secp256k1.SchnorrKeyPair{
+					address2.EncodeAddress(): key2,
+				}), getScript, &externalapi.ScriptPublicKey{Script: sigScript, Version: 0})
+			if err != nil {
+				t.Errorf("failed to sign output %s: %v", msg, err)
+				break
+			}
+
+			err = checkScripts(msg, tx, i, sigScript, scriptScriptPubKey)
+			if err != nil {
+				t.Errorf("fully signed script invalid for %s: %v", msg, err)
+				break
+			}
+		}
+	}
+}
+
+// newKeyAndPubKeyHashAddress generates a new private key along with its
+// pay-to-pubkey-hash address
+func newKeyAndPubKeyHashAddress() (*secp256k1.SchnorrKeyPair, *util.AddressPubKeyHash, error) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := key.SchnorrPublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	serializedPubKey, err := pubKey.Serialize()
+	if err != nil {
+		return nil, nil, err
+	}
+	address, err := util.NewAddressPubKeyHash(util.Hash160(serializedPubKey[:]), util.Bech32PrefixKaspaTest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, address, nil
+}
+
+// newKeyAndPubKeyAddress generates a new private key along with its
+// pay-to-pubkey address
+func newKeyAndPubKeyAddress() (*secp256k1.SchnorrKeyPair, *util.AddressPubKey, error) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := key.SchnorrPublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	serializedPubKey, err := pubKey.Serialize()
+	if err != nil {
+		return nil, nil, err
+	}
+	address, err := util.NewAddressPubKey(serializedPubKey[:], util.Bech32PrefixKaspaTest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, address, nil
 }
 
 type tstInput struct {
@@ -22,6 +22,8 @@ const (
 	NonStandardTy ScriptClass = iota // None of the recognized forms.
 	PubKeyHashTy                     // Pay pubkey hash.
 	ScriptHashTy                     // Pay to script hash.
+	PubKeyTy                         // Pay to pubkey.
+	MultiSigTy                       // Multi signature.
 )
 
 // scriptClassToName houses the human-readable strings which describe each
@@ -30,6 +32,8 @@ var scriptClassToName = []string{
 	NonStandardTy: "nonstandard",
 	PubKeyHashTy:  "pubkeyhash",
 	ScriptHashTy:  "scripthash",
+	PubKeyTy:      "pubkey",
+	MultiSigTy:    "multisig",
 }
 
 // String implements the Stringer interface by returning the name of
@@ -54,13 +58,63 @@ func isPubkeyHash(pops []parsedOpcode) bool {
 
 }
 
+// isPubKey returns true if the script passed is a pay-to-pubkey
+// transaction, false otherwise.
+func isPubKey(pops []parsedOpcode) bool {
+	return len(pops) == 2 &&
+		pops[0].opcode.value == OpData32 &&
+		pops[1].opcode.value == OpCheckSig
+}
+
+// isMultiSig returns true if the script passed is a standard M-of-N multisig
+// transaction, false otherwise. A standard multisig script is of the form:
+//  M <pubkey 1> ... <pubkey N> N OP_CHECKMULTISIG
+// where every public key is a 32-byte Schnorr public key and 1 <= M <= N.
+func isMultiSig(pops []parsedOpcode) bool {
+	// The absolute minimum is 1 pubkey:
+	// OP_1 <pubkey> OP_1 OP_CHECKMULTISIG
+	numPops := len(pops)
+	if numPops < 4 {
+		return false
+	}
+	if !isSmallInt(pops[0].opcode) {
+		return false
+	}
+	if !isSmallInt(pops[numPops-2].opcode) {
+		return false
+	}
+	if pops[numPops-1].opcode.value != OpCheckMultiSig {
+		return false
+	}
+
+	// Verify the number of pubkeys specified matches the actual number
+	// of pubkeys provided, and that at least one but no more than all of
+	// them are required.
+	numRequired := asSmallInt(pops[0].opcode)
+	numPubKeys := asSmallInt(pops[numPops-2].opcode)
+	if numPops-3 != numPubKeys || numRequired < 1 || numRequired > numPubKeys {
+		return false
+	}
+
+	for _, pop := range pops[1 : numPops-2] {
+		if pop.opcode.value != OpData32 {
+			return false
+		}
+	}
+	return true
+}
+
 // scriptType returns the type of the script being inspected from the known
 // standard types.
 func typeOfScript(pops []parsedOpcode) ScriptClass {
 	if isPubkeyHash(pops) {
 		return PubKeyHashTy
+	} else if isPubKey(pops) {
+		return PubKeyTy
 	} else if isScriptHash(pops) {
 		return ScriptHashTy
+	} else if isMultiSig(pops) {
+		return MultiSigTy
 	}
 	return NonStandardTy
 }
@@ -87,10 +141,18 @@ func expectedInputs(pops []parsedOpcode, class ScriptClass) int {
 	case PubKeyHashTy:
 		return 2
 
+	case PubKeyTy:
+		return 1
+
 	case ScriptHashTy:
 		// Not including script. That is handled by the caller.
 		return 1
 
+	case MultiSigTy:
+		// Standard multisig has a push of the small int for the number of
+		// signatures required, and exactly that many signatures.
+		return asSmallInt(pops[0].opcode)
+
 	default:
 		return -1
 	}
@@ -168,6 +230,32 @@ func CalcScriptInfo(sigScript, scriptPubKey []byte, isP2SH bool) (*ScriptInfo, e
 	return si, nil
 }
 
+// CalcMultiSigStats returns the number of public keys and signatures from
+// a multi-signature transaction script. The passed script MUST already be
+// known to be a multi-signature script.
+func CalcMultiSigStats(script []byte) (int, int, error) {
+	pops, err := parseScript(script)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// A multi-signature script is of the pattern:
+	//  NUM_SIGS PUBKEY PUBKEY PUBKEY... NUM_PUBKEYS OP_CHECKMULTISIG
+	// Therefore the number of signatures is the oldest item on the stack
+	// and the number of pubkeys is the 2nd to last. Also, the absolute
+	// minimum for a multi-signature script is 1 pubkey, so at least 4
+	// items must be on the stack per:
+	//  OP_1 PUBKEY OP_1 OP_CHECKMULTISIG
+	if len(pops) < 4 {
+		str := fmt.Sprintf("script %x is not a multisig script", script)
+		return 0, 0, scriptError(ErrNotMultisigScript, str)
+	}
+
+	numSigs := asSmallInt(pops[0].opcode)
+	numPubKeys := asSmallInt(pops[len(pops)-2].opcode)
+	return numPubKeys, numSigs, nil
+}
+
 // payToPubKeyHashScript creates a new script to pay a transaction
 // output to a 20-byte pubkey hash. It is expected that the input is a valid
 // hash.
@@ -177,6 +265,13 @@ func payToPubKeyHashScript(pubKeyHash []byte) ([]byte, error) {
 		Script()
 }
 
+// payToPubKeyScript creates a new script to pay a transaction output to a
+// 32-byte Schnorr public key. It is expected that the input is a valid
+// public key.
+func payToPubKeyScript(publicKey []byte) ([]byte, error) {
+	return NewScriptBuilder().AddData(publicKey).AddOp(OpCheckSig).Script()
+}
+
 // payToScriptHashScript creates a new script to pay a transaction output to a
 // script hash. It is expected that the input is a valid hash.
 func payToScriptHashScript(scriptHash []byte) ([]byte, error) {
@@ -210,6 +305,17 @@ func PayToAddrScript(addr util.Address) (*externalapi.ScriptPublicKey, error) {
 			return nil, err
 		}
 		return &externalapi.ScriptPublicKey{script, constants.MaxScriptPublicKeyVersion}, err
+
+	case *util.AddressPubKey:
+		if addr == nil {
+			return nil, scriptError(ErrUnsupportedAddress,
+				nilAddrErrStr)
+		}
+		script, err := payToPubKeyScript(addr.ScriptAddress())
+		if err != nil {
+			return nil, err
+		}
+		return &externalapi.ScriptPublicKey{script, constants.MaxScriptPublicKeyVersion}, err
 	}
 
 	str := fmt.Sprintf("unable to generate payment script for unsupported "+
@@ -217,6 +323,39 @@ func PayToAddrScript(addr util.Address) (*externalapi.ScriptPublicKey, error) {
 	return nil, scriptError(ErrUnsupportedAddress, str)
 }
 
+// MultiSigScript returns a valid script for a multisignature redemption where
+// nRequired of the keys in pubKeys are required to have signed the transaction
+// for success. An Error with the error code ErrTooManyRequiredSigs will be
+// returned if nRequired is larger than the number of keys provided.
+func MultiSigScript(pubKeys []*util.AddressPubKey, nRequired int) ([]byte, error) {
+	if len(pubKeys) < nRequired {
+		str := fmt.Sprintf("unable to generate multisig script with "+
+			"%d required signatures when there are only %d public "+
+			"keys available", nRequired, len(pubKeys))
+		return nil, scriptError(ErrTooManyRequiredSigs, str)
+	}
+	if nRequired < 1 {
+		str := fmt.Sprintf("unable to generate multisig script with "+
+			"%d required signatures", nRequired)
+		return nil, scriptError(ErrTooManyRequiredSigs, str)
+	}
+	if len(pubKeys) > MaxPubKeysPerMultiSig {
+		str := fmt.Sprintf("unable to generate multisig script with "+
+			"%d public keys, which is more than the allowed max of %d",
+			len(pubKeys), MaxPubKeysPerMultiSig)
+		return nil, scriptError(ErrTooManyRequiredSigs, str)
+	}
+
+	builder := NewScriptBuilder().AddInt64(int64(nRequired))
+	for _, key := range pubKeys {
+		builder.AddData(key.ScriptAddress())
+	}
+	builder.AddInt64(int64(len(pubKeys)))
+	builder.AddOp(OpCheckMultiSig)
+
+	return builder.Script()
+}
+
 // PayToScriptHashScript takes a script and returns an equivalent pay-to-script-hash script
 func PayToScriptHashScript(redeemScript []byte) ([]byte, error) {
 	redeemScriptHash := util.Hash160(redeemScript)
@@ -299,6 +438,23 @@ func ExtractScriptPubKeyAddress(scriptPubKey *externalapi.ScriptPublicKey, dagPa
 		}
 		return scriptClass, addr, nil
 
+	case PubKeyTy:
+		// A pay-to-pubkey script is of the form:
+		//  <pubkey> OP_CHECKSIG
+		// Therefore the pubkey is the first item on the stack.
+		// If the pubkey is invalid for some reason, return a nil address.
+		addr, err := util.NewAddressPubKey(pops[0].data,
+			dagParams.Prefix)
+		if err != nil {
+			return scriptClass, nil, nil
+		}
+		return scriptClass, addr, nil
+
+	case MultiSigTy:
+		// A multisig script pays to several public keys rather than to a
+		// single address. Use ExtractScriptPubKeyAddresses to get them.
+		return scriptClass, nil, nil
+
 	case NonStandardTy:
 		// Don't attempt to extract addresses or required signatures for
 		// nonstandard transactions.
@@ -308,6 +464,54 @@ func ExtractScriptPubKeyAddress(scriptPubKey *externalapi.ScriptPublicKey, dagPa
 	return NonStandardTy, nil, errors.Errorf("Cannot handle script class %s", scriptClass)
 }
 
+// ExtractScriptPubKeyAddresses returns the type of script, the addresses it pays
+// to, and the number of signatures required to spend it. Unlike
+// ExtractScriptPubKeyAddress, it also supports multisig scripts, which pay to
+// several public keys. Note that it only works for 'standard' transaction script
+// types. Any data such as public keys which are invalid are omitted from the
+// returned addresses.
+func ExtractScriptPubKeyAddresses(scriptPubKey *externalapi.ScriptPublicKey, dagParams *dagconfig.Params) (
+	ScriptClass, []util.Address, int, error) {
+
+	scriptClass, addr, err := ExtractScriptPubKeyAddress(scriptPubKey, dagParams)
+	if err != nil {
+		return scriptClass, nil, 0, err
+	}
+
+	switch scriptClass {
+	case PubKeyHashTy, PubKeyTy, ScriptHashTy:
+		if addr == nil {
+			return scriptClass, nil, 0, nil
+		}
+		return scriptClass, []util.Address{addr}, 1, nil
+
+	case MultiSigTy:
+		// A multi-signature script is of the form:
+		//  <numsigs> <pubkey> <pubkey> <pubkey>... <numpubkeys> OP_CHECKMULTISIG
+		// Therefore the number of required signatures is the 1st item
+		// on the stack and the number of public keys is the 2nd to last
+		// item on the stack.
+		pops, err := parseScript(scriptPubKey.Script)
+		if err != nil {
+			return NonStandardTy, nil, 0, err
+		}
+		requiredSigs := asSmallInt(pops[0].opcode)
+		numPubKeys := asSmallInt(pops[len(pops)-2].opcode)
+
+		// Extract the public keys while skipping any that are invalid.
+		addrs := make([]util.Address, 0, numPubKeys)
+		for i := 0; i < numPubKeys; i++ {
+			addr, err := util.NewAddressPubKey(pops[i+1].data, dagParams.Prefix)
+			if err == nil {
+				addrs = append(addrs, addr)
+			}
+		}
+		return scriptClass, addrs, requiredSigs, nil
+	}
+
+	return NonStandardTy, nil, 0, nil
+}
+
 // AtomicSwapDataPushes houses the data pushes found in atomic swap contracts.
 type AtomicSwapDataPushes struct {
 	RecipientHash160 [20]byte
@@ -6,6 +6,7 @@ package txscript
 
 import (
 	"bytes"
+	"encoding/hex"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"reflect"
 	"testing"
@@ -41,6 +42,19 @@ func newAddressPubKeyHash(pkHash []byte) util.Address {
 	return addr
 }
 
+// newAddressPubKey returns a new util.AddressPubKey from the provided
+// public key. It panics if an error occurs. This is only used in the tests
+// as a helper since the only way it can fail is if there is an error in the
+// test source code.
+func newAddressPubKey(publicKey []byte) util.Address {
+	addr, err := util.NewAddressPubKey(publicKey, util.Bech32PrefixKaspa)
+	if err != nil {
+		panic("invalid public key in test source")
+	}
+
+	return addr
+}
+
 // newAddressScriptHash returns a new util.AddressScriptHash from the
 // provided hash. It panics if an error occurs. This is only used in the tests
 // as a helper since the only way it can fail is if there is an error in the
@@ -88,6 +102,17 @@ func TestExtractScriptPubKeyAddrs(t *testing.T) {
 				"8ee0189dd5cc67f1b0e5f02f45cb")),
 			class: ScriptHashTy,
 		},
+		{
+			name: "standard p2pk",
+			script: &externalapi.ScriptPublicKey{
+				Script: hexToBytes("2011db93e1dcdb8a016b49840f8c53bc1eb68a382e97b1" +
+					"482ecad7b148a6909a5cac"),
+				Version: 0,
+			},
+			addr: newAddressPubKey(hexToBytes("11db93e1dcdb8a016b49840f8c53b" +
+				"c1eb68a382e97b1482ecad7b148a6909a5c")),
+			class: PubKeyTy,
+		},
 
 		// The below are nonstandard script due to things such as
 		// invalid pubkeys, failure to parse, and not being of a
@@ -177,6 +202,149 @@ func TestExtractScriptPubKeyAddrs(t *testing.T) {
 	}
 }
 
+// TestExtractScriptPubKeyAddresses ensures that extracting the addresses and
+// the number of required signatures from multisig scriptPubKeys works as
+// intended.
+func TestExtractScriptPubKeyAddresses(t *testing.T) {
+	t.Parallel()
+
+	publicKey1 := hexToBytes("32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a")
+	publicKey2 := hexToBytes("7adf5df7c965a2d46203c781bd4dd821f11844136f6673af7cc5a4a05cd29380")
+
+	tests := []struct {
+		name         string
+		script       *externalapi.ScriptPublicKey
+		addrs        []util.Address
+		requiredSigs int
+		class        ScriptClass
+	}{
+		{
+			name: "standard p2pkh",
+			script: &externalapi.ScriptPublicKey{
+				Script: hexToBytes("76a914ad06dd6ddee55cbca9a9e3713bd" +
+					"7587509a3056488ac"),
+				Version: 0,
+			},
+			addrs: []util.Address{newAddressPubKeyHash(hexToBytes("ad06dd6ddee5" +
+				"5cbca9a9e3713bd7587509a30564"))},
+			requiredSigs: 1,
+			class:        PubKeyHashTy,
+		},
+		{
+			name: "standard 1 of 2 multisig",
+			script: &externalapi.ScriptPublicKey{
+				Script: mustParseShortForm("1 DATA_32 0x"+hex.EncodeToString(publicKey1)+
+					" DATA_32 0x"+hex.EncodeToString(publicKey2)+" 2 CHECKMULTISIG", 0),
+				Version: 0,
+			},
+			addrs:        []util.Address{newAddressPubKey(publicKey1), newAddressPubKey(publicKey2)},
+			requiredSigs: 1,
+			class:        MultiSigTy,
+		},
+		{
+			name: "nonstandard script",
+			script: &externalapi.ScriptPublicKey{
+				Script:  []byte{OpTrue},
+				Version: 0,
+			},
+			addrs:        nil,
+			requiredSigs: 0,
+			class:        NonStandardTy,
+		},
+	}
+
+	for _, test := range tests {
+		class, addrs, requiredSigs, err := ExtractScriptPubKeyAddresses(test.script, &dagconfig.MainnetParams)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if class != test.class {
+			t.Errorf("%s: unexpected script type - got %s, want %s", test.name, class, test.class)
+		}
+		if !reflect.DeepEqual(addrs, test.addrs) {
+			t.Errorf("%s: unexpected addresses - got %v, want %v", test.name, addrs, test.addrs)
+		}
+		if requiredSigs != test.requiredSigs {
+			t.Errorf("%s: unexpected number of required signatures - got %d, want %d",
+				test.name, requiredSigs, test.requiredSigs)
+		}
+	}
+}
+
+// TestMultiSigScript ensures the MultiSigScript function returns the expected
+// scripts and errors.
+func TestMultiSigScript(t *testing.T) {
+	t.Parallel()
+
+	publicKey1 := newAddressPubKey(hexToBytes("32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a")).(*util.AddressPubKey)
+	publicKey2 := newAddressPubKey(hexToBytes("7adf5df7c965a2d46203c781bd4dd821f11844136f6673af7cc5a4a05cd29380")).(*util.AddressPubKey)
+
+	tests := []struct {
+		keys      []*util.AddressPubKey
+		nrequired int
+		expected  string
+		err       error
+	}{
+		{
+			[]*util.AddressPubKey{publicKey1, publicKey2},
+			1,
+			"1 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a " +
+				"DATA_32 0x7adf5df7c965a2d46203c781bd4dd821f11844136f6673af7cc5a4a05cd29380 " +
+				"2 CHECKMULTISIG",
+			nil,
+		},
+		{
+			[]*util.AddressPubKey{publicKey1, publicKey2},
+			2,
+			"2 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a " +
+				"DATA_32 0x7adf5df7c965a2d46203c781bd4dd821f11844136f6673af7cc5a4a05cd29380 " +
+				"2 CHECKMULTISIG",
+			nil,
+		},
+		{
+			[]*util.AddressPubKey{publicKey1, publicKey2},
+			3,
+			"",
+			scriptError(ErrTooManyRequiredSigs, ""),
+		},
+		{
+			[]*util.AddressPubKey{publicKey1},
+			0,
+			"",
+			scriptError(ErrTooManyRequiredSigs, ""),
+		},
+	}
+
+	for i, test := range tests {
+		script, err := MultiSigScript(test.keys, test.nrequired)
+		if e := checkScriptError(err, test.err); e != nil {
+			t.Errorf("MultiSigScript #%d: %v", i, e)
+			continue
+		}
+
+		expected := mustParseShortForm(test.expected, 0)
+		if !bytes.Equal(script, expected) {
+			t.Errorf("MultiSigScript #%d got: %x\nwant: %x",
+				i, script, expected)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		numPubKeys, numSigs, err := CalcMultiSigStats(script)
+		if err != nil {
+			t.Errorf("CalcMultiSigStats #%d: unexpected error: %s", i, err)
+			continue
+		}
+		if numPubKeys != len(test.keys) || numSigs != test.nrequired {
+			t.Errorf("CalcMultiSigStats #%d got: %d of %d\nwant: %d of %d",
+				i, numSigs, numPubKeys, test.nrequired, len(test.keys))
+		}
+	}
+}
+
 // TestCalcScriptInfo ensures the CalcScriptInfo provides the expected results
 // for various valid and invalid script pairs.
 func TestCalcScriptInfo(t *testing.T) {
@@ -213,6 +381,21 @@ func TestCalcScriptInfo(t *testing.T) {
 			isP2SH:        true,
 			scriptInfoErr: scriptError(ErrMalformedPush, ""),
 		},
+		{
+			name:      "multisig script",
+			sigScript: "DATA_1 0x01 DATA_1 0x02",
+			scriptPubKey: "2 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a " +
+				"DATA_32 0x7adf5df7c965a2d46203c781bd4dd821f11844136f6673af7cc5a4a05cd29380 " +
+				"DATA_32 0xc08f3de8ee2de9be7bd770f4c10eb0d6ff1dd81ee96eedd3a9d4aeaf86695e80 " +
+				"3 CHECKMULTISIG",
+			isP2SH: false,
+			scriptInfo: ScriptInfo{
+				ScriptPubKeyClass: MultiSigTy,
+				NumInputs:         2,
+				ExpectedInputs:    2,
+				SigOps:            3,
+			},
+		},
 		{
 			// Invented scripts, the hashes do not match
 			name: "p2sh standard script",
@@ -318,6 +501,12 @@ func TestPayToAddrScript(t *testing.T) {
 		t.Fatalf("Unable to create script hash address: %v", err)
 	}
 
+	p2pkMain, err := util.NewAddressPubKey(hexToBytes("11db93e1dcdb8a016b4"+
+		"9840f8c53bc1eb68a382e97b1482ecad7b148a6909a5c"), util.Bech32PrefixKaspa)
+	if err != nil {
+		t.Fatalf("Unable to create public key address: %v", err)
+	}
+
 	// Errors used in the tests below defined here for convenience and to
 	// keep the horizontal test size shorter.
 	errUnsupportedAddress := scriptError(ErrUnsupportedAddress, "")
@@ -344,10 +533,18 @@ func TestPayToAddrScript(t *testing.T) {
 			0,
 			nil,
 		},
+		// pay-to-pubkey address on mainnet
+		{
+			p2pkMain,
+			"DATA_32 0x11db93e1dcdb8a016b49840f8c53bc1eb68a382e97b1482ecad7b148a6909a5c CHECKSIG",
+			0,
+			nil,
+		},
 
 		// Supported address types with nil pointers.
 		{(*util.AddressPubKeyHash)(nil), "", 0, errUnsupportedAddress},
 		{(*util.AddressScriptHash)(nil), "", 0, errUnsupportedAddress},
+		{(*util.AddressPubKey)(nil), "", 0, errUnsupportedAddress},
 
 		// Unsupported address type.
 		{&bogusAddress{}, "", 0, errUnsupportedAddress},
@@ -401,6 +598,12 @@ var scriptClassTests = []struct {
 			"0bfa9b8b64f9d4c03f999b8643f656b412a3 CHECKSIG",
 		class: NonStandardTy,
 	},
+	{
+		name: "Pay Schnorr Pubkey",
+		script: "DATA_32 0x11db93e1dcdb8a016b49840f8c53bc1eb68a382e97b148" +
+			"2ecad7b148a6909a5c CHECKSIG",
+		class: PubKeyTy,
+	},
 	// tx 599e47a8114fe098103663029548811d2651991b62397e057f0c863c2bc9f9ea
 	{
 		name: "Pay PubkeyHash",
@@ -415,6 +618,25 @@ var scriptClassTests = []struct {
 			"5329a00357b3a7886211ab414d55a 1 CHECKMULTISIG",
 		class: NonStandardTy,
 	},
+	{
+		name: "Schnorr multisig",
+		script: "1 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a " +
+			"DATA_32 0x7adf5df7c965a2d46203c781bd4dd821f11844136f6673af7cc5a4a05cd29380 " +
+			"2 CHECKMULTISIG",
+		class: MultiSigTy,
+	},
+	{
+		name: "Schnorr multisig requiring more signatures than pubkeys",
+		script: "2 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a " +
+			"1 CHECKMULTISIG",
+		class: NonStandardTy,
+	},
+	{
+		name: "Schnorr multisig requiring no signatures",
+		script: "0 DATA_32 0x32abdc893e7f0631364d7fd01cb33d24da45329a00357b3a7886211ab414d55a " +
+			"1 CHECKMULTISIG",
+		class: NonStandardTy,
+	},
 	// tx e5779b9e78f9650debc2893fd9636d827b26b4ddfa6a8172fe8708c924f5c39d
 	{
 		name: "P2SH",
@@ -527,6 +749,16 @@ func TestStringifyClass(t *testing.T) {
 			class:    ScriptHashTy,
 			stringed: "scripthash",
 		},
+		{
+			name:     "pubkey",
+			class:    PubKeyTy,
+			stringed: "pubkey",
+		},
+		{
+			name:     "multisig",
+			class:    MultiSigTy,
+			stringed: "multisig",
+		},
 		{
 			name:     "broken",
 			class:    ScriptClass(255),
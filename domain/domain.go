@@ -28,9 +28,11 @@ func (d domain) MiningManager() miningmanager.MiningManager {
 }
 
 // New instantiates a new instance of a Domain object
-func New(dagParams *dagconfig.Params, db infrastructuredatabase.Database, isArchivalNode bool) (Domain, error) {
+func New(dagParams *dagconfig.Params, db infrastructuredatabase.Database, isArchivalNode bool,
+	cacheSizes *consensus.CacheSizes) (Domain, error) {
+
 	consensusFactory := consensus.NewFactory()
-	consensusInstance, err := consensusFactory.NewConsensus(dagParams, db, isArchivalNode)
+	consensusInstance, err := consensusFactory.NewConsensus(dagParams, db, isArchivalNode, cacheSizes)
 	if err != nil {
 		return nil, err
 	}
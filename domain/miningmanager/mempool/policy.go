@@ -12,6 +12,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/utils/estimatedsize"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
 	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -19,6 +20,12 @@ const (
 	// that are considered standard in a pay-to-script-hash script.
 	maxStandardP2SHSigOps = 15
 
+	// maxStandardMultiSigKeys is the maximum number of public keys allowed
+	// in a multi-signature transaction output script for it to be
+	// considered standard. Larger multisig contracts should be paid to
+	// through pay-to-script-hash, which keeps them out of the UTXO set.
+	maxStandardMultiSigKeys = 3
+
 	// maxStandardSigScriptSize is the maximum size allowed for a
 	// transaction input signature script to be considered standard. This
 	// value allows for a 15-of-15 CHECKMULTISIG pay-to-script-hash with
@@ -77,7 +84,8 @@ func calcMinRequiredTxRelayFee(serializedSize int64, minRelayTxFee util.Amount)
 // checkInputsStandard performs a series of checks on a transaction's inputs
 // to ensure they are "standard". A standard transaction input within the
 // context of this function is one whose referenced public key script is of a
-// standard form and, for pay-to-script-hash, does not have more than
+// standard form and, for pay-to-script-hash, has a redeem script of a standard
+// form that is given exactly the inputs it expects, and does not have more than
 // maxStandardP2SHSigOps signature operations.
 func checkInputsStandard(tx *consensusexternalapi.DomainTransaction) error {
 	// NOTE: The reference implementation also does a coinbase check here,
@@ -92,6 +100,12 @@ func checkInputsStandard(tx *consensusexternalapi.DomainTransaction) error {
 		originScriptPubKey := entry.ScriptPublicKey()
 		switch txscript.GetScriptClass(originScriptPubKey.Script) {
 		case txscript.ScriptHashTy:
+			err := checkRedeemScriptStandard(txIn.SignatureScript, originScriptPubKey.Script)
+			if err != nil {
+				str := fmt.Sprintf("transaction input #%d: %s", i, err)
+				return txRuleError(RejectNonstandard, str)
+			}
+
 			numSigOps := txscript.GetPreciseSigOpCount(
 				txIn.SignatureScript, originScriptPubKey, true)
 			if numSigOps > maxStandardP2SHSigOps {
@@ -112,6 +126,37 @@ func checkInputsStandard(tx *consensusexternalapi.DomainTransaction) error {
 	return nil
 }
 
+// checkRedeemScriptStandard checks that the redeem script of the given
+// pay-to-script-hash signature script is of a standard form other than
+// pay-to-script-hash, and that the signature script pushes exactly the
+// inputs it expects before it
+func checkRedeemScriptStandard(signatureScript []byte, scriptPubKey []byte) error {
+	pushes, err := txscript.PushedData(signatureScript)
+	if err != nil {
+		return errors.Wrap(err, "malformed signature script")
+	}
+	if len(pushes) == 0 {
+		return errors.New("signature script has no redeem script")
+	}
+	redeemScriptClass := txscript.GetScriptClass(pushes[len(pushes)-1])
+	switch redeemScriptClass {
+	case txscript.NonStandardTy:
+		return errors.New("non-standard redeem script form")
+	case txscript.ScriptHashTy:
+		return errors.New("nested pay-to-script-hash redeem script")
+	}
+
+	scriptInfo, err := txscript.CalcScriptInfo(signatureScript, scriptPubKey, true)
+	if err != nil {
+		return errors.Wrap(err, "malformed signature script")
+	}
+	if scriptInfo.NumInputs != scriptInfo.ExpectedInputs {
+		return errors.Errorf("signature script has %d inputs while its redeem script expects %d",
+			scriptInfo.NumInputs, scriptInfo.ExpectedInputs)
+	}
+	return nil
+}
+
 // isDust returns whether or not the passed transaction output amount is
 // considered dust or not based on the passed minimum transaction relay fee.
 // Dust is defined in terms of the minimum transaction relay fee. In
@@ -235,6 +280,18 @@ func checkTransactionStandard(tx *consensusexternalapi.DomainTransaction, policy
 			str := fmt.Sprintf("transaction output %d: non-standard script form", i)
 			return txRuleError(RejectNonstandard, str)
 		}
+		if scriptClass == txscript.MultiSigTy {
+			numPubKeys, _, err := txscript.CalcMultiSigStats(txOut.ScriptPublicKey.Script)
+			if err != nil {
+				str := fmt.Sprintf("transaction output %d: multi-signature script parse failure: %s", i, err)
+				return txRuleError(RejectNonstandard, str)
+			}
+			if numPubKeys > maxStandardMultiSigKeys {
+				str := fmt.Sprintf("transaction output %d: multi-signature script with %d public keys "+
+					"which is more than the allowed max of %d", i, numPubKeys, maxStandardMultiSigKeys)
+				return txRuleError(RejectNonstandard, str)
+			}
+		}
 
 		if isDust(txOut, policy.MinRelayTxFee) {
 			str := fmt.Sprintf("transaction output %d: payment "+
@@ -12,6 +12,7 @@ import (
 
 	consensusexternalapi "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/pkg/errors"
 )
@@ -192,6 +193,23 @@ func TestCheckTransactionStandard(t *testing.T) {
 		ScriptPublicKey: dummyScriptPublicKey,
 	}
 
+	multiSigPubKeys := make([]*util.AddressPubKey, maxStandardMultiSigKeys+1)
+	for i := range multiSigPubKeys {
+		publicKey := [util.PublicKeySize]byte{byte(i + 1)}
+		multiSigPubKeys[i], err = util.NewAddressPubKey(publicKey[:], util.Bech32PrefixKaspaTest)
+		if err != nil {
+			t.Fatalf("NewAddressPubKey: unexpected error: %v", err)
+		}
+	}
+	standardMultiSigScript, err := txscript.MultiSigScript(multiSigPubKeys[:maxStandardMultiSigKeys], 2)
+	if err != nil {
+		t.Fatalf("MultiSigScript: unexpected error: %v", err)
+	}
+	tooManyKeysMultiSigScript, err := txscript.MultiSigScript(multiSigPubKeys, 2)
+	if err != nil {
+		t.Fatalf("MultiSigScript: unexpected error: %v", err)
+	}
+
 	tests := []struct {
 		name       string
 		tx         consensusexternalapi.DomainTransaction
@@ -255,6 +273,25 @@ func TestCheckTransactionStandard(t *testing.T) {
 			isStandard: false,
 			code:       RejectDust,
 		},
+		{
+			name: "Bare multisig output",
+			tx: consensusexternalapi.DomainTransaction{Version: 0, Inputs: []*consensusexternalapi.DomainTransactionInput{&dummyTxIn}, Outputs: []*consensusexternalapi.DomainTransactionOutput{{
+				Value:           100000000,
+				ScriptPublicKey: &consensusexternalapi.ScriptPublicKey{Script: standardMultiSigScript, Version: 0},
+			}}},
+			height:     300000,
+			isStandard: true,
+		},
+		{
+			name: "Bare multisig output with too many public keys",
+			tx: consensusexternalapi.DomainTransaction{Version: 0, Inputs: []*consensusexternalapi.DomainTransactionInput{&dummyTxIn}, Outputs: []*consensusexternalapi.DomainTransactionOutput{{
+				Value:           100000000,
+				ScriptPublicKey: &consensusexternalapi.ScriptPublicKey{Script: tooManyKeysMultiSigScript, Version: 0},
+			}}},
+			height:     300000,
+			isStandard: false,
+			code:       RejectNonstandard,
+		},
 		{
 			name: "Nulldata transaction",
 			tx: consensusexternalapi.DomainTransaction{Version: 0, Inputs: []*consensusexternalapi.DomainTransactionInput{&dummyTxIn}, Outputs: []*consensusexternalapi.DomainTransactionOutput{{
@@ -309,3 +346,118 @@ func TestCheckTransactionStandard(t *testing.T) {
 		}
 	}
 }
+
+// TestCheckInputsStandard tests the checkInputsStandard API.
+func TestCheckInputsStandard(t *testing.T) {
+	dummySignature := bytes.Repeat([]byte{0x01}, 65)
+	dummyPublicKey := bytes.Repeat([]byte{0x02}, util.PublicKeySize)
+
+	payToPubKeyHashAddress, err := util.NewAddressPubKeyHash(util.Hash160(dummyPublicKey), util.Bech32PrefixKaspaTest)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: unexpected error: %v", err)
+	}
+	payToPubKeyHashScript, err := txscript.PayToAddrScript(payToPubKeyHashAddress)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: unexpected error: %v", err)
+	}
+
+	multiSigPubKeys := make([]*util.AddressPubKey, 3)
+	for i := range multiSigPubKeys {
+		publicKey := [util.PublicKeySize]byte{byte(i + 1)}
+		multiSigPubKeys[i], err = util.NewAddressPubKey(publicKey[:], util.Bech32PrefixKaspaTest)
+		if err != nil {
+			t.Fatalf("NewAddressPubKey: unexpected error: %v", err)
+		}
+	}
+	multiSigScript, err := txscript.MultiSigScript(multiSigPubKeys, 2)
+	if err != nil {
+		t.Fatalf("MultiSigScript: unexpected error: %v", err)
+	}
+	payToScriptHashScript, err := txscript.PayToScriptHashScript(payToPubKeyHashScript.Script)
+	if err != nil {
+		t.Fatalf("PayToScriptHashScript: unexpected error: %v", err)
+	}
+
+	signatureScript := func(pushes ...[]byte) []byte {
+		builder := txscript.NewScriptBuilder()
+		for _, push := range pushes {
+			builder.AddData(push)
+		}
+		script, err := builder.Script()
+		if err != nil {
+			t.Fatalf("Script: unexpected error: %v", err)
+		}
+		return script
+	}
+
+	tests := []struct {
+		name            string
+		redeemScript    []byte
+		signatureScript []byte
+		isStandard      bool
+	}{
+		{
+			name:            "pay-to-pubkey-hash redeem script",
+			redeemScript:    payToPubKeyHashScript.Script,
+			signatureScript: signatureScript(dummySignature, dummyPublicKey, payToPubKeyHashScript.Script),
+			isStandard:      true,
+		},
+		{
+			name:            "multisig redeem script",
+			redeemScript:    multiSigScript,
+			signatureScript: signatureScript(dummySignature, dummySignature, multiSigScript),
+			isStandard:      true,
+		},
+		{
+			name:            "multisig redeem script with too few signatures",
+			redeemScript:    multiSigScript,
+			signatureScript: signatureScript(dummySignature, multiSigScript),
+			isStandard:      false,
+		},
+		{
+			name:            "pay-to-pubkey-hash redeem script with an extra input",
+			redeemScript:    payToPubKeyHashScript.Script,
+			signatureScript: signatureScript(dummySignature, dummySignature, dummyPublicKey, payToPubKeyHashScript.Script),
+			isStandard:      false,
+		},
+		{
+			name:            "non-standard redeem script",
+			redeemScript:    []byte{txscript.OpTrue},
+			signatureScript: signatureScript([]byte{txscript.OpTrue}),
+			isStandard:      false,
+		},
+		{
+			name:            "nested pay-to-script-hash redeem script",
+			redeemScript:    payToScriptHashScript,
+			signatureScript: signatureScript(dummySignature, dummyPublicKey, payToPubKeyHashScript.Script, payToScriptHashScript),
+			isStandard:      false,
+		},
+	}
+
+	for _, test := range tests {
+		scriptPublicKey, err := txscript.PayToScriptHashScript(test.redeemScript)
+		if err != nil {
+			t.Fatalf("%s: PayToScriptHashScript: unexpected error: %v", test.name, err)
+		}
+		tx := &consensusexternalapi.DomainTransaction{
+			Inputs: []*consensusexternalapi.DomainTransactionInput{{
+				SignatureScript: test.signatureScript,
+				Sequence:        constants.MaxTxInSequenceNum,
+				UTXOEntry: utxo.NewUTXOEntry(100000000,
+					&consensusexternalapi.ScriptPublicKey{Script: scriptPublicKey, Version: 0}, false, 0),
+			}},
+		}
+
+		err = checkInputsStandard(tx)
+		if test.isStandard && err != nil {
+			t.Errorf("checkInputsStandard (%s): nonstandard when it should be standard: %v", test.name, err)
+			continue
+		}
+		if !test.isStandard {
+			var txRuleErr TxRuleError
+			if !errors.As(err, &txRuleErr) || txRuleErr.RejectCode != RejectNonstandard {
+				t.Errorf("checkInputsStandard (%s): expected a non-standard rule error, got: %v", test.name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package pruningpointfile
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kaspanet/kaspad/domain/consensus/database/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// utxoChunkSize is the number of UTXOs in every UTXO record
+	utxoChunkSize = 1000
+
+	// maxBlueScoreDifference limits the number of headers in every header
+	// record, the same way it's limited when headers are sent to peers
+	maxBlueScoreDifference = 1 << 10
+
+	// writeBufferSize is the size of the buffer pruning point
+	// files are written through
+	writeBufferSize = 1 << 20
+)
+
+// Summary describes the contents of a pruning point file
+type Summary struct {
+	PruningPointHash *externalapi.DomainHash
+	HeaderCount      uint64
+	UTXOCount        uint64
+}
+
+// Export writes the current pruning point of the given consensus into a new
+// file at the given path: its block, its UTXO set with the UTXO set's
+// multiset hash, and the headers a node needs in order to accept it as its
+// pruning point.
+//
+// The UTXO set is verified against the pruning point's UTXO commitment
+// before the file is completed. The file is first written under a temporary
+// name and renamed once it's complete.
+func Export(consensus externalapi.Consensus, params *dagconfig.Params, path string) (*Summary, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "pruningpointfile.Export")
+	defer onEnd()
+
+	_, err := os.Stat(path)
+	if err == nil {
+		return nil, errors.Errorf("%s already exists", path)
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+
+	tempPath := path + ".tmp"
+	file, err := os.OpenFile(tempPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	isComplete := false
+	defer func() {
+		if isComplete {
+			return
+		}
+		// The file may have already been closed, so the error is ignored
+		_ = file.Close()
+		removeErr := os.Remove(tempPath)
+		if removeErr != nil {
+			log.Errorf("Error removing the incomplete pruning point file %s: %s", tempPath, removeErr)
+		}
+	}()
+
+	writer := bufio.NewWriterSize(file, writeBufferSize)
+	summary, err := export(consensus, params, writer)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Flush()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = file.Sync()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = file.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	isComplete = true
+
+	return summary, nil
+}
+
+func export(consensus externalapi.Consensus, params *dagconfig.Params, writer *bufio.Writer) (*Summary, error) {
+	pruningPointHash, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Exporting pruning point %s", pruningPointHash)
+
+	err = writeFileHeader(writer, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	headerCount, err := exportHeaders(consensus, params, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	pruningPointBlock, err := consensus.GetBlock(pruningPointHash)
+	if err != nil {
+		return nil, err
+	}
+	serializedBlock, err := proto.Marshal(serialization.DomainBlockToDbBlock(pruningPointBlock))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = writeRecord(writer, recordTypePruningPointBlock, serializedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	utxoCount, utxoSetMultiset, err := exportUTXOSet(consensus, pruningPointHash, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	// This is the same sanity check kaspad makes when it moves its pruning
+	// point, so that the file is never written with a wrong UTXO set
+	utxoSetHash := utxoSetMultiset.Hash()
+	expectedUTXOCommitment := pruningPointBlock.Header.UTXOCommitment()
+	if !expectedUTXOCommitment.Equal(utxoSetHash) {
+		return nil, errors.Errorf("the UTXO set of pruning point %s doesn't match its UTXO commitment. "+
+			"UTXO set hash: %s. Commitment: %s", pruningPointHash, utxoSetHash, expectedUTXOCommitment)
+	}
+
+	err = writeRecord(writer, recordTypeEnd,
+		serializeUint64(headerCount), serializeUint64(utxoCount), utxoSetHash.ByteSlice())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Exported pruning point %s with %d headers and %d UTXOs",
+		pruningPointHash, headerCount, utxoCount)
+	return &Summary{
+		PruningPointHash: pruningPointHash,
+		HeaderCount:      headerCount,
+		UTXOCount:        utxoCount,
+	}, nil
+}
+
+// exportHeaders writes the headers of all the blocks in the past of
+// the headers selected tip, except for genesis, in topological order
+func exportHeaders(consensus externalapi.Consensus, params *dagconfig.Params, writer *bufio.Writer) (uint64, error) {
+	headersSelectedTip, err := consensus.GetHeadersSelectedTip()
+	if err != nil {
+		return 0, err
+	}
+
+	headerCount := uint64(0)
+	lowHash := params.GenesisHash
+	for !lowHash.Equal(headersSelectedTip) {
+		blockHashes, err := consensus.GetHashesBetween(lowHash, headersSelectedTip, maxBlueScoreDifference)
+		if err != nil {
+			return 0, err
+		}
+		if len(blockHashes) == 0 {
+			return 0, errors.Errorf("no headers were found between %s and %s",
+				lowHash, headersSelectedTip)
+		}
+
+		serializedHeaders := make([][]byte, len(blockHashes))
+		for i, blockHash := range blockHashes {
+			header, err := consensus.GetBlockHeader(blockHash)
+			if err != nil {
+				return 0, err
+			}
+			serializedHeaders[i], err = proto.Marshal(serialization.DomainBlockHeaderToDbBlockHeader(header))
+			if err != nil {
+				return 0, errors.WithStack(err)
+			}
+		}
+		err = writeRecord(writer, recordTypeHeaders, serializedHeaders...)
+		if err != nil {
+			return 0, err
+		}
+
+		headerCount += uint64(len(blockHashes))
+		log.Debugf("Exported %d headers so far", headerCount)
+		lowHash = blockHashes[len(blockHashes)-1]
+	}
+	return headerCount, nil
+}
+
+// exportUTXOSet writes the UTXO set of the given pruning point, and
+// returns its size and multiset
+func exportUTXOSet(consensus externalapi.Consensus, pruningPointHash *externalapi.DomainHash,
+	writer *bufio.Writer) (uint64, model.Multiset, error) {
+
+	utxoCount := uint64(0)
+	utxoSetMultiset := multiset.New()
+	var fromOutpoint *externalapi.DomainOutpoint
+	for {
+		pruningPointUTXOs, err := consensus.GetPruningPointUTXOs(pruningPointHash, fromOutpoint, utxoChunkSize)
+		if err != nil {
+			if errors.Is(err, ruleerrors.ErrWrongPruningPointHash) {
+				return 0, nil, errors.Errorf("the pruning point moved from %s while its UTXO set "+
+					"was being exported. Try again", pruningPointHash)
+			}
+			return 0, nil, err
+		}
+
+		serializedUTXOs := make([][]byte, len(pruningPointUTXOs))
+		for i, pruningPointUTXO := range pruningPointUTXOs {
+			serializedUTXOs[i], err = utxo.SerializeUTXO(pruningPointUTXO.UTXOEntry, pruningPointUTXO.Outpoint)
+			if err != nil {
+				return 0, nil, err
+			}
+			utxoSetMultiset.Add(serializedUTXOs[i])
+		}
+		if len(serializedUTXOs) > 0 {
+			err = writeRecord(writer, recordTypeUTXOs, serializedUTXOs...)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+		utxoCount += uint64(len(pruningPointUTXOs))
+
+		if len(pruningPointUTXOs) < utxoChunkSize {
+			return utxoCount, utxoSetMultiset, nil
+		}
+		fromOutpoint = pruningPointUTXOs[len(pruningPointUTXOs)-1].Outpoint
+		log.Debugf("Exported %d UTXOs so far", utxoCount)
+	}
+}
@@ -0,0 +1,184 @@
+package pruningpointfile
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// A pruning point file is made of:
+//   - The magic bytes
+//   - The format version, as a little-endian uint32
+//   - The name of the network the file belongs to
+//   - Header records, with the headers of the blocks in the past of the
+//     exporting node's headers selected tip, in topological order
+//   - A pruning point block record
+//   - UTXO records, with the UTXO set of the pruning point in chunks
+//   - An end record, with the number of headers and UTXOs in the file and
+//     the multiset hash of the UTXO set
+//
+// Every record is made of its type, as a single byte, followed by its
+// payload. All variable-length fields are prefixed by their length as
+// a uvarint.
+
+// magic identifies pruning point files
+var magic = []byte("kaspapp\x00")
+
+// formatVersion is the version of the pruning point file format
+const formatVersion = 1
+
+type recordType byte
+
+const (
+	recordTypeHeaders recordType = iota + 1
+	recordTypePruningPointBlock
+	recordTypeUTXOs
+	recordTypeEnd
+)
+
+func (r recordType) String() string {
+	switch r {
+	case recordTypeHeaders:
+		return "headers"
+	case recordTypePruningPointBlock:
+		return "pruning point block"
+	case recordTypeUTXOs:
+		return "UTXOs"
+	case recordTypeEnd:
+		return "end"
+	default:
+		return "unknown"
+	}
+}
+
+// maxFieldLength is the maximum length of a field in a pruning point file.
+// It protects against allocating huge buffers for corrupted files.
+const maxFieldLength = 1 << 30
+
+func writeFileHeader(writer io.Writer, network string) error {
+	_, err := writer.Write(magic)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(writer, binary.LittleEndian, uint32(formatVersion))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeField(writer, []byte(network))
+}
+
+func readFileHeader(reader *bufio.Reader) (network string, err error) {
+	fileMagic := make([]byte, len(magic))
+	_, err = io.ReadFull(reader, fileMagic)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the file header")
+	}
+	if string(fileMagic) != string(magic) {
+		return "", errors.New("the file is not a pruning point file")
+	}
+	var fileFormatVersion uint32
+	err = binary.Read(reader, binary.LittleEndian, &fileFormatVersion)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the file header")
+	}
+	if fileFormatVersion != formatVersion {
+		return "", errors.Errorf("unsupported pruning point file format version %d. "+
+			"Supported version is %d", fileFormatVersion, formatVersion)
+	}
+	networkBytes, err := readField(reader)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the file header")
+	}
+	return string(networkBytes), nil
+}
+
+// writeRecord writes a record whose payload is made of the given fields
+func writeRecord(writer io.Writer, recordType recordType, fields ...[]byte) error {
+	_, err := writer.Write([]byte{byte(recordType)})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = writeUvarint(writer, uint64(len(fields)))
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		err := writeField(writer, field)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRecord reads a record and returns its type and the fields of its payload
+func readRecord(reader *bufio.Reader, maxFieldCount uint64) (recordType, [][]byte, error) {
+	recordTypeByte, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, errors.WithStack(err)
+	}
+	fieldCount, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return 0, nil, errors.WithStack(err)
+	}
+	if fieldCount > maxFieldCount {
+		return 0, nil, errors.Errorf("%s record has %d fields, which is above "+
+			"the maximum of %d", recordType(recordTypeByte), fieldCount, maxFieldCount)
+	}
+	fields := make([][]byte, fieldCount)
+	for i := range fields {
+		fields[i], err = readField(reader)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return recordType(recordTypeByte), fields, nil
+}
+
+func writeUvarint(writer io.Writer, value uint64) error {
+	valueBytes := make([]byte, binary.MaxVarintLen64)
+	valueSize := binary.PutUvarint(valueBytes, value)
+	_, err := writer.Write(valueBytes[:valueSize])
+	return errors.WithStack(err)
+}
+
+func writeField(writer io.Writer, field []byte) error {
+	err := writeUvarint(writer, uint64(len(field)))
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(field)
+	return errors.WithStack(err)
+}
+
+func readField(reader *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if length > maxFieldLength {
+		return nil, errors.Errorf("field length %d is above the "+
+			"maximum of %d", length, maxFieldLength)
+	}
+	field := make([]byte, length)
+	_, err = io.ReadFull(reader, field)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return field, nil
+}
+
+func serializeUint64(value uint64) []byte {
+	valueBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(valueBytes, value)
+	return valueBytes
+}
+
+func deserializeUint64(valueBytes []byte) (uint64, error) {
+	if len(valueBytes) != 8 {
+		return 0, errors.Errorf("expected 8 bytes but got %d", len(valueBytes))
+	}
+	return binary.LittleEndian.Uint64(valueBytes), nil
+}
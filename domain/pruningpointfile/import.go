@@ -0,0 +1,288 @@
+package pruningpointfile
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kaspanet/kaspad/domain/consensus/database/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// readBufferSize is the size of the buffer pruning point
+	// files are read through
+	readBufferSize = 1 << 20
+
+	// maxRecordFieldCount is the maximum number of fields
+	// in a record of a pruning point file
+	maxRecordFieldCount = 1 << 20
+)
+
+// ErrPruningPointAlreadyImported indicates that the consensus already
+// has the block data of the pruning point in a pruning point file
+var ErrPruningPointAlreadyImported = errors.New("the pruning point was already imported")
+
+// Import makes the pruning point in the file at the given path the pruning
+// point of the given consensus, the same way it's done during IBD: the
+// headers in the file are inserted first, then the pruning point UTXO set
+// is imported, and finally the pruning point block is validated and
+// inserted, which verifies the UTXO set against the pruning point's UTXO
+// commitment. The blocks above the pruning point are then synced from
+// peers.
+//
+// Import returns ErrPruningPointAlreadyImported if the consensus already
+// has the block data of the pruning point.
+func Import(consensus externalapi.Consensus, params *dagconfig.Params, path string) (*Summary, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "pruningpointfile.Import")
+	defer onEnd()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+	reader := bufio.NewReaderSize(file, readBufferSize)
+
+	network, err := readFileHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+	if network != params.Name {
+		return nil, errors.Errorf("the pruning point file was exported on %s rather than on %s",
+			network, params.Name)
+	}
+
+	headerCount, record, err := importHeaders(consensus, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	pruningPointBlock, err := deserializePruningPointBlock(record)
+	if err != nil {
+		return nil, err
+	}
+	pruningPointHash := consensushashing.BlockHash(pruningPointBlock)
+	err = verifyPruningPointCanBeImported(consensus, pruningPointHash)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		err := consensus.ClearImportedPruningPointData()
+		if err != nil {
+			log.Errorf("Error clearing the imported pruning point data: %s", err)
+		}
+	}()
+	log.Infof("Importing the UTXO set of pruning point %s", pruningPointHash)
+	utxoCount, utxoSetMultiset, record, err := importUTXOSet(consensus, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyEndRecord(record, headerCount, utxoCount, utxoSetMultiset, pruningPointBlock)
+	if err != nil {
+		return nil, err
+	}
+	_, err = reader.ReadByte()
+	if err != io.EOF {
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return nil, errors.New("unexpected data after the end of the pruning point file")
+	}
+
+	log.Infof("Validating and inserting pruning point %s", pruningPointHash)
+	err = consensus.ValidateAndInsertImportedPruningPoint(pruningPointBlock)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to import pruning point %s", pruningPointHash)
+	}
+
+	log.Infof("Imported pruning point %s with %d headers and %d UTXOs",
+		pruningPointHash, headerCount, utxoCount)
+	return &Summary{
+		PruningPointHash: pruningPointHash,
+		HeaderCount:      headerCount,
+		UTXOCount:        utxoCount,
+	}, nil
+}
+
+// importHeaders inserts the headers in the header records, and returns
+// their number and the record that follows them
+func importHeaders(consensus externalapi.Consensus, reader *bufio.Reader) (uint64, [][]byte, error) {
+	headerCount := uint64(0)
+	for {
+		recordType, fields, err := readRecord(reader, maxRecordFieldCount)
+		if err != nil {
+			return 0, nil, err
+		}
+		if recordType != recordTypeHeaders {
+			if recordType != recordTypePruningPointBlock {
+				return 0, nil, errors.Errorf("unexpected %s record after %d headers",
+					recordType, headerCount)
+			}
+			return headerCount, fields, nil
+		}
+
+		for _, serializedHeader := range fields {
+			err := importHeader(consensus, serializedHeader)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+		headerCount += uint64(len(fields))
+		log.Debugf("Imported %d headers so far", headerCount)
+	}
+}
+
+func importHeader(consensus externalapi.Consensus, serializedHeader []byte) error {
+	dbBlockHeader := &serialization.DbBlockHeader{}
+	err := proto.Unmarshal(serializedHeader, dbBlockHeader)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	header, err := serialization.DbBlockHeaderToDomainBlockHeader(dbBlockHeader)
+	if err != nil {
+		return err
+	}
+	blockHash := consensushashing.HeaderHash(header)
+
+	blockInfo, err := consensus.GetBlockInfo(blockHash)
+	if err != nil {
+		return err
+	}
+	if blockInfo.Exists {
+		return nil
+	}
+	_, err = consensus.ValidateAndInsertBlock(&externalapi.DomainBlock{Header: header})
+	if err != nil {
+		if errors.Is(err, ruleerrors.ErrDuplicateBlock) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to insert header %s", blockHash)
+	}
+	return nil
+}
+
+func deserializePruningPointBlock(fields [][]byte) (*externalapi.DomainBlock, error) {
+	if len(fields) != 1 {
+		return nil, errors.Errorf("expected a single pruning point block but got %d", len(fields))
+	}
+	dbBlock := &serialization.DbBlock{}
+	err := proto.Unmarshal(fields[0], dbBlock)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return serialization.DbBlockToDomainBlock(dbBlock)
+}
+
+// verifyPruningPointCanBeImported makes the same checks IBD makes before
+// it requests the UTXO set of a pruning point from a peer
+func verifyPruningPointCanBeImported(consensus externalapi.Consensus, pruningPointHash *externalapi.DomainHash) error {
+	blockInfo, err := consensus.GetBlockInfo(pruningPointHash)
+	if err != nil {
+		return err
+	}
+	if !blockInfo.Exists {
+		return errors.Errorf("the header of pruning point %s is missing from the file", pruningPointHash)
+	}
+	if blockInfo.BlockStatus != externalapi.StatusHeaderOnly {
+		return errors.Wrapf(ErrPruningPointAlreadyImported, "already has the block data of pruning point %s",
+			pruningPointHash)
+	}
+
+	isValid, err := consensus.IsValidPruningPoint(pruningPointHash)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return errors.Errorf("%s is not a valid pruning point for the DAG of this node", pruningPointHash)
+	}
+	return nil
+}
+
+// importUTXOSet imports the UTXOs in the UTXO records, and returns their
+// number, their multiset and the record that follows them
+func importUTXOSet(consensus externalapi.Consensus, reader *bufio.Reader) (uint64, model.Multiset, [][]byte, error) {
+	utxoCount := uint64(0)
+	utxoSetMultiset := multiset.New()
+	for {
+		recordType, fields, err := readRecord(reader, maxRecordFieldCount)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		if recordType != recordTypeUTXOs {
+			if recordType != recordTypeEnd {
+				return 0, nil, nil, errors.Errorf("unexpected %s record after %d UTXOs",
+					recordType, utxoCount)
+			}
+			return utxoCount, utxoSetMultiset, fields, nil
+		}
+
+		outpointAndUTXOEntryPairs := make([]*externalapi.OutpointAndUTXOEntryPair, len(fields))
+		for i, serializedUTXO := range fields {
+			entry, outpoint, err := utxo.DeserializeUTXO(serializedUTXO)
+			if err != nil {
+				return 0, nil, nil, err
+			}
+			outpointAndUTXOEntryPairs[i] = &externalapi.OutpointAndUTXOEntryPair{
+				Outpoint:  outpoint,
+				UTXOEntry: entry,
+			}
+			utxoSetMultiset.Add(serializedUTXO)
+		}
+		err = consensus.AppendImportedPruningPointUTXOs(outpointAndUTXOEntryPairs)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		utxoCount += uint64(len(fields))
+		log.Debugf("Imported %d UTXOs so far", utxoCount)
+	}
+}
+
+// verifyEndRecord makes sure that the file contained everything it was
+// written with, and that the UTXO set matches the pruning point's UTXO
+// commitment before the pruning point is inserted
+func verifyEndRecord(fields [][]byte, headerCount uint64, utxoCount uint64,
+	utxoSetMultiset model.Multiset, pruningPointBlock *externalapi.DomainBlock) error {
+
+	if len(fields) != 3 {
+		return errors.Errorf("expected 3 fields in the end record but got %d", len(fields))
+	}
+	expectedHeaderCount, err := deserializeUint64(fields[0])
+	if err != nil {
+		return err
+	}
+	expectedUTXOCount, err := deserializeUint64(fields[1])
+	if err != nil {
+		return err
+	}
+	expectedUTXOSetHash, err := externalapi.NewDomainHashFromByteSlice(fields[2])
+	if err != nil {
+		return err
+	}
+
+	if headerCount != expectedHeaderCount || utxoCount != expectedUTXOCount {
+		return errors.Errorf("the file contains %d headers and %d UTXOs rather than the "+
+			"%d headers and %d UTXOs recorded in it", headerCount, utxoCount, expectedHeaderCount, expectedUTXOCount)
+	}
+	utxoSetHash := utxoSetMultiset.Hash()
+	if !utxoSetHash.Equal(expectedUTXOSetHash) {
+		return errors.Errorf("the UTXO set hash %s doesn't match the hash %s recorded in the file",
+			utxoSetHash, expectedUTXOSetHash)
+	}
+	if !utxoSetHash.Equal(pruningPointBlock.Header.UTXOCommitment()) {
+		return errors.Errorf("the UTXO set hash %s doesn't match the UTXO commitment %s of the pruning point",
+			utxoSetHash, pruningPointBlock.Header.UTXOCommitment())
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package pruningpointfile
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("PPFL")
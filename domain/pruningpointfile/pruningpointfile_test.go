@@ -0,0 +1,116 @@
+package pruningpointfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/domain/pruningpointfile"
+	"github.com/pkg/errors"
+)
+
+func TestExportAndImport(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, params *dagconfig.Params) {
+		// This is done to reduce the pruning depth to 6 blocks
+		params.FinalityDuration = 2 * params.TargetTimePerBlock
+		params.K = 0
+
+		factory := consensus.NewFactory()
+		tcExporter, teardownExporter, err := factory.NewTestConsensus(params, false, "TestExportAndImportExporter")
+		if err != nil {
+			t.Fatalf("Error setting up tcExporter: %+v", err)
+		}
+		defer teardownExporter(false)
+
+		tcImporter, teardownImporter, err := factory.NewTestConsensus(params, false, "TestExportAndImportImporter")
+		if err != nil {
+			t.Fatalf("Error setting up tcImporter: %+v", err)
+		}
+		defer teardownImporter(false)
+
+		tipHash := params.GenesisHash
+		for {
+			tipHash, _, err = tcExporter.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			pruningPoint, err := tcExporter.PruningPoint()
+			if err != nil {
+				t.Fatalf("PruningPoint: %+v", err)
+			}
+			if !pruningPoint.Equal(params.GenesisHash) {
+				break
+			}
+		}
+		pruningPoint, err := tcExporter.PruningPoint()
+		if err != nil {
+			t.Fatalf("PruningPoint: %+v", err)
+		}
+
+		dir, err := ioutil.TempDir("", "TestExportAndImport")
+		if err != nil {
+			t.Fatalf("TempDir: %+v", err)
+		}
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "pruningpoint")
+
+		exportSummary, err := pruningpointfile.Export(tcExporter, params, path)
+		if err != nil {
+			t.Fatalf("Export: %+v", err)
+		}
+		if !exportSummary.PruningPointHash.Equal(pruningPoint) {
+			t.Fatalf("Exported pruning point %s. Want: %s", exportSummary.PruningPointHash, pruningPoint)
+		}
+		if exportSummary.HeaderCount == 0 || exportSummary.UTXOCount == 0 {
+			t.Fatalf("Unexpectedly empty export: %d headers and %d UTXOs",
+				exportSummary.HeaderCount, exportSummary.UTXOCount)
+		}
+
+		_, err = pruningpointfile.Export(tcExporter, params, path)
+		if err == nil {
+			t.Fatalf("Export unexpectedly overwrote an existing file")
+		}
+
+		importSummary, err := pruningpointfile.Import(tcImporter, params, path)
+		if err != nil {
+			t.Fatalf("Import: %+v", err)
+		}
+		if !importSummary.PruningPointHash.Equal(exportSummary.PruningPointHash) ||
+			importSummary.HeaderCount != exportSummary.HeaderCount || importSummary.UTXOCount != exportSummary.UTXOCount {
+
+			t.Fatalf("Import summary %+v is different from the export summary %+v", importSummary, exportSummary)
+		}
+
+		importedPruningPoint, err := tcImporter.PruningPoint()
+		if err != nil {
+			t.Fatalf("PruningPoint: %+v", err)
+		}
+		if !importedPruningPoint.Equal(pruningPoint) {
+			t.Fatalf("The pruning point after the import is %s. Want: %s", importedPruningPoint, pruningPoint)
+		}
+		pruningPointInfo, err := tcImporter.GetBlockInfo(pruningPoint)
+		if err != nil {
+			t.Fatalf("GetBlockInfo: %+v", err)
+		}
+		if pruningPointInfo.BlockStatus != externalapi.StatusUTXOValid {
+			t.Fatalf("Unexpected pruning point status %s after the import", pruningPointInfo.BlockStatus)
+		}
+		headersSelectedTip, err := tcImporter.GetHeadersSelectedTip()
+		if err != nil {
+			t.Fatalf("GetHeadersSelectedTip: %+v", err)
+		}
+		if !headersSelectedTip.Equal(tipHash) {
+			t.Fatalf("The headers selected tip after the import is %s. Want: %s", headersSelectedTip, tipHash)
+		}
+
+		_, err = pruningpointfile.Import(tcImporter, params, path)
+		if !errors.Is(err, pruningpointfile.ErrPruningPointAlreadyImported) {
+			t.Fatalf("Importing the same pruning point again returned an unexpected error: %+v", err)
+		}
+	})
+}
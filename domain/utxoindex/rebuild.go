@@ -0,0 +1,253 @@
+package utxoindex
+
+import (
+	"fmt"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/util/panics"
+	"github.com/pkg/errors"
+)
+
+// rebuildChunkSize is the number of entries that are deleted or
+// indexed at a time while the UTXO index is rebuilt
+const rebuildChunkSize = 1000
+
+// ErrNotReady indicates that the UTXO index is being rebuilt, and
+// can't be queried until the rebuild is done
+var ErrNotReady = errors.New("the UTXO index is being rebuilt")
+
+// ErrAlreadyRebuilding indicates that a rebuild of the UTXO index
+// was requested while the index is already being rebuilt
+var ErrAlreadyRebuilding = errors.New("the UTXO index is already being rebuilt")
+
+var spawn = panics.GoroutineWrapperFunc(log)
+
+// rebuildState is the state of a running rebuild of the UTXO index
+type rebuildState struct {
+	// isDeleting is true while the entries of the old index are deleted
+	isDeleting bool
+
+	indexedUTXOCount uint64
+	progressPercent  int
+
+	// virtualParents are the virtual parents the index is synced with once
+	// the rebuild is done. They're staged by Update instead of the store,
+	// so the index isn't marked as synced before it's complete.
+	virtualParents []*externalapi.DomainHash
+
+	// err is the error the rebuild failed with. The index stays
+	// not ready until it's rebuilt again.
+	err error
+}
+
+func (state *rebuildState) String() string {
+	if state.err != nil {
+		return fmt.Sprintf("the rebuild failed: %s", state.err)
+	}
+	if state.isDeleting {
+		return "deleting the old index"
+	}
+	return fmt.Sprintf("%d%% done, %d UTXOs indexed", state.progressPercent, state.indexedUTXOCount)
+}
+
+// Rebuild deletes the UTXO index and rebuilds it from consensus in the
+// background, while blocks keep being added. Until the rebuild is done,
+// UTXOs returns ErrNotReady. If kaspad stops before the rebuild is done,
+// the index is reset when kaspad starts again.
+//
+// The index is rebuilt in chunks, and Update keeps applying the changes
+// of new blocks between them:
+// 1. While the old entries are deleted, the changes are ignored. The
+//    entries they would change are read from consensus afterwards.
+// 2. While the virtual UTXO set is indexed, the changes are applied as
+//    usual. A chunk is always read and indexed before the changes of
+//    the blocks added after it was read are applied, so the index ends
+//    up the same as the virtual UTXO set.
+func (ui *UTXOIndex) Rebuild() error {
+	state, err := ui.startRebuild()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Rebuilding the UTXO index")
+	spawn("UTXOIndex.Rebuild", func() {
+		err := ui.runRebuild(state)
+		if err != nil {
+			log.Errorf("Failed to rebuild the UTXO index. Rebuild it again, or restart "+
+				"kaspad to reset it: %s", err)
+		}
+	})
+	return nil
+}
+
+func (ui *UTXOIndex) startRebuild() (*rebuildState, error) {
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	if ui.rebuild != nil && ui.rebuild.err == nil {
+		return nil, ErrAlreadyRebuilding
+	}
+
+	virtualInfo, err := ui.consensus.GetVirtualInfo()
+	if err != nil {
+		return nil, err
+	}
+	// The virtual parents are deleted first, so that the index
+	// is reset if kaspad stops before the rebuild is done
+	err = ui.store.deleteVirtualParents()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &rebuildState{
+		isDeleting:     true,
+		virtualParents: virtualInfo.ParentHashes,
+	}
+	ui.rebuild = state
+	return state, nil
+}
+
+func (ui *UTXOIndex) runRebuild(state *rebuildState) error {
+	for {
+		isDone, err := ui.rebuildChunk(state, ui.deleteChunk)
+		if err != nil {
+			return err
+		}
+		if isDone {
+			break
+		}
+	}
+
+	var lastOutpoints []*externalapi.DomainOutpoint
+	for {
+		indexChunk := func(state *rebuildState) (bool, error) {
+			var isDone bool
+			var err error
+			isDone, lastOutpoints, err = ui.indexChunk(state, lastOutpoints)
+			return isDone, err
+		}
+		isDone, err := ui.rebuildChunk(state, indexChunk)
+		if err != nil {
+			return err
+		}
+		if isDone {
+			return nil
+		}
+	}
+}
+
+// rebuildChunk runs the given rebuild step while holding the index lock,
+// unless the rebuild was canceled by Reset
+func (ui *UTXOIndex) rebuildChunk(state *rebuildState,
+	step func(state *rebuildState) (isDone bool, err error)) (bool, error) {
+
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	if ui.rebuild != state {
+		log.Infof("The rebuild of the UTXO index was canceled")
+		return true, nil
+	}
+	isDone, err := step(state)
+	if err != nil {
+		state.err = err
+		return false, err
+	}
+	return isDone, nil
+}
+
+func (ui *UTXOIndex) deleteChunk(state *rebuildState) (bool, error) {
+	deletedCount, err := ui.store.deleteChunk(rebuildChunkSize)
+	if err != nil {
+		return false, err
+	}
+	if deletedCount < rebuildChunkSize {
+		log.Infof("Deleted the old UTXO index. Indexing the virtual UTXO set")
+		state.isDeleting = false
+		return true, nil
+	}
+	return false, nil
+}
+
+// indexChunk indexes the chunk of the virtual UTXO set that follows
+// the given last outpoints of the previous chunk, and returns the
+// outpoints of this chunk
+func (ui *UTXOIndex) indexChunk(state *rebuildState, lastOutpoints []*externalapi.DomainOutpoint) (
+	bool, []*externalapi.DomainOutpoint, error) {
+
+	virtualUTXOs, err := ui.nextVirtualUTXOs(lastOutpoints)
+	if err != nil {
+		return false, nil, err
+	}
+	err = ui.store.addAndCommitOutpointsWithoutTransaction(virtualUTXOs)
+	if err != nil {
+		return false, nil, err
+	}
+
+	state.indexedUTXOCount += uint64(len(virtualUTXOs))
+	if len(virtualUTXOs) < rebuildChunkSize {
+		err := ui.store.updateAndCommitVirtualParentsWithoutTransaction(state.virtualParents)
+		if err != nil {
+			return false, nil, err
+		}
+		ui.rebuild = nil
+		log.Infof("Rebuilt the UTXO index with %d UTXOs", state.indexedUTXOCount)
+		return true, nil, nil
+	}
+
+	// The virtual UTXO set is ordered by transaction ID, and transaction
+	// IDs are uniformly distributed, so the position of the last
+	// transaction ID estimates the progress
+	lastTransactionID := virtualUTXOs[len(virtualUTXOs)-1].Outpoint.TransactionID.ByteSlice()
+	progressPercent := (int(lastTransactionID[0])<<8 | int(lastTransactionID[1])) * 100 / (1 << 16)
+	if progressPercent/10 > state.progressPercent/10 {
+		log.Infof("Rebuilding the UTXO index: %d%% done, %d UTXOs indexed",
+			progressPercent, state.indexedUTXOCount)
+	}
+	state.progressPercent = progressPercent
+
+	outpoints := make([]*externalapi.DomainOutpoint, len(virtualUTXOs))
+	for i, virtualUTXO := range virtualUTXOs {
+		outpoints[i] = virtualUTXO.Outpoint
+	}
+	return false, outpoints, nil
+}
+
+// nextVirtualUTXOs returns the chunk of the virtual UTXO set that follows
+// the given outpoints. The outpoints may have been spent since they were
+// read, so the chunk follows the last one of them that's still unspent.
+// Indexing UTXOs again is harmless, so if all of them were spent, the
+// virtual UTXO set is read from its start again.
+func (ui *UTXOIndex) nextVirtualUTXOs(lastOutpoints []*externalapi.DomainOutpoint) (
+	[]*externalapi.OutpointAndUTXOEntryPair, error) {
+
+	for i := len(lastOutpoints) - 1; i >= -1; i-- {
+		var fromOutpoint *externalapi.DomainOutpoint
+		if i >= 0 {
+			fromOutpoint = lastOutpoints[i]
+		}
+
+		for {
+			virtualInfo, err := ui.consensus.GetVirtualInfo()
+			if err != nil {
+				return nil, err
+			}
+			virtualUTXOs, err := ui.consensus.GetVirtualUTXOs(virtualInfo.ParentHashes, fromOutpoint, rebuildChunkSize)
+			if err == nil {
+				return virtualUTXOs, nil
+			}
+			// A block was added between the two calls
+			if errors.Is(err, ruleerrors.ErrGetVirtualUTXOsWrongVirtualParents) {
+				continue
+			}
+			if database.IsNotFoundError(err) {
+				break
+			}
+			return nil, err
+		}
+	}
+
+	return nil, errors.New("could not find where to continue indexing the virtual UTXO set")
+}
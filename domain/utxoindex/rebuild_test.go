@@ -0,0 +1,104 @@
+package utxoindex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+func TestRebuild(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, params *dagconfig.Params) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(params, false, "TestRebuild")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		utxoIndex, err := New(tc, tc.Database())
+		if err != nil {
+			t.Fatalf("New: %+v", err)
+		}
+
+		scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1}, Version: 0}
+		coinbaseData := &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey}
+		tip := params.GenesisHash
+		addBlock := func() {
+			var blockInsertionResult *externalapi.BlockInsertionResult
+			tip, blockInsertionResult, err = tc.AddBlock([]*externalapi.DomainHash{tip}, coinbaseData, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			_, err = utxoIndex.Update(blockInsertionResult)
+			if err != nil {
+				t.Fatalf("Update: %+v", err)
+			}
+		}
+		for i := 0; i < 5; i++ {
+			addBlock()
+		}
+
+		state, err := utxoIndex.startRebuild()
+		if err != nil {
+			t.Fatalf("startRebuild: %+v", err)
+		}
+		_, err = utxoIndex.startRebuild()
+		if !errors.Is(err, ErrAlreadyRebuilding) {
+			t.Fatalf("Unexpected error from startRebuild. Want: %s, got: %v", ErrAlreadyRebuilding, err)
+		}
+		_, err = utxoIndex.UTXOs(scriptPublicKey)
+		if !errors.Is(err, ErrNotReady) {
+			t.Fatalf("Unexpected error from UTXOs. Want: %s, got: %v", ErrNotReady, err)
+		}
+
+		// Blocks are added during both phases of the rebuild
+		addBlock()
+		isDone, err := utxoIndex.rebuildChunk(state, utxoIndex.deleteChunk)
+		if err != nil || !isDone {
+			t.Fatalf("Unexpected result from deleting the index: %t, %+v", isDone, err)
+		}
+		addBlock()
+		err = utxoIndex.runRebuild(state)
+		if err != nil {
+			t.Fatalf("runRebuild: %+v", err)
+		}
+		addBlock()
+
+		rebuiltUTXOs := utxos(t, utxoIndex, scriptPublicKey)
+		if len(rebuiltUTXOs) == 0 {
+			t.Fatalf("The rebuilt index has no UTXOs")
+		}
+		isSynced, err := utxoIndex.isSynced()
+		if err != nil {
+			t.Fatalf("isSynced: %+v", err)
+		}
+		if !isSynced {
+			t.Fatalf("The rebuilt index is not synced")
+		}
+
+		// The rebuilt index must be the same as an index that's reset
+		// while no blocks are added
+		err = utxoIndex.Reset()
+		if err != nil {
+			t.Fatalf("Reset: %+v", err)
+		}
+		resetUTXOs := utxos(t, utxoIndex, scriptPublicKey)
+		if !reflect.DeepEqual(rebuiltUTXOs, resetUTXOs) {
+			t.Fatalf("The rebuilt index differs from the reset index.\nRebuilt: %v\nReset: %v",
+				rebuiltUTXOs, resetUTXOs)
+		}
+	})
+}
+
+func utxos(t *testing.T, utxoIndex *UTXOIndex, scriptPublicKey *externalapi.ScriptPublicKey) UTXOOutpointEntryPairs {
+	utxoOutpointEntryPairs, err := utxoIndex.UTXOs(scriptPublicKey)
+	if err != nil {
+		t.Fatalf("UTXOs: %+v", err)
+	}
+	return utxoOutpointEntryPairs
+}
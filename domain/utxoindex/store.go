@@ -149,10 +149,14 @@ func (uis *utxoIndexStore) commit() error {
 		}
 	}
 
-	serializeParentHashes := serializeHashes(uis.virtualParents)
-	err = dbTransaction.Put(virtualParentsKey, serializeParentHashes)
-	if err != nil {
-		return err
+	// The virtual parents are not staged while the index is being rebuilt,
+	// since it only becomes synced with them once the rebuild is done
+	if uis.virtualParents != nil {
+		serializeParentHashes := serializeHashes(uis.virtualParents)
+		err = dbTransaction.Put(virtualParentsKey, serializeParentHashes)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = dbTransaction.Commit()
@@ -289,7 +293,7 @@ func (uis *utxoIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error
 func (uis *utxoIndexStore) deleteAll() error {
 	// First we delete the virtual parents, so if anything goes wrong, the UTXO index will be marked as "not synced"
 	// and will be reset.
-	err := uis.database.Delete(virtualParentsKey)
+	err := uis.deleteVirtualParents()
 	if err != nil {
 		return err
 	}
@@ -313,3 +317,54 @@ func (uis *utxoIndexStore) deleteAll() error {
 
 	return nil
 }
+
+func (uis *utxoIndexStore) deleteVirtualParents() error {
+	return uis.database.Delete(virtualParentsKey)
+}
+
+// deleteChunk deletes up to limit entries of the UTXO index,
+// and returns the number of entries it deleted
+func (uis *utxoIndexStore) deleteChunk(limit int) (int, error) {
+	keys, err := uis.keys(limit)
+	if err != nil {
+		return 0, err
+	}
+
+	dbTransaction, err := uis.database.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+	for _, key := range keys {
+		err := dbTransaction.Delete(key)
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = dbTransaction.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// keys returns up to limit keys of the UTXO index. The keys are
+// copied, since the cursor they're read with may reuse them.
+func (uis *utxoIndexStore) keys(limit int) ([]*database.Key, error) {
+	cursor, err := uis.database.Cursor(utxoIndexBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	rootBucket := database.MakeBucket(nil)
+	var keys []*database.Key
+	for len(keys) < limit && cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, rootBucket.Key(key.Bytes()))
+	}
+	return keys, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
 	"sync"
 )
 
@@ -14,6 +15,10 @@ type UTXOIndex struct {
 	store     *utxoIndexStore
 
 	mutex sync.Mutex
+
+	// rebuild is the state of the running rebuild,
+	// or nil if the index isn't being rebuilt
+	rebuild *rebuildState
 }
 
 // New creates a new UTXO index.
@@ -41,7 +46,15 @@ func New(consensus externalapi.Consensus, database database.Database) (*UTXOInde
 }
 
 // Reset deletes the whole UTXO index and resyncs it from consensus.
+// A running rebuild of the index is canceled.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
 func (ui *UTXOIndex) Reset() error {
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	ui.rebuild = nil
+
 	err := ui.store.deleteAll()
 	if err != nil {
 		return err
@@ -112,7 +125,11 @@ func (ui *UTXOIndex) Update(blockInsertionResult *externalapi.BlockInsertionResu
 		return nil, err
 	}
 
-	ui.store.updateVirtualParents(blockInsertionResult.VirtualParents)
+	if ui.rebuild == nil {
+		ui.store.updateVirtualParents(blockInsertionResult.VirtualParents)
+	} else {
+		ui.rebuild.virtualParents = blockInsertionResult.VirtualParents
+	}
 
 	added, removed, _ := ui.store.stagedData()
 	utxoIndexChanges := &UTXOChanges{
@@ -120,9 +137,15 @@ func (ui *UTXOIndex) Update(blockInsertionResult *externalapi.BlockInsertionResu
 		Removed: removed,
 	}
 
-	err = ui.store.commit()
-	if err != nil {
-		return nil, err
+	// See Rebuild for why the changes are ignored while
+	// the old entries of a rebuilt index are deleted
+	if ui.rebuild != nil && ui.rebuild.isDeleting {
+		ui.store.discard()
+	} else {
+		err = ui.store.commit()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	log.Tracef("UTXO index updated with the UTXOChanged: %+v", utxoIndexChanges)
@@ -173,5 +196,9 @@ func (ui *UTXOIndex) UTXOs(scriptPublicKey *externalapi.ScriptPublicKey) (UTXOOu
 	ui.mutex.Lock()
 	defer ui.mutex.Unlock()
 
+	if ui.rebuild != nil {
+		return nil, errors.Wrapf(ErrNotReady, "%s", ui.rebuild)
+	}
+
 	return ui.store.getUTXOOutpointEntryPairs(scriptPublicKey)
 }
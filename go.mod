@@ -12,7 +12,9 @@ require (
 	github.com/kaspanet/go-secp256k1 v0.0.3
 	github.com/pkg/errors v0.9.1
 	github.com/syndtr/goleveldb v1.0.1-0.20190923125748-758128399b1d
+	go.etcd.io/bbolt v1.3.6
 	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897
+	golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3
 	google.golang.org/grpc v1.33.1
 	google.golang.org/protobuf v1.25.0
 )
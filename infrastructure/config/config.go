@@ -18,6 +18,7 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/kaspanet/kaspad/util/network"
@@ -33,6 +34,7 @@ const (
 	defaultDataDirname         = "data"
 	defaultLogLevel            = "info"
 	defaultLogDirname          = "logs"
+	defaultSnapshotDirname     = "snapshots"
 	defaultLogFilename         = "kaspad.log"
 	defaultErrLogFilename      = "kaspad_err.log"
 	defaultTargetOutboundPeers = 8
@@ -54,6 +56,10 @@ const (
 	defaultSigCacheMaxSize  = 100000
 	sampleConfigFilename    = "sample-kaspad.conf"
 	defaultMaxUTXOCacheSize = 5000000000
+	defaultDbType           = "leveldb"
+	defaultDbSyncMode       = "transactions"
+	defaultCacheSizeMiB     = 1024
+	minCacheSizeMiB         = 64
 )
 
 var (
@@ -85,6 +91,8 @@ type Flags struct {
 	Listeners            []string      `long:"listen" description:"Add an interface/port to listen for connections (default all interfaces port: 16111, testnet: 16211)"`
 	TargetOutboundPeers  int           `long:"outpeers" description:"Target number of outbound peers"`
 	MaxInboundPeers      int           `long:"maxinpeers" description:"Max number of inbound peers"`
+	MaxPeerIBDUploadRate int           `long:"maxpeeribduploadrate" description:"Max rate, in KB/s, at which IBD data is uploaded to each peer (0 for unlimited)"`
+	MaxIBDUploadRate     int           `long:"maxibduploadrate" description:"Max rate, in KB/s, at which IBD data is uploaded to all peers together (0 for unlimited)"`
 	DisableBanning       bool          `long:"nobanning" description:"Disable banning of misbehaving peers"`
 	BanDuration          time.Duration `long:"banduration" description:"How long to ban misbehaving peers. Valid time units are {s, m, h}. Minimum 1 second"`
 	BanThreshold         uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
@@ -100,13 +108,19 @@ type Flags struct {
 	DNSSeed              string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
 	GRPCSeed             string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
 	ExternalIPs          []string      `long:"externalip" description:"Add an ip to the list of local addresses we claim to listen on to peers"`
-	Proxy                string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
+	Proxy                string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050) -- NOTE: DNS and gRPC seeding are disabled, and host names are not resolved, when using a proxy"`
 	ProxyUser            string        `long:"proxyuser" description:"Username for proxy server"`
 	ProxyPass            string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
-	DbType               string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
+	OnlyOnion            bool          `long:"onlyonion" description:"Only connect to and advertise Tor .onion addresses, through the proxy specified by --proxy -- NOTE: DNS and gRPC seeding are disabled in this mode"`
+	DbType               string        `long:"dbtype" description:"Database backend to use for the Block DAG {leveldb, bbolt, memory}. The memory backend loses all data on shutdown"`
+	DbSyncMode           string        `long:"dbsyncmode" description:"When to flush database writes to disk {none, transactions, full} -- none is the fastest, but a power loss may then leave the database inconsistent"`
+	DbCompression        bool          `long:"dbcompression" description:"Compress the database with snappy. Only applies to new leveldb data"`
+	DbAutoCompact        bool          `long:"dbautocompact" description:"Compact the database in the background whenever the pruning point moves, to reclaim the disk space of pruned blocks sooner"`
+	CacheSizeMiB         int           `long:"cachesize" description:"Memory budget in MiB for in-memory caches, split between the consensus stores and the database cache"`
+	CheckDatabase        bool          `long:"checkdb" description:"Verify the consistency of the database on startup even if kaspad was shut down cleanly. The database is always verified after an unclean shutdown"`
 	Profile              string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	LogLevel             string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
-	Upnp                 bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
+	Upnp                 bool          `long:"upnp" description:"Use UPnP or NAT-PMP to map our listening port outside of NAT"`
 	MinRelayTxFee        float64       `long:"minrelaytxfee" description:"The minimum transaction fee in KAS/kB to be considered a non-zero fee."`
 	MaxOrphanTxs         int           `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
 	BlockMaxMass         uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
@@ -117,8 +131,12 @@ type Flags struct {
 	RelayNonStd          bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
 	RejectNonStd         bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	ResetDatabase        bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
+	RestoreDatabase      string        `long:"restore-db" description:"Restore the database from a snapshot file created with the CreateDatabaseSnapshot RPC or kaspasnapshot before starting node. There must be no existing database, so combine with --reset-db to replace one"`
+	SnapshotDir          string        `long:"snapshotdir" description:"Directory that the CreateDatabaseSnapshot and ExportPruningPointUTXOSet RPCs write their files into (default: snapshots under the data directory)"`
+	ImportPruningPoint   string        `long:"import-pruning-point" description:"Bootstrap the node from a pruning point file created with the ExportPruningPointUTXOSet RPC or kaspapruningpoint, instead of downloading the pruning point UTXO set from peers"`
 	MaxUTXOCacheSize     uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex            bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	ReindexUTXOIndex     bool          `long:"reindex-utxoindex" description:"Rebuild the UTXO index in the background from the virtual UTXO set. Queries of the UTXO index fail until the rebuild is complete. Requires --utxoindex"`
 	IsArchivalNode       bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
 	Delay                float32       `long:"delay" description:"Provide a delay in seconds as a floating point"`
 	NetworkFlags
@@ -136,6 +154,7 @@ type Config struct {
 	MinRelayTxFee util.Amount
 	Whitelists    []*net.IPNet
 	SubnetworkID  *externalapi.DomainSubnetworkID // nil in full nodes
+	DbSyncMode    database.SyncMode
 }
 
 // ServiceOptions defines the configuration options for the daemon as a service on
@@ -187,6 +206,9 @@ func defaultFlags() *Flags {
 		SigCacheMaxSize:      defaultSigCacheMaxSize,
 		MinRelayTxFee:        defaultMinRelayTxFee,
 		MaxUTXOCacheSize:     defaultMaxUTXOCacheSize,
+		DbType:               defaultDbType,
+		DbSyncMode:           defaultDbSyncMode,
+		CacheSizeMiB:         defaultCacheSizeMiB,
 		ServiceOptions:       &ServiceOptions{},
 	}
 }
@@ -329,6 +351,22 @@ func LoadConfig() (*Config, error) {
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
 	cfg.LogDir = filepath.Join(cfg.LogDir, cfg.NetParams().Name)
 
+	// RPC clients may only have files written into the snapshot directory,
+	// which is namespaced per network along with the data directory by
+	// default
+	if cfg.SnapshotDir == "" {
+		cfg.SnapshotDir = filepath.Join(cfg.DataDir, defaultSnapshotDirname)
+	} else {
+		cfg.SnapshotDir = cleanAndExpandPath(cfg.SnapshotDir)
+	}
+
+	if cfg.RestoreDatabase != "" {
+		cfg.RestoreDatabase = cleanAndExpandPath(cfg.RestoreDatabase)
+	}
+	if cfg.ImportPruningPoint != "" {
+		cfg.ImportPruningPoint = cleanAndExpandPath(cfg.ImportPruningPoint)
+	}
+
 	// Special show command to list supported subsystems and exit.
 	if cfg.LogLevel == "show" {
 		fmt.Println("Supported subsystems", logger.SupportedSubsystems())
@@ -368,6 +406,22 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.MaxPeerIBDUploadRate < 0 {
+		str := "%s: The maxpeeribduploadrate option may not be negative -- parsed [%d]"
+		err := errors.Errorf(str, funcName, cfg.MaxPeerIBDUploadRate)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	if cfg.MaxIBDUploadRate < 0 {
+		str := "%s: The maxibduploadrate option may not be negative -- parsed [%d]"
+		err := errors.Errorf(str, funcName, cfg.MaxIBDUploadRate)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Validate any given whitelisted IP addresses and networks.
 	if len(cfg.Whitelists) > 0 {
 		var ip net.IP
@@ -410,12 +464,45 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// --onlyonion requires a proxy to reach the onion addresses through.
+	if cfg.OnlyOnion && cfg.Proxy == "" {
+		str := "%s: the --onlyonion option requires --proxy"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// --reindex-utxoindex rebuilds the UTXO index, so it requires one
+	if cfg.ReindexUTXOIndex && !cfg.UTXOIndex {
+		str := "%s: the --reindex-utxoindex option requires --utxoindex"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Validate the cache size budget.
+	if cfg.CacheSizeMiB < minCacheSizeMiB {
+		str := "%s: The cachesize option may not be less than %d MiB -- parsed [%d]"
+		err := errors.Errorf(str, funcName, minCacheSizeMiB, cfg.CacheSizeMiB)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// --proxy or --connect without --listen disables listening.
 	if (cfg.Proxy != "" || len(cfg.ConnectPeers) > 0) &&
 		len(cfg.Listeners) == 0 {
 		cfg.DisableListen = true
 	}
 
+	// --proxy disables seeding, since the seeders are resolved through the
+	// system resolver and dialed directly, which would bypass the proxy.
+	if cfg.Proxy != "" {
+		cfg.DisableDNSSeed = true
+	}
+
 	// ConnectPeers means no DNS seeding and no outbound peers
 	if len(cfg.ConnectPeers) > 0 {
 		cfg.DisableDNSSeed = true
@@ -453,6 +540,16 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// Validate the dbsyncmode.
+	cfg.DbSyncMode, err = database.ParseSyncMode(cfg.Flags.DbSyncMode)
+	if err != nil {
+		str := "%s: invalid dbsyncmode: %s"
+		err := errors.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Validate the the minrelaytxfee.
 	cfg.MinRelayTxFee, err = util.NewAmount(cfg.Flags.MinRelayTxFee)
 	if err != nil {
@@ -550,7 +647,8 @@ func LoadConfig() (*Config, error) {
 	// specified options. The default is to use the standard
 	// net.DialTimeout function as well as the system DNS resolver. When a
 	// proxy is specified, the dial function is set to the proxy specific
-	// dial function.
+	// dial function, and DNS resolution is refused so that host names
+	// never leak to the system resolver.
 	cfg.Dial = net.DialTimeout
 	cfg.Lookup = net.LookupIP
 	if cfg.Proxy != "" {
@@ -569,6 +667,9 @@ func LoadConfig() (*Config, error) {
 			Password: cfg.ProxyPass,
 		}
 		cfg.Dial = proxy.DialTimeout
+		cfg.Lookup = func(host string) ([]net.IP, error) {
+			return nil, errors.Errorf("can not resolve %s: DNS lookups are disabled when using a proxy", host)
+		}
 	}
 
 	// Warn about missing config file only after all other configuration is
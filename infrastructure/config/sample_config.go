@@ -27,14 +27,23 @@ var sampleConfig = `[Application Options]
 
 ; Connect via a SOCKS5 proxy. NOTE: Specifying a proxy will disable listening
 ; for incoming connections unless listen addresses are provided via the 'listen'
-; option.
+; option. It also disables DNS and gRPC seeding, and peers given by host name
+; can not be connected to, since host names would be resolved outside of the
+; proxy.
 ; proxy=127.0.0.1:9050
 ; proxyuser=
 ; proxypass=
 
-; Use Universal Plug and Play (UPnP) to automatically open the listen port
-; and obtain the external IP address from supported devices. NOTE: This option
-; will have no effect if external IP addresses are specified.
+; Only connect to and advertise Tor .onion addresses, routed through the proxy
+; above, which must be a Tor SOCKS5 proxy. Addresses of other networks are
+; neither dialed nor relayed, and DNS and gRPC seeding are disabled, so peers
+; must be given via the 'addpeer' or 'connect' options.
+; onlyonion=1
+
+; Use Universal Plug and Play (UPnP), or NAT-PMP when UPnP is unavailable, to
+; automatically open the listen port and obtain the external IP address from
+; supported devices. NOTE: This option will have no effect if external IP
+; addresses are specified or if listening is disabled.
 ; upnp=1
 
 ; Specify the external IP addresses your node is listening on. One address per
@@ -89,6 +98,11 @@ var sampleConfig = `[Application Options]
 ; Maximum number of inbound and outbound peers.
 ; maxinpeers=125
 
+; Max rate, in KB/s, at which IBD data is uploaded to each syncing peer, and to
+; all syncing peers together. 0 means unlimited.
+; maxpeeribduploadrate=0
+; maxibduploadrate=0
+
 ; Disable banning of misbehaving peers.
 ; nobanning=1
 
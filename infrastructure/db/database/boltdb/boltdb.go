@@ -0,0 +1,157 @@
+package boltdb
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// fileName is the name of the database file inside the database directory
+	fileName = "kaspad.bolt"
+
+	// fileMode is the permissions the database file is created with
+	fileMode = 0600
+)
+
+// rootBucketName is the name of the bolt bucket that holds all the data.
+// kaspad's buckets are key prefixes rather than bolt buckets, so that
+// cursors behave the same as in the other backends.
+var rootBucketName = []byte("kaspad")
+
+// BoltDB defines a thin wrapper around bbolt.
+type BoltDB struct {
+	bolt *bbolt.DB
+}
+
+// NewBoltDB opens a bbolt database in the given directory,
+// creating it if it doesn't exist.
+//
+// bbolt syncs either all of its writes or none of them, so any
+// sync mode other than database.SyncModeNone syncs every write.
+func NewBoltDB(path string, syncMode database.SyncMode) (*BoltDB, error) {
+	err := os.MkdirAll(path, 0700)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	err = removeStaleSnapshots(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bolt, err := bbolt.Open(filepath.Join(path, fileName), fileMode, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	bolt.NoSync = syncMode == database.SyncModeNone
+	err = bolt.Update(func(boltTx *bbolt.Tx) error {
+		_, err := boltTx.CreateBucketIfNotExists(rootBucketName)
+		return err
+	})
+	if err != nil {
+		closeErr := bolt.Close()
+		if closeErr != nil {
+			log.Errorf("Error closing the database after a failure to open it: %s", closeErr)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &BoltDB{bolt: bolt}, nil
+}
+
+// removeStaleSnapshots removes the snapshot files that were left in the
+// database directory if kaspad stopped before they were released.
+func removeStaleSnapshots(path string) error {
+	staleSnapshotPaths, err := filepath.Glob(filepath.Join(path, snapshotFilePrefix+"*"))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, staleSnapshotPath := range staleSnapshotPaths {
+		log.Infof("Removing stale database snapshot %s", staleSnapshotPath)
+		err := os.Remove(staleSnapshotPath)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// Close closes the bbolt instance.
+func (db *BoltDB) Close() error {
+	err := db.bolt.Close()
+	return errors.WithStack(err)
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (db *BoltDB) Put(key *database.Key, value []byte) error {
+	err := db.bolt.Update(func(boltTx *bbolt.Tx) error {
+		return boltTx.Bucket(rootBucketName).Put(key.Bytes(), value)
+	})
+	return errors.WithStack(err)
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (db *BoltDB) Get(key *database.Key) ([]byte, error) {
+	var value []byte
+	var found bool
+	err := db.bolt.View(func(boltTx *bbolt.Tx) error {
+		var boltValue []byte
+		boltValue, found = get(boltTx, key.Bytes())
+		if found {
+			// Values returned by bolt are only valid for the
+			// lifetime of the bolt transaction
+			value = make([]byte, len(boltValue))
+			copy(value, boltValue)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !found {
+		return nil, errors.Wrapf(database.ErrNotFound,
+			"key %s not found", key)
+	}
+	return value, nil
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (db *BoltDB) Has(key *database.Key) (bool, error) {
+	var found bool
+	err := db.bolt.View(func(boltTx *bbolt.Tx) error {
+		_, found = get(boltTx, key.Bytes())
+		return nil
+	})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return found, nil
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (db *BoltDB) Delete(key *database.Key) error {
+	err := db.bolt.Update(func(boltTx *bbolt.Tx) error {
+		return boltTx.Bucket(rootBucketName).Delete(key.Bytes())
+	})
+	return errors.WithStack(err)
+}
+
+// get returns the value of the given key. bolt's Get doesn't
+// distinguish between a missing key and an empty value, so a
+// cursor is used instead.
+func get(boltTx *bbolt.Tx, key []byte) (value []byte, found bool) {
+	foundKey, value := boltTx.Bucket(rootBucketName).Cursor().Seek(key)
+	if foundKey == nil || !bytes.Equal(foundKey, key) {
+		return nil, false
+	}
+	return value, true
+}
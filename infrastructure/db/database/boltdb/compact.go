@@ -0,0 +1,9 @@
+package boltdb
+
+// Compact does nothing. bbolt reuses the pages of deleted entries
+// for new writes, but it never shrinks the database file while it's
+// open, so there's nothing to compact in place.
+func (db *BoltDB) Compact() error {
+	log.Infof("The bbolt database reuses the space of deleted entries, so it is not compacted")
+	return nil
+}
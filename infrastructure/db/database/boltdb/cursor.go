@@ -0,0 +1,176 @@
+package boltdb
+
+import (
+	"bytes"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// cursorChunkSize is the maximum number of entries a cursor reads in a
+// single bolt transaction. Reading in chunks avoids keeping a bolt read
+// transaction open for the lifetime of the cursor, which would block
+// the database from growing its file while the cursor is open.
+const cursorChunkSize = 1000
+
+type cursorEntry struct {
+	key   []byte
+	value []byte
+}
+
+// BoltDBCursor is a cursor over a bbolt database that reads
+// the entries under its prefix in chunks.
+//
+// Note that unlike leveldb's, the cursor is not a snapshot of the database:
+// changes made after the cursor is opened may or may not be visible to it.
+type BoltDBCursor struct {
+	db     *BoltDB
+	bucket *database.Bucket
+
+	chunk             []*cursorEntry
+	index             int
+	isLastChunkLoaded bool
+
+	isClosed bool
+}
+
+// Cursor begins a new cursor over the given prefix.
+func (db *BoltDB) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	return &BoltDBCursor{
+		db:       db,
+		bucket:   bucket,
+		index:    -1,
+		isClosed: false,
+	}, nil
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted. Panics if the cursor is closed.
+func (c *BoltDBCursor) Next() bool {
+	if c.isClosed {
+		panic("cannot call next on a closed cursor")
+	}
+	if c.chunk == nil && c.index < 0 {
+		return c.First()
+	}
+	if c.index+1 < len(c.chunk) {
+		c.index++
+		return true
+	}
+	if c.isLastChunkLoaded || len(c.chunk) == 0 {
+		c.index = len(c.chunk)
+		return false
+	}
+	lastKey := c.chunk[len(c.chunk)-1].key
+	c.load(lastKey, false)
+	return c.isValid()
+}
+
+// First moves the iterator to the first key/value pair. It returns false if
+// such a pair does not exist. Panics if the cursor is closed.
+func (c *BoltDBCursor) First() bool {
+	if c.isClosed {
+		panic("cannot call first on a closed cursor")
+	}
+	c.load(c.bucket.Path(), true)
+	return c.isValid()
+}
+
+// Seek moves the iterator to the first key/value pair whose key is greater
+// than or equal to the given key. It returns ErrNotFound if such pair does not
+// exist.
+func (c *BoltDBCursor) Seek(key *database.Key) error {
+	if c.isClosed {
+		return errors.New("cannot seek a closed cursor")
+	}
+
+	c.load(key.Bytes(), true)
+	if !c.isValid() || !bytes.Equal(c.chunk[c.index].key, key.Bytes()) {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+	return nil
+}
+
+// Key returns the key of the current key/value pair, or ErrNotFound if done.
+// Note that the key is trimmed to not include the prefix the cursor was opened
+// with.
+func (c *BoltDBCursor) Key() (*database.Key, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the key of a closed cursor")
+	}
+	if !c.isValid() {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"key of an exhausted cursor")
+	}
+	suffix := bytes.TrimPrefix(c.chunk[c.index].key, c.bucket.Path())
+	return c.bucket.Key(suffix), nil
+}
+
+// Value returns the value of the current key/value pair, or ErrNotFound if done.
+func (c *BoltDBCursor) Value() ([]byte, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the value of a closed cursor")
+	}
+	if !c.isValid() {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"value of an exhausted cursor")
+	}
+	return c.chunk[c.index].value, nil
+}
+
+// Close releases associated resources.
+func (c *BoltDBCursor) Close() error {
+	if c.isClosed {
+		return errors.New("cannot close an already closed cursor")
+	}
+	c.isClosed = true
+	c.chunk = nil
+	c.bucket = nil
+	return nil
+}
+
+func (c *BoltDBCursor) isValid() bool {
+	return c.index >= 0 && c.index < len(c.chunk)
+}
+
+// load reads the next chunk of entries under the cursor's prefix, starting
+// at the given key, and points the cursor at its first entry. An error
+// reading from the database is logged and treated as the end of the data,
+// since Next and First have no way to return it.
+func (c *BoltDBCursor) load(start []byte, isInclusive bool) {
+	prefix := c.bucket.Path()
+	chunk := make([]*cursorEntry, 0, cursorChunkSize)
+	isLastChunk := false
+	err := c.db.bolt.View(func(boltTx *bbolt.Tx) error {
+		boltCursor := boltTx.Bucket(rootBucketName).Cursor()
+		key, value := boltCursor.Seek(start)
+		if !isInclusive && key != nil && bytes.Equal(key, start) {
+			key, value = boltCursor.Next()
+		}
+		for ; key != nil && bytes.HasPrefix(key, prefix); key, value = boltCursor.Next() {
+			if len(chunk) == cursorChunkSize {
+				return nil
+			}
+			// Keys and values returned by bolt are only valid for
+			// the lifetime of the bolt transaction
+			entry := &cursorEntry{
+				key:   make([]byte, len(key)),
+				value: make([]byte, len(value)),
+			}
+			copy(entry.key, key)
+			copy(entry.value, value)
+			chunk = append(chunk, entry)
+		}
+		isLastChunk = true
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Error reading from the database: %s", err)
+		chunk = nil
+		isLastChunk = true
+	}
+	c.isLastChunkLoaded = isLastChunk
+	c.chunk = chunk
+	c.index = 0
+}
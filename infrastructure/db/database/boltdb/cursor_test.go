@@ -0,0 +1,109 @@
+package boltdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// TestCursorChunks validates that a cursor iterates over all the entries
+// under its prefix, and only over them, when they span several chunks.
+func TestCursorChunks(t *testing.T) {
+	path, err := ioutil.TempDir("", "TestCursorChunks")
+	if err != nil {
+		t.Fatalf("TestCursorChunks: TempDir unexpectedly failed: %s", err)
+	}
+	defer os.RemoveAll(path)
+	db, err := NewBoltDB(path, database.SyncModeNone)
+	if err != nil {
+		t.Fatalf("TestCursorChunks: NewBoltDB unexpectedly failed: %s", err)
+	}
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("TestCursorChunks: Close unexpectedly failed: %s", err)
+		}
+	}()
+
+	// Surround the bucket with entries from neighbouring buckets,
+	// to make sure that the cursor doesn't leak into them
+	bucket := database.MakeBucket([]byte("b"))
+	neighbours := []*database.Key{
+		database.MakeBucket([]byte("a")).Key([]byte("key")),
+		database.MakeBucket([]byte("c")).Key([]byte("key")),
+	}
+	for _, key := range neighbours {
+		err := db.Put(key, []byte("neighbour"))
+		if err != nil {
+			t.Fatalf("TestCursorChunks: Put unexpectedly failed: %s", err)
+		}
+	}
+
+	const entryCount = cursorChunkSize*2 + cursorChunkSize/2
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("TestCursorChunks: Begin unexpectedly failed: %s", err)
+	}
+	for i := 0; i < entryCount; i++ {
+		err := tx.Put(bucket.Key([]byte(fmt.Sprintf("key%05d", i))), []byte(fmt.Sprintf("value%d", i)))
+		if err != nil {
+			t.Fatalf("TestCursorChunks: Put unexpectedly failed: %s", err)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		t.Fatalf("TestCursorChunks: Commit unexpectedly failed: %s", err)
+	}
+
+	cursor, err := db.Cursor(bucket)
+	if err != nil {
+		t.Fatalf("TestCursorChunks: Cursor unexpectedly failed: %s", err)
+	}
+	defer cursor.Close()
+
+	i := 0
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			t.Fatalf("TestCursorChunks: Key unexpectedly failed: %s", err)
+		}
+		expectedKey := fmt.Sprintf("key%05d", i)
+		if string(key.Suffix()) != expectedKey {
+			t.Fatalf("TestCursorChunks: unexpected key. Want: %s, got: %s", expectedKey, key.Suffix())
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			t.Fatalf("TestCursorChunks: Value unexpectedly failed: %s", err)
+		}
+		expectedValue := fmt.Sprintf("value%d", i)
+		if string(value) != expectedValue {
+			t.Fatalf("TestCursorChunks: unexpected value. Want: %s, got: %s", expectedValue, value)
+		}
+		i++
+	}
+	if i != entryCount {
+		t.Fatalf("TestCursorChunks: unexpected amount of entries. Want: %d, got: %d", entryCount, i)
+	}
+	if cursor.Next() {
+		t.Fatalf("TestCursorChunks: Next unexpectedly returned true for an exhausted cursor")
+	}
+
+	// Seek into the middle of the second chunk and make sure that
+	// iteration continues from there
+	seekIndex := cursorChunkSize + cursorChunkSize/2
+	err = cursor.Seek(bucket.Key([]byte(fmt.Sprintf("key%05d", seekIndex))))
+	if err != nil {
+		t.Fatalf("TestCursorChunks: Seek unexpectedly failed: %s", err)
+	}
+	remaining := 1
+	for cursor.Next() {
+		remaining++
+	}
+	if remaining != entryCount-seekIndex {
+		t.Fatalf("TestCursorChunks: unexpected amount of entries after Seek. Want: %d, got: %d",
+			entryCount-seekIndex, remaining)
+	}
+}
@@ -0,0 +1,17 @@
+package boltdb
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// DbType is the --dbtype of the bbolt backend
+const DbType = "bbolt"
+
+func init() {
+	database.RegisterDriver(&database.Driver{
+		DbType: DbType,
+		Open: func(path string, options *database.Options) (database.Database, error) {
+			return NewBoltDB(path, options.SyncMode)
+		},
+	})
+}
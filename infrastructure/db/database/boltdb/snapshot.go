@@ -0,0 +1,109 @@
+package boltdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// snapshotFilePrefix is the prefix of the names of the temporary
+// files snapshots are copied into
+const snapshotFilePrefix = fileName + ".snapshot-"
+
+// BoltDBSnapshot is a snapshot of a bbolt database.
+//
+// bbolt can't grow its file while a read transaction is open, so instead
+// of holding one for the lifetime of the snapshot, the database file is
+// copied within a single read transaction into a temporary file next to
+// it, which the snapshot reads from. The copy is deleted once the snapshot
+// is released.
+type BoltDBSnapshot struct {
+	snapshotDB *BoltDB
+	path       string
+	isReleased bool
+}
+
+// Snapshot takes a read-only snapshot of the database
+// as it is at the time of the call.
+func (db *BoltDB) Snapshot() (database.Snapshot, error) {
+	snapshotFile, err := ioutil.TempFile(filepath.Dir(db.bolt.Path()), snapshotFilePrefix)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	snapshotPath := snapshotFile.Name()
+	err = snapshotFile.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	snapshot, err := openSnapshot(db, snapshotPath)
+	if err != nil {
+		removeErr := os.Remove(snapshotPath)
+		if removeErr != nil {
+			log.Errorf("Error removing snapshot file %s: %s", snapshotPath, removeErr)
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func openSnapshot(db *BoltDB, snapshotPath string) (*BoltDBSnapshot, error) {
+	err := db.bolt.View(func(boltTx *bbolt.Tx) error {
+		return boltTx.CopyFile(snapshotPath, fileMode)
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	snapshotBolt, err := bbolt.Open(snapshotPath, fileMode, &bbolt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &BoltDBSnapshot{
+		snapshotDB: &BoltDB{bolt: snapshotBolt},
+		path:       snapshotPath,
+		isReleased: false,
+	}, nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (s *BoltDBSnapshot) Get(key *database.Key) ([]byte, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot get from a released snapshot")
+	}
+	return s.snapshotDB.Get(key)
+}
+
+// Has returns true if the snapshot does contains the
+// given key.
+func (s *BoltDBSnapshot) Has(key *database.Key) (bool, error) {
+	if s.isReleased {
+		return false, errors.New("cannot has from a released snapshot")
+	}
+	return s.snapshotDB.Has(key)
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (s *BoltDBSnapshot) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot open a cursor from a released snapshot")
+	}
+	return s.snapshotDB.Cursor(bucket)
+}
+
+// Release releases the snapshot and deletes its copy of the database.
+func (s *BoltDBSnapshot) Release() error {
+	if s.isReleased {
+		return errors.New("cannot release an already released snapshot")
+	}
+	s.isReleased = true
+	err := s.snapshotDB.Close()
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(os.Remove(s.path))
+}
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/boltdb"
 	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
 )
 
 type databasePrepareFunc func(t *testing.T, testName string) (db database.Database, name string, teardownFunc func())
@@ -16,6 +18,8 @@ type databasePrepareFunc func(t *testing.T, testName string) (db database.Databa
 // See testForAllDatabaseTypes for further details.
 var databasePrepareFuncs = []databasePrepareFunc{
 	prepareLDBForTest,
+	prepareMemoryDBForTest,
+	prepareBoltDBForTest,
 }
 
 func prepareLDBForTest(t *testing.T, testName string) (db database.Database, name string, teardownFunc func()) {
@@ -40,6 +44,40 @@ func prepareLDBForTest(t *testing.T, testName string) (db database.Database, nam
 	return db, "ldb", teardownFunc
 }
 
+func prepareMemoryDBForTest(t *testing.T, testName string) (db database.Database, name string, teardownFunc func()) {
+	db = memorydb.NewMemoryDB()
+	teardownFunc = func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("%s: Close unexpectedly "+
+				"failed: %s", testName, err)
+		}
+	}
+	return db, "memorydb", teardownFunc
+}
+
+func prepareBoltDBForTest(t *testing.T, testName string) (db database.Database, name string, teardownFunc func()) {
+	// Create a temp db to run tests against
+	path, err := ioutil.TempDir("", testName)
+	if err != nil {
+		t.Fatalf("%s: TempDir unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	db, err = boltdb.NewBoltDB(path, database.SyncModeNone)
+	if err != nil {
+		t.Fatalf("%s: Open unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	teardownFunc = func() {
+		err = db.Close()
+		if err != nil {
+			t.Fatalf("%s: Close unexpectedly "+
+				"failed: %s", testName, err)
+		}
+	}
+	return db, "boltdb", teardownFunc
+}
+
 // testForAllDatabaseTypes runs the given testFunc for every database
 // type defined in databasePrepareFuncs. This is to make sure that
 // all supported database types adhere to the assumptions defined in
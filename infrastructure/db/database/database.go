@@ -14,6 +14,16 @@ type Database interface {
 	// Begin begins a new database transaction.
 	Begin() (Transaction, error)
 
+	// Snapshot takes a read-only snapshot of the database
+	// as it is at the time of the call.
+	Snapshot() (Snapshot, error)
+
+	// Compact compacts the whole database, reclaiming the disk
+	// space of deleted and overwritten entries. It may take a long
+	// time on large databases, during which the database remains
+	// usable.
+	Compact() error
+
 	// Close closes the database.
 	Close() error
 }
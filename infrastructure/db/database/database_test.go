@@ -167,3 +167,40 @@ func testDatabaseDelete(t *testing.T, db database.Database, testName string) {
 			"unexpectedly returned that the value exists", testName)
 	}
 }
+
+func TestDatabaseCompact(t *testing.T) {
+	testForAllDatabaseTypes(t, "TestDatabaseCompact", testDatabaseCompact)
+}
+
+func testDatabaseCompact(t *testing.T, db database.Database, testName string) {
+	entries := populateDatabaseForTest(t, db, testName)
+
+	// Delete half of the entries, so there's something to compact
+	for _, entry := range entries[:len(entries)/2] {
+		err := db.Delete(entry.key)
+		if err != nil {
+			t.Fatalf("%s: Delete "+
+				"unexpectedly failed: %s", testName, err)
+		}
+	}
+
+	err := db.Compact()
+	if err != nil {
+		t.Fatalf("%s: Compact "+
+			"unexpectedly failed: %s", testName, err)
+	}
+
+	// Make sure that the compaction didn't change the contents of the database
+	for i, entry := range entries {
+		exists, err := db.Has(entry.key)
+		if err != nil {
+			t.Fatalf("%s: Has "+
+				"unexpectedly failed: %s", testName, err)
+		}
+		shouldExist := i >= len(entries)/2
+		if exists != shouldExist {
+			t.Fatalf("%s: Has returned %t for entry %d. Want: %t",
+				testName, exists, i, shouldExist)
+		}
+	}
+}
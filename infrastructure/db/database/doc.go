@@ -6,10 +6,14 @@ Overview
 This package provides a database layer to store and retrieve data in a simple
 and efficient manner.
 
-The current backend is ffldb, which makes use of leveldb, flat files, and strict
-checksums in key areas to ensure data integrity.
+Backends are selected with kaspad's --dbtype option. The available backends are:
+leveldb (ldb, the default), bbolt (boltdb) and memory (memorydb), which keeps
+everything in memory and is meant for tests and short-lived simnet nodes.
+Backends are opened with Options, which define their cache size, when their
+writes are synced to disk and whether their data is compressed.
 
-Implementors of additional backends are required to implement the following interfaces:
+Implementors of additional backends are required to implement the following interfaces,
+and to register themselves with RegisterDriver, usually from an init function:
 
 DataAccessor
 
@@ -18,7 +22,8 @@ database. Both the Database and the Transaction interfaces (see below) implement
 
 Database
 
-This defines the interface of a database that can begin transactions and close itself.
+This defines the interface of a database that can begin transactions, take snapshots,
+compact and close itself.
 
 Transaction
 
@@ -27,6 +32,11 @@ Note: transactions provide data consistency over the state of the database as it
 when the transaction started. There is NO guarantee that if one puts data into the
 transaction then it will be available to get within the same transaction.
 
+Snapshot
+
+This defines the interface of a read-only view of a database as it was when the
+snapshot was taken. Snapshots are used to back up a database while it's in use.
+
 Cursor
 
 This iterates over database entries given some bucket.
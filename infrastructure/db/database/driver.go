@@ -0,0 +1,66 @@
+package database
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Driver defines a database backend that can be selected with
+// the --dbtype option
+type Driver struct {
+	// DbType is the identifier of the backend
+	DbType string
+
+	// Open opens the database at the given path with the given
+	// options, creating it if it doesn't exist yet
+	Open func(path string, options *Options) (Database, error)
+}
+
+var (
+	driversLock sync.RWMutex
+	drivers     = make(map[string]*Driver)
+)
+
+// RegisterDriver adds a backend to the available backends. It panics
+// if a backend with the same DbType was already registered, so that
+// two backends can't silently shadow each other.
+func RegisterDriver(driver *Driver) {
+	driversLock.Lock()
+	defer driversLock.Unlock()
+
+	if _, exists := drivers[driver.DbType]; exists {
+		panic(errors.Errorf("database driver %s is already registered", driver.DbType))
+	}
+	drivers[driver.DbType] = driver
+}
+
+// SupportedDrivers returns the DbTypes of all the registered backends,
+// sorted alphabetically
+func SupportedDrivers() []string {
+	driversLock.RLock()
+	defer driversLock.RUnlock()
+
+	dbTypes := make([]string, 0, len(drivers))
+	for dbType := range drivers {
+		dbTypes = append(dbTypes, dbType)
+	}
+	sort.Strings(dbTypes)
+	return dbTypes
+}
+
+// Open opens the database at the given path using the backend
+// registered with the given DbType
+func Open(dbType string, path string, options *Options) (Database, error) {
+	driversLock.RLock()
+	driver, ok := drivers[dbType]
+	driversLock.RUnlock()
+
+	if !ok {
+		return nil, errors.Errorf("unknown database type %s. Supported types are: %s",
+			dbType, strings.Join(SupportedDrivers(), ", "))
+	}
+	return driver.Open(path, options)
+}
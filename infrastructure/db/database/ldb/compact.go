@@ -0,0 +1,67 @@
+package ldb
+
+import (
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// compactionRangeCount is the number of key ranges the database
+// is compacted in. Compacting range by range lets the compaction
+// progress be logged.
+const compactionRangeCount = 256
+
+// Compact compacts the whole database, reclaiming the disk space of
+// deleted and overwritten entries. Seek compaction is disabled, so
+// without it this space is only reclaimed when new writes happen to
+// be compacted with the deleted entries.
+//
+// Only one compaction runs at a time. Concurrent calls wait
+// for the running compaction to finish and then compact again.
+func (db *LevelDB) Compact() error {
+	db.compactionLock.Lock()
+	defer db.compactionLock.Unlock()
+
+	// The ranges are split by the first byte of their keys, and
+	// the progress is weighed by their approximate sizes
+	ranges := make([]util.Range, compactionRangeCount)
+	for i := range ranges {
+		ranges[i].Start = []byte{byte(i)}
+		if i+1 < compactionRangeCount {
+			ranges[i].Limit = []byte{byte(i + 1)}
+		}
+	}
+	// The empty key sorts before all the ranges
+	ranges[0].Start = nil
+	sizes, err := db.ldb.SizeOf(ranges)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	sizeBefore := sizes.Sum()
+	log.Infof("Compacting the database (%d MiB)", sizeBefore/(1<<20))
+
+	compactedSize := int64(0)
+	lastLoggedPercent := int64(0)
+	for i, keyRange := range ranges {
+		err := db.ldb.CompactRange(keyRange)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		compactedSize += sizes[i]
+		if sizeBefore == 0 {
+			continue
+		}
+		percent := compactedSize * 100 / sizeBefore
+		if percent >= lastLoggedPercent+10 && percent < 100 {
+			log.Infof("Database compaction %d%% done", percent)
+			lastLoggedPercent = percent
+		}
+	}
+
+	sizeAfter, err := db.ldb.SizeOf([]util.Range{{}})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	log.Infof("Compacted the database from %d MiB to %d MiB", sizeBefore/(1<<20), sizeAfter.Sum()/(1<<20))
+	return nil
+}
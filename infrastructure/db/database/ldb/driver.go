@@ -0,0 +1,17 @@
+package ldb
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// DbType is the --dbtype of the leveldb backend
+const DbType = "leveldb"
+
+func init() {
+	database.RegisterDriver(&database.Driver{
+		DbType: DbType,
+		Open: func(path string, options *database.Options) (database.Database, error) {
+			return NewLevelDBWithOptions(path, options)
+		},
+	})
+}
@@ -1,6 +1,8 @@
 package ldb
 
 import (
+	"sync"
+
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -11,14 +13,36 @@ import (
 // LevelDB defines a thin wrapper around leveldb.
 type LevelDB struct {
 	ldb *leveldb.DB
+
+	// writeOptions are used for writes made directly to the database,
+	// and transactionWriteOptions for committing transactions
+	writeOptions            *opt.WriteOptions
+	transactionWriteOptions *opt.WriteOptions
+
+	compactionLock sync.Mutex
 }
 
 // NewLevelDB opens a leveldb instance defined by the given path.
+// Writes are never explicitly synced, and the data isn't compressed.
 func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
+	return NewLevelDBWithOptions(path, &database.Options{
+		CacheSizeMiB: cacheSizeMiB,
+		SyncMode:     database.SyncModeNone,
+		Compression:  false,
+	})
+}
+
+// NewLevelDBWithOptions opens a leveldb instance defined by the given path
+// with the given options.
+func NewLevelDBWithOptions(path string, databaseOptions *database.Options) (*LevelDB, error) {
 	// Open leveldb. If it doesn't exist, create it.
 	options := Options()
-	options.BlockCacheCapacity = cacheSizeMiB * opt.MiB
-	options.WriteBuffer = (cacheSizeMiB * opt.MiB) / 2
+	options.BlockCacheCapacity = databaseOptions.CacheSizeMiB * opt.MiB
+	options.WriteBuffer = (databaseOptions.CacheSizeMiB * opt.MiB) / 2
+	options.NoSync = databaseOptions.SyncMode == database.SyncModeNone
+	if databaseOptions.Compression {
+		options.Compression = opt.SnappyCompression
+	}
 	ldb, err := leveldb.OpenFile(path, &options)
 
 	// If the database is corrupted, attempt to recover.
@@ -26,7 +50,7 @@ func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
 		log.Warnf("LevelDB corruption detected for path %s: %s",
 			path, err)
 		var recoverErr error
-		ldb, recoverErr = leveldb.RecoverFile(path, nil)
+		ldb, recoverErr = leveldb.RecoverFile(path, &options)
 		if recoverErr != nil {
 			return nil, errors.Wrapf(err, "failed recovering from "+
 				"database corruption: %s", recoverErr)
@@ -43,6 +67,12 @@ func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
 
 	db := &LevelDB{
 		ldb: ldb,
+		writeOptions: &opt.WriteOptions{
+			Sync: databaseOptions.SyncMode == database.SyncModeFull,
+		},
+		transactionWriteOptions: &opt.WriteOptions{
+			Sync: databaseOptions.SyncMode != database.SyncModeNone,
+		},
 	}
 	return db, nil
 }
@@ -56,7 +86,7 @@ func (db *LevelDB) Close() error {
 // Put sets the value for the given key. It overwrites
 // any previous value for that key.
 func (db *LevelDB) Put(key *database.Key, value []byte) error {
-	err := db.ldb.Put(key.Bytes(), value, nil)
+	err := db.ldb.Put(key.Bytes(), value, db.writeOptions)
 	return errors.WithStack(err)
 }
 
@@ -87,6 +117,6 @@ func (db *LevelDB) Has(key *database.Key) (bool, error) {
 // Delete deletes the value for the given key. Will not
 // return an error if the key doesn't exist.
 func (db *LevelDB) Delete(key *database.Key) error {
-	err := db.ldb.Delete(key.Bytes(), nil)
+	err := db.ldb.Delete(key.Bytes(), db.writeOptions)
 	return errors.WithStack(err)
 }
@@ -4,6 +4,8 @@ import "github.com/syndtr/goleveldb/leveldb/opt"
 
 // Options is a function that returns a leveldb
 // opt.Options struct for opening a database.
+// NewLevelDBWithOptions overrides the compression and
+// sync settings according to the database.Options it's given.
 func Options() opt.Options {
 	return opt.Options{
 		Compression:            opt.NoCompression,
@@ -40,7 +40,7 @@ func (tx *LevelDBTransaction) Commit() error {
 	}
 
 	tx.isClosed = true
-	return errors.WithStack(tx.db.ldb.Write(tx.batch, nil))
+	return errors.WithStack(tx.db.ldb.Write(tx.batch, tx.db.transactionWriteOptions))
 }
 
 // Rollback rolls back whatever changes were made to the
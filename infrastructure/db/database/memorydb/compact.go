@@ -0,0 +1,7 @@
+package memorydb
+
+// Compact does nothing, since deleted entries
+// are freed from memory as soon as they're deleted
+func (db *MemoryDB) Compact() error {
+	return nil
+}
@@ -0,0 +1,121 @@
+package memorydb
+
+import (
+	"bytes"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// MemoryDBCursor is a thin wrapper around native memdb iterators.
+type MemoryDBCursor struct {
+	memdbIterator iterator.Iterator
+	bucket        *database.Bucket
+
+	isClosed bool
+}
+
+// Cursor begins a new cursor over the given prefix.
+//
+// Note that unlike leveldb's, the cursor is not a snapshot of the database:
+// changes made after the cursor is opened may or may not be visible to it.
+func (db *MemoryDB) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errors.New("cannot open a cursor over a closed database")
+	}
+	memdbIterator := db.memdb.NewIterator(util.BytesPrefix(bucket.Path()))
+	return &MemoryDBCursor{
+		memdbIterator: memdbIterator,
+		bucket:        bucket,
+		isClosed:      false,
+	}, nil
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted. Panics if the cursor is closed.
+func (c *MemoryDBCursor) Next() bool {
+	if c.isClosed {
+		panic("cannot call next on a closed cursor")
+	}
+	return c.memdbIterator.Next()
+}
+
+// First moves the iterator to the first key/value pair. It returns false if
+// such a pair does not exist. Panics if the cursor is closed.
+func (c *MemoryDBCursor) First() bool {
+	if c.isClosed {
+		panic("cannot call first on a closed cursor")
+	}
+	return c.memdbIterator.First()
+}
+
+// Seek moves the iterator to the first key/value pair whose key is greater
+// than or equal to the given key. It returns ErrNotFound if such pair does not
+// exist.
+func (c *MemoryDBCursor) Seek(key *database.Key) error {
+	if c.isClosed {
+		return errors.New("cannot seek a closed cursor")
+	}
+
+	found := c.memdbIterator.Seek(key.Bytes())
+	if !found {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+
+	// Use c.memdbIterator.Key because c.Key removes the prefix from the key
+	currentKey := c.memdbIterator.Key()
+	if currentKey == nil || !bytes.Equal(currentKey, key.Bytes()) {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+
+	return nil
+}
+
+// Key returns the key of the current key/value pair, or ErrNotFound if done.
+// Note that the key is trimmed to not include the prefix the cursor was opened
+// with. The caller should not modify the contents of the returned slice, and
+// its contents may change on the next call to Next.
+func (c *MemoryDBCursor) Key() (*database.Key, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the key of a closed cursor")
+	}
+	fullKeyPath := c.memdbIterator.Key()
+	if fullKeyPath == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"key of an exhausted cursor")
+	}
+	suffix := bytes.TrimPrefix(fullKeyPath, c.bucket.Path())
+	return c.bucket.Key(suffix), nil
+}
+
+// Value returns the value of the current key/value pair, or ErrNotFound if done.
+// The caller should not modify the contents of the returned slice, and its
+// contents may change on the next call to Next.
+func (c *MemoryDBCursor) Value() ([]byte, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the value of a closed cursor")
+	}
+	value := c.memdbIterator.Value()
+	if value == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"value of an exhausted cursor")
+	}
+	return value, nil
+}
+
+// Close releases associated resources.
+func (c *MemoryDBCursor) Close() error {
+	if c.isClosed {
+		return errors.New("cannot close an already closed cursor")
+	}
+	c.isClosed = true
+	c.memdbIterator.Release()
+	c.memdbIterator = nil
+	c.bucket = nil
+	return nil
+}
@@ -0,0 +1,18 @@
+package memorydb
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// DbType is the --dbtype of the in-memory backend
+const DbType = "memory"
+
+func init() {
+	database.RegisterDriver(&database.Driver{
+		DbType: DbType,
+		Open: func(_ string, _ *database.Options) (database.Database, error) {
+			log.Warnf("Using an in-memory database. Its contents will be lost on shutdown")
+			return NewMemoryDB(), nil
+		},
+	})
+}
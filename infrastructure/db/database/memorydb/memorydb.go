@@ -0,0 +1,154 @@
+package memorydb
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+)
+
+const (
+	// initialCapacity is the initial size, in bytes, of the buffer that
+	// backs the database
+	initialCapacity = 4 * 1024 * 1024
+
+	// minRebuildSize is the size, in bytes, of the backing buffer below
+	// which it's never rebuilt
+	minRebuildSize = 64 * 1024 * 1024
+)
+
+// MemoryDB is a database that is kept entirely in memory. It's meant for
+// tests and for short-lived nodes, since its contents are lost once it's
+// closed.
+type MemoryDB struct {
+	// lock guards the replacement of memdb when it's rebuilt. memdb
+	// itself is safe for concurrent use.
+	lock     sync.RWMutex
+	memdb    *memdb.DB
+	isClosed bool
+}
+
+// NewMemoryDB returns a new empty MemoryDB
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		memdb: memdb.New(comparer.DefaultComparer, initialCapacity),
+	}
+}
+
+// Close closes the database and discards its contents.
+func (db *MemoryDB) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.isClosed {
+		return errors.New("cannot close an already closed database")
+	}
+	db.isClosed = true
+	db.memdb = nil
+	return nil
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (db *MemoryDB) Put(key *database.Key, value []byte) error {
+	return db.write(func() error {
+		return db.memdb.Put(key.Bytes(), value)
+	})
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (db *MemoryDB) Get(key *database.Key) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errors.New("cannot get from a closed database")
+	}
+	value, err := db.memdb.Get(key.Bytes())
+	if err != nil {
+		if errors.Is(err, memdb.ErrNotFound) {
+			return nil, errors.Wrapf(database.ErrNotFound,
+				"key %s not found", key)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	// The returned slice must remain valid after the database is rebuilt
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+	return valueCopy, nil
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (db *MemoryDB) Has(key *database.Key) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return false, errors.New("cannot has from a closed database")
+	}
+	return db.memdb.Contains(key.Bytes()), nil
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (db *MemoryDB) Delete(key *database.Key) error {
+	return db.write(func() error {
+		return deleteIfExists(db.memdb, key.Bytes())
+	})
+}
+
+// write runs the given function, which modifies the database, while
+// holding the database lock, and rebuilds the database afterwards if
+// needed
+func (db *MemoryDB) write(writeFunc func() error) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.isClosed {
+		return errors.New("cannot write to a closed database")
+	}
+	err := writeFunc()
+	if err != nil {
+		return err
+	}
+
+	// memdb never reuses the space of overwritten or deleted entries,
+	// so the backing buffer is rebuilt once most of it is garbage
+	usedSize := db.memdb.Capacity() - db.memdb.Free()
+	if usedSize > minRebuildSize && usedSize > 2*db.memdb.Size() {
+		return db.rebuild()
+	}
+	return nil
+}
+
+func deleteIfExists(memdbInstance *memdb.DB, key []byte) error {
+	err := memdbInstance.Delete(key)
+	if err != nil && !errors.Is(err, memdb.ErrNotFound) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// rebuild copies the live entries of the database into a new backing
+// buffer, dropping the space taken by overwritten and deleted entries.
+// Open cursors keep iterating over the old buffer.
+func (db *MemoryDB) rebuild() error {
+	rebuilt := memdb.New(comparer.DefaultComparer, db.memdb.Size())
+	iterator := db.memdb.NewIterator(nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		err := rebuilt.Put(iterator.Key(), iterator.Value())
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	log.Debugf("Rebuilt the in-memory database from %d to %d bytes",
+		db.memdb.Capacity()-db.memdb.Free(), rebuilt.Size())
+	db.memdb = rebuilt
+	return nil
+}
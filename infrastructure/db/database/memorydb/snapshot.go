@@ -0,0 +1,62 @@
+package memorydb
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+)
+
+// MemoryDBSnapshot is a snapshot of a MemoryDB. memdb doesn't
+// support snapshots, so it holds a copy of the database's contents.
+type MemoryDBSnapshot struct {
+	snapshotDB *MemoryDB
+}
+
+// Snapshot takes a read-only snapshot of the database
+// as it is at the time of the call.
+func (db *MemoryDB) Snapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errors.New("cannot take a snapshot of a closed database")
+	}
+
+	// All writes hold the lock exclusively, so nothing
+	// changes while the contents are copied
+	snapshotMemdb := memdb.New(comparer.DefaultComparer, db.memdb.Size())
+	iterator := db.memdb.NewIterator(nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		err := snapshotMemdb.Put(iterator.Key(), iterator.Value())
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return &MemoryDBSnapshot{
+		snapshotDB: &MemoryDB{memdb: snapshotMemdb},
+	}, nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (s *MemoryDBSnapshot) Get(key *database.Key) ([]byte, error) {
+	return s.snapshotDB.Get(key)
+}
+
+// Has returns true if the snapshot does contains the
+// given key.
+func (s *MemoryDBSnapshot) Has(key *database.Key) (bool, error) {
+	return s.snapshotDB.Has(key)
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (s *MemoryDBSnapshot) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	return s.snapshotDB.Cursor(bucket)
+}
+
+// Release releases the snapshot and discards its copy of the data.
+func (s *MemoryDBSnapshot) Release() error {
+	return s.snapshotDB.Close()
+}
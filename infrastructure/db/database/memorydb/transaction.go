@@ -0,0 +1,128 @@
+package memorydb
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// MemoryDBTransaction is a batch of changes that is applied atomically to
+// a MemoryDB when committed.
+//
+// Note that reads are done from the Database directly, so if another transaction changed the data,
+// you will read the new data, and not the one from the time the transaction was opened.
+//
+// Note: if one puts data into the transaction then it will not be available
+// to get within the same transaction.
+type MemoryDBTransaction struct {
+	db         *MemoryDB
+	operations []*operation
+	isClosed   bool
+}
+
+type operation struct {
+	key      []byte
+	value    []byte
+	isDelete bool
+}
+
+// Begin begins a new transaction.
+func (db *MemoryDB) Begin() (database.Transaction, error) {
+	return &MemoryDBTransaction{
+		db:       db,
+		isClosed: false,
+	}, nil
+}
+
+// Commit commits whatever changes were made to the database
+// within this transaction.
+func (tx *MemoryDBTransaction) Commit() error {
+	if tx.isClosed {
+		return errors.New("cannot commit a closed transaction")
+	}
+	tx.isClosed = true
+	return tx.db.write(func() error {
+		for _, operation := range tx.operations {
+			if operation.isDelete {
+				err := deleteIfExists(tx.db.memdb, operation.key)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			err := tx.db.memdb.Put(operation.key, operation.value)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		return nil
+	})
+}
+
+// Rollback rolls back whatever changes were made to the
+// database within this transaction.
+func (tx *MemoryDBTransaction) Rollback() error {
+	if tx.isClosed {
+		return errors.New("cannot rollback a closed transaction")
+	}
+	tx.isClosed = true
+	tx.operations = nil
+	return nil
+}
+
+// RollbackUnlessClosed rolls back changes that were made to
+// the database within the transaction, unless the transaction
+// had already been closed using either Rollback or Commit.
+func (tx *MemoryDBTransaction) RollbackUnlessClosed() error {
+	if tx.isClosed {
+		return nil
+	}
+	return tx.Rollback()
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (tx *MemoryDBTransaction) Put(key *database.Key, value []byte) error {
+	if tx.isClosed {
+		return errors.New("cannot put into a closed transaction")
+	}
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+	tx.operations = append(tx.operations, &operation{key: key.Bytes(), value: valueCopy})
+	return nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (tx *MemoryDBTransaction) Get(key *database.Key) ([]byte, error) {
+	if tx.isClosed {
+		return nil, errors.New("cannot get from a closed transaction")
+	}
+	return tx.db.Get(key)
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (tx *MemoryDBTransaction) Has(key *database.Key) (bool, error) {
+	if tx.isClosed {
+		return false, errors.New("cannot has from a closed transaction")
+	}
+	return tx.db.Has(key)
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (tx *MemoryDBTransaction) Delete(key *database.Key) error {
+	if tx.isClosed {
+		return errors.New("cannot delete from a closed transaction")
+	}
+	tx.operations = append(tx.operations, &operation{key: key.Bytes(), isDelete: true})
+	return nil
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (tx *MemoryDBTransaction) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	if tx.isClosed {
+		return nil, errors.New("cannot open a cursor from a closed transaction")
+	}
+	return tx.db.Cursor(bucket)
+}
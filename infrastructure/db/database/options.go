@@ -0,0 +1,60 @@
+package database
+
+import (
+	"github.com/pkg/errors"
+)
+
+// SyncMode defines when a database flushes its writes to stable storage
+type SyncMode int
+
+const (
+	// SyncModeNone never explicitly flushes writes, and leaves that to the
+	// operating system. It's the fastest mode, but a power loss may lose
+	// any amount of recent writes.
+	SyncModeNone SyncMode = iota
+
+	// SyncModeTransactions flushes committed transactions, but not single
+	// writes made directly to the database. Consensus data is always written
+	// in transactions, so this mode is enough to keep it consistent.
+	SyncModeTransactions
+
+	// SyncModeFull flushes every write.
+	SyncModeFull
+)
+
+var syncModeStrings = map[SyncMode]string{
+	SyncModeNone:         "none",
+	SyncModeTransactions: "transactions",
+	SyncModeFull:         "full",
+}
+
+func (mode SyncMode) String() string {
+	if modeString, ok := syncModeStrings[mode]; ok {
+		return modeString
+	}
+	return "unknown"
+}
+
+// ParseSyncMode returns the SyncMode whose name is the given string
+func ParseSyncMode(modeString string) (SyncMode, error) {
+	for mode, candidate := range syncModeStrings {
+		if candidate == modeString {
+			return mode, nil
+		}
+	}
+	return 0, errors.Errorf("unknown sync mode %s. Supported modes are: "+
+		"none, transactions, full", modeString)
+}
+
+// Options defines the settings a database is opened with. Backends
+// ignore the settings that don't apply to them.
+type Options struct {
+	// CacheSizeMiB is the amount of memory the backend may use for caching
+	CacheSizeMiB int
+
+	// SyncMode defines when writes are flushed to stable storage
+	SyncMode SyncMode
+
+	// Compression enables compression of the stored data
+	Compression bool
+}
@@ -0,0 +1,23 @@
+package database
+
+// Snapshot is a read-only view of a database as it was at the time
+// the snapshot was taken. Writes made to the database afterwards are
+// not visible through it.
+//
+// Snapshot is not safe for concurrent use.
+type Snapshot interface {
+	// Get gets the value for the given key. It returns
+	// ErrNotFound if the given key does not exist.
+	Get(key *Key) ([]byte, error)
+
+	// Has returns true if the snapshot does contains the
+	// given key.
+	Has(key *Key) (bool, error)
+
+	// Cursor begins a new cursor over the given bucket.
+	Cursor(bucket *Bucket) (Cursor, error)
+
+	// Release releases the snapshot. The snapshot, and any
+	// cursor opened from it, must not be used afterwards.
+	Release() error
+}
@@ -0,0 +1,113 @@
+// All tests within this file should call testForAllDatabaseTypes
+// over the actual test. This is to make sure that all supported
+// database types adhere to the assumptions defined in the
+// interfaces in this package.
+
+package database_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+func TestSnapshotIsolation(t *testing.T) {
+	testForAllDatabaseTypes(t, "TestSnapshotIsolation", testSnapshotIsolation)
+}
+
+func testSnapshotIsolation(t *testing.T, db database.Database, testName string) {
+	entries := populateDatabaseForTest(t, db, testName)
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("%s: Snapshot unexpectedly "+
+			"failed: %s", testName, err)
+	}
+
+	// Change the database after the snapshot was taken
+	err = db.Put(entries[0].key, []byte("changed"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	err = db.Delete(entries[1].key)
+	if err != nil {
+		t.Fatalf("%s: Delete unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	addedKey := database.MakeBucket(nil).Key([]byte("added"))
+	err = db.Put(addedKey, []byte("added"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly "+
+			"failed: %s", testName, err)
+	}
+
+	// Make sure that none of the changes are visible through the snapshot
+	value, err := snapshot.Get(entries[0].key)
+	if err != nil {
+		t.Fatalf("%s: Get unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	if !bytes.Equal(value, entries[0].value) {
+		t.Fatalf("%s: Get returned wrong value. Want: %s, got: %s",
+			testName, entries[0].value, value)
+	}
+	exists, err := snapshot.Has(entries[1].key)
+	if err != nil {
+		t.Fatalf("%s: Has unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	if !exists {
+		t.Fatalf("%s: Has unexpectedly returned that the "+
+			"deleted key does not exist", testName)
+	}
+	_, err = snapshot.Get(addedKey)
+	if !database.IsNotFoundError(err) {
+		t.Fatalf("%s: Get of the added key returned "+
+			"wrong error: %s", testName, err)
+	}
+
+	cursor, err := snapshot.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		t.Fatalf("%s: Cursor unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	i := 0
+	for ; cursor.Next(); i++ {
+		key, err := cursor.Key()
+		if err != nil {
+			t.Fatalf("%s: Key unexpectedly "+
+				"failed: %s", testName, err)
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			t.Fatalf("%s: Value unexpectedly "+
+				"failed: %s", testName, err)
+		}
+		if !bytes.Equal(key.Bytes(), entries[i].key.Bytes()) || !bytes.Equal(value, entries[i].value) {
+			t.Fatalf("%s: cursor returned wrong entry %d. Want: %s:%s, got: %s:%s",
+				testName, i, entries[i].key, entries[i].value, key, value)
+		}
+	}
+	if i != len(entries) {
+		t.Fatalf("%s: cursor returned %d entries. Want: %d",
+			testName, i, len(entries))
+	}
+	err = cursor.Close()
+	if err != nil {
+		t.Fatalf("%s: Close unexpectedly "+
+			"failed: %s", testName, err)
+	}
+
+	err = snapshot.Release()
+	if err != nil {
+		t.Fatalf("%s: Release unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	_, err = snapshot.Get(entries[0].key)
+	if err == nil {
+		t.Fatalf("%s: Get unexpectedly succeeded "+
+			"on a released snapshot", testName)
+	}
+}
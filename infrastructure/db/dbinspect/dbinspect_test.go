@@ -0,0 +1,69 @@
+package dbinspect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+)
+
+func TestStatsAndDump(t *testing.T) {
+	db := memorydb.NewMemoryDB()
+	defer db.Close()
+
+	// A new consensus inserts the genesis block
+	params := dagconfig.SimnetParams
+	_, err := consensus.NewFactory().NewConsensus(&params, db, false, consensus.DefaultCacheSizes(&params))
+	if err != nil {
+		t.Fatalf("NewConsensus: %+v", err)
+	}
+
+	allStats, err := Stats(db)
+	if err != nil {
+		t.Fatalf("Stats: %+v", err)
+	}
+	if len(allStats) != len(stores) {
+		t.Fatalf("Stats returned %d stores. Want: %d", len(allStats), len(stores))
+	}
+	for _, stats := range allStats {
+		switch stats.Name {
+		case "blocks", "headers":
+			if stats.KeyCount != 1 {
+				t.Fatalf("Store %s has %d keys. Want: 1", stats.Name, stats.KeyCount)
+			}
+			if stats.KeysSize == 0 || stats.ValuesSize == 0 {
+				t.Fatalf("Store %s has unexpectedly empty keys or values", stats.Name)
+			}
+		case "utxoindex", "addressstore":
+			if stats.KeyCount != 0 {
+				t.Fatalf("Store %s has %d keys. Want: 0", stats.Name, stats.KeyCount)
+			}
+		}
+	}
+
+	entries, err := DumpBucket(db, "block-headers", 10)
+	if err != nil {
+		t.Fatalf("DumpBucket: %+v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("DumpBucket returned %d entries. Want: 1", len(entries))
+	}
+	if !strings.Contains(entries[0].Value, "hashMerkleRoot") {
+		t.Fatalf("The header was not decoded: %s", entries[0].Value)
+	}
+
+	entry, err := DumpKey(db, "block-headers", params.GenesisHash.ByteSlice())
+	if err != nil {
+		t.Fatalf("DumpKey: %+v", err)
+	}
+	if *entry != *entries[0] {
+		t.Fatalf("DumpKey returned %+v. Want: %+v", entry, entries[0])
+	}
+
+	_, err = DumpBucket(db, "no-such-bucket", 10)
+	if err == nil {
+		t.Fatalf("DumpBucket unexpectedly dumped an unknown bucket")
+	}
+}
@@ -0,0 +1,85 @@
+package dbinspect
+
+import (
+	"encoding/hex"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Entry is a database entry with its value decoded
+// through the serialization protobuf of its bucket
+type Entry struct {
+	// Key is the bucket name followed by the hex of the key suffix
+	Key string
+
+	// Value is the value as JSON, or as hex if the bucket's
+	// values are not protobufs
+	Value string
+}
+
+// DumpKey returns the entry of the given key suffix in the given bucket
+func DumpKey(db database.Database, bucketName string, keySuffix []byte) (*Entry, error) {
+	bucket, ok := bucketByName(bucketName)
+	if !ok {
+		return nil, errors.Errorf("unknown bucket %s", bucketName)
+	}
+	value, err := db.Get(bucket.bucket.Key(keySuffix))
+	if err != nil {
+		return nil, err
+	}
+	return bucket.entry(keySuffix, value)
+}
+
+// DumpBucket returns the first limit entries of the given bucket
+func DumpBucket(db database.Database, bucketName string, limit int) ([]*Entry, error) {
+	bucket, ok := bucketByName(bucketName)
+	if !ok {
+		return nil, errors.Errorf("unknown bucket %s", bucketName)
+	}
+	cursor, err := db.Cursor(bucket.bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var entries []*Entry
+	for len(entries) < limit && cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		entry, err := bucket.entry(key.Suffix(), value)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (b *bucket) entry(keySuffix []byte, value []byte) (*Entry, error) {
+	entry := &Entry{Key: b.name + "/" + hex.EncodeToString(keySuffix)}
+	if b.newValue == nil {
+		entry.Value = hex.EncodeToString(value)
+		return entry, nil
+	}
+
+	message := b.newValue()
+	err := proto.Unmarshal(value, message)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the value of %s", entry.Key)
+	}
+	decodedValue, err := protojson.MarshalOptions{Multiline: true}.Marshal(message)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	entry.Value = string(decodedValue)
+	return entry, nil
+}
@@ -0,0 +1,7 @@
+package dbinspect
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("KSDB")
@@ -0,0 +1,60 @@
+package dbinspect
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+// StoreStats are the number of keys of a store
+// and the total sizes of its keys and values
+type StoreStats struct {
+	Name       string
+	KeyCount   uint64
+	KeysSize   uint64
+	ValuesSize uint64
+}
+
+// Stats walks the buckets of every store in the given database, and
+// returns their key counts and sizes. The sizes are of the keys and
+// values themselves, before any compression the database applies.
+func Stats(db database.Database) ([]*StoreStats, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "dbinspect.Stats")
+	defer onEnd()
+
+	allStats := make([]*StoreStats, len(stores))
+	for i, store := range stores {
+		stats := &StoreStats{Name: store.name}
+		for _, bucket := range store.buckets {
+			err := addBucketStats(db, bucket.bucket, stats)
+			if err != nil {
+				return nil, err
+			}
+		}
+		log.Debugf("Store %s has %d keys", stats.Name, stats.KeyCount)
+		allStats[i] = stats
+	}
+	return allStats, nil
+}
+
+func addBucketStats(db database.Database, bucket *database.Bucket, stats *StoreStats) error {
+	cursor, err := db.Cursor(bucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			return err
+		}
+		stats.KeyCount++
+		stats.KeysSize += uint64(len(key.Bytes()))
+		stats.ValuesSize += uint64(len(value))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package dbinspect
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/database/serialization"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"google.golang.org/protobuf/proto"
+)
+
+// store is a group of buckets that are reported together
+type store struct {
+	name    string
+	buckets []*bucket
+}
+
+// bucket is a database bucket together with the serialization
+// protobuf its values are decoded through. newValue is nil for
+// buckets whose values are not protobufs.
+type bucket struct {
+	name     string
+	bucket   *database.Bucket
+	newValue func() proto.Message
+}
+
+func newBucket(name string, newValue func() proto.Message) *bucket {
+	return &bucket{
+		name:     name,
+		bucket:   database.MakeBucket([]byte(name)),
+		newValue: newValue,
+	}
+}
+
+// stores are the stores kaspad keeps in its database. The bucket names
+// must be kept in sync with the buckets of the respective stores, which
+// TestStoresListAllBuckets checks.
+var stores = []*store{
+	{name: "blocks", buckets: []*bucket{
+		newBucket("blocks", func() proto.Message { return &serialization.DbBlock{} }),
+	}},
+	{name: "headers", buckets: []*bucket{
+		newBucket("block-headers", func() proto.Message { return &serialization.DbBlockHeader{} }),
+	}},
+	{name: "statuses", buckets: []*bucket{
+		newBucket("block-statuses", func() proto.Message { return &serialization.DbBlockStatus{} }),
+	}},
+	{name: "relations", buckets: []*bucket{
+		newBucket("block-relations", func() proto.Message { return &serialization.DbBlockRelations{} }),
+	}},
+	{name: "ghostdag", buckets: []*bucket{
+		newBucket("block-ghostdag-data", func() proto.Message { return &serialization.DbBlockGhostdagData{} }),
+	}},
+	{name: "reachability", buckets: []*bucket{
+		newBucket("reachability-data", func() proto.Message { return &serialization.DbReachabilityData{} }),
+	}},
+	{name: "utxodiffs", buckets: []*bucket{
+		newBucket("utxo-diffs", func() proto.Message { return &serialization.DbUtxoDiff{} }),
+		newBucket("utxo-diff-children", func() proto.Message { return &serialization.DbHash{} }),
+	}},
+	{name: "multisets", buckets: []*bucket{
+		newBucket("multisets", func() proto.Message { return &serialization.DbMultiset{} }),
+	}},
+	{name: "acceptancedata", buckets: []*bucket{
+		newBucket("acceptance-data", func() proto.Message { return &serialization.DbAcceptanceData{} }),
+	}},
+	{name: "finality", buckets: []*bucket{
+		newBucket("finality-points", nil),
+	}},
+	{name: "headerschain", buckets: []*bucket{
+		newBucket("chain-block-hash-by-index", nil),
+		newBucket("chain-block-index-by-hash", nil),
+	}},
+	{name: "virtualutxoset", buckets: []*bucket{
+		newBucket("virtual-utxo-set", func() proto.Message { return &serialization.DbUtxoEntry{} }),
+	}},
+	{name: "pruningpointutxoset", buckets: []*bucket{
+		newBucket("pruning-point-utxo-set", func() proto.Message { return &serialization.DbUtxoEntry{} }),
+		newBucket("imported-pruning-point-utxos", func() proto.Message { return &serialization.DbUtxoEntry{} }),
+	}},
+	{name: "utxoindex", buckets: []*bucket{
+		newBucket("utxo-index", func() proto.Message { return &serialization.DbUtxoEntry{} }),
+	}},
+	{name: "addressstore", buckets: []*bucket{
+		newBucket("new-addresses", nil),
+		newBucket("tried-addresses", nil),
+		newBucket("banned-addresses", nil),
+		newBucket("permanent-peers", nil),
+	}},
+}
+
+// BucketNames returns the names of the buckets that can be dumped
+func BucketNames() []string {
+	var names []string
+	for _, store := range stores {
+		for _, bucket := range store.buckets {
+			names = append(names, bucket.name)
+		}
+	}
+	return names
+}
+
+func bucketByName(name string) (*bucket, bool) {
+	for _, store := range stores {
+		for _, bucket := range store.buckets {
+			if bucket.name == name {
+				return bucket, true
+			}
+		}
+	}
+	return nil, false
+}
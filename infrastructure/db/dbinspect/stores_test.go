@@ -0,0 +1,101 @@
+package dbinspect
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// storeSourceDirs are the directories, relative to this package, of the
+// packages that define the buckets kaspad keeps in its database
+var storeSourceDirs = []string{
+	"../../../domain/consensus/datastructures",
+	"../../../domain/utxoindex",
+	"../../network/addressmanager",
+}
+
+// TestStoresListAllBuckets makes sure that every bucket a store defines is
+// listed in stores, so that adding a store or renaming a bucket without
+// updating this package fails the tests.
+func TestStoresListAllBuckets(t *testing.T) {
+	listedBucketNames := make(map[string]bool)
+	for _, name := range BucketNames() {
+		listedBucketNames[name] = true
+	}
+
+	definedBucketNames := make(map[string]bool)
+	for _, dir := range storeSourceDirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+			for _, name := range bucketNamesDefinedIn(t, path) {
+				definedBucketNames[name] = true
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Walk: %s", err)
+		}
+	}
+	if len(definedBucketNames) == 0 {
+		t.Fatalf("Found no bucket definitions in %s", storeSourceDirs)
+	}
+
+	for name := range definedBucketNames {
+		if !listedBucketNames[name] {
+			t.Errorf("Bucket %s is defined by a store but is not listed in stores", name)
+		}
+	}
+	for name := range listedBucketNames {
+		if !definedBucketNames[name] {
+			t.Errorf("Bucket %s is listed in stores but is not defined by any store", name)
+		}
+	}
+}
+
+// bucketNamesDefinedIn returns the names of the non-empty buckets that
+// the given file makes with database.MakeBucket([]byte("name"))
+func bucketNamesDefinedIn(t *testing.T, path string) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		t.Fatalf("ParseFile: %s", err)
+	}
+
+	var names []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || selector.Sel.Name != "MakeBucket" {
+			return true
+		}
+		conversion, ok := call.Args[0].(*ast.CallExpr)
+		if !ok || len(conversion.Args) != 1 {
+			return true
+		}
+		literal, ok := conversion.Args[0].(*ast.BasicLit)
+		if !ok || literal.Kind != token.STRING {
+			return true
+		}
+		name, err := strconv.Unquote(literal.Value)
+		if err != nil {
+			t.Fatalf("Unquote: %s", err)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+		return true
+	})
+	return names
+}
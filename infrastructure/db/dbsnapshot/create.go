@@ -0,0 +1,155 @@
+package dbsnapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// writeBufferSize is the size of the buffer snapshot files are written through
+const writeBufferSize = 1 << 20
+
+// Result describes a snapshot file that was created
+type Result struct {
+	EntryCount uint64
+	Size       int64
+}
+
+// Create takes a point-in-time snapshot of the given database and writes
+// it into a new file at the given path, gzip compressed if compress is
+// true. The database may keep being written to while the file is written.
+//
+// The file is first written under a temporary name and renamed once it's
+// complete, so a file at the given path is always a complete snapshot.
+func Create(db database.Database, path string, network string, compress bool) (*Result, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		return nil, errors.Errorf("%s already exists", path)
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		releaseErr := snapshot.Release()
+		if releaseErr != nil {
+			log.Errorf("Error releasing the database snapshot: %s", releaseErr)
+		}
+	}()
+
+	tempPath := path + ".tmp"
+	file, err := os.OpenFile(tempPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	isComplete := false
+	defer func() {
+		if isComplete {
+			return
+		}
+		// The file may have already been closed, so the error is ignored
+		_ = file.Close()
+		removeErr := os.Remove(tempPath)
+		if removeErr != nil {
+			log.Errorf("Error removing the incomplete snapshot file %s: %s", tempPath, removeErr)
+		}
+	}()
+
+	entryCount, err := write(snapshot, file, network, compress)
+	if err != nil {
+		return nil, err
+	}
+	err = file.Sync()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = file.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	isComplete = true
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Result{
+		EntryCount: entryCount,
+		Size:       fileInfo.Size(),
+	}, nil
+}
+
+// write writes all the entries of the given snapshot to the given writer
+func write(snapshot database.Snapshot, writer io.Writer, network string, compress bool) (entryCount uint64, err error) {
+	bufferedWriter := bufio.NewWriterSize(writer, writeBufferSize)
+	var snapshotWriter io.Writer = bufferedWriter
+	var gzipWriter *gzip.Writer
+	if compress {
+		gzipWriter = gzip.NewWriter(bufferedWriter)
+		snapshotWriter = gzipWriter
+	}
+
+	err = writeHeader(snapshotWriter, network)
+	if err != nil {
+		return 0, err
+	}
+
+	cursor, err := snapshot.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return 0, err
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			return 0, err
+		}
+		keyBytes := key.Bytes()
+		// An empty key marks the end of the entries
+		if len(keyBytes) == 0 {
+			return 0, errors.New("cannot write an entry with an empty key")
+		}
+		err = writeField(snapshotWriter, keyBytes)
+		if err != nil {
+			return 0, err
+		}
+		err = writeField(snapshotWriter, value)
+		if err != nil {
+			return 0, err
+		}
+		entryCount++
+	}
+
+	err = writeFooter(snapshotWriter, entryCount)
+	if err != nil {
+		return 0, err
+	}
+	if gzipWriter != nil {
+		err = gzipWriter.Close()
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	err = bufferedWriter.Flush()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return entryCount, nil
+}
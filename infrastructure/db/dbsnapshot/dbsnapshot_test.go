@@ -0,0 +1,114 @@
+package dbsnapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+)
+
+const testNetwork = "kaspa-simnet"
+
+func TestCreateAndRestore(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		testName := fmt.Sprintf("compress: %t", compress)
+
+		dir, err := ioutil.TempDir("", "TestCreateAndRestore")
+		if err != nil {
+			t.Fatalf("%s: TempDir: %s", testName, err)
+		}
+		defer os.RemoveAll(dir)
+
+		db, err := ldb.NewLevelDB(filepath.Join(dir, "db"), 8)
+		if err != nil {
+			t.Fatalf("%s: NewLevelDB: %s", testName, err)
+		}
+		defer db.Close()
+
+		bucket := database.MakeBucket([]byte("bucket"))
+		const entryCount = 2*restoreBatchSize + 1
+		for i := 0; i < entryCount; i++ {
+			err := db.Put(bucket.Key([]byte(fmt.Sprintf("key%d", i))), []byte(fmt.Sprintf("value%d", i)))
+			if err != nil {
+				t.Fatalf("%s: Put: %s", testName, err)
+			}
+		}
+		// Empty values must survive the round trip as well
+		emptyValueKey := database.MakeBucket(nil).Key([]byte("empty"))
+		err = db.Put(emptyValueKey, []byte{})
+		if err != nil {
+			t.Fatalf("%s: Put: %s", testName, err)
+		}
+
+		path := filepath.Join(dir, "snapshot")
+		result, err := Create(db, path, testNetwork, compress)
+		if err != nil {
+			t.Fatalf("%s: Create: %s", testName, err)
+		}
+		if result.EntryCount != entryCount+1 {
+			t.Fatalf("%s: Create wrote %d entries. Want: %d", testName, result.EntryCount, entryCount+1)
+		}
+		_, err = os.Stat(path + ".tmp")
+		if !os.IsNotExist(err) {
+			t.Fatalf("%s: the temporary snapshot file was not removed", testName)
+		}
+
+		_, err = Create(db, path, testNetwork, compress)
+		if err == nil || !strings.Contains(err.Error(), "already exists") {
+			t.Fatalf("%s: Create over an existing file returned an unexpected error: %v", testName, err)
+		}
+
+		restoredDB := memorydb.NewMemoryDB()
+		defer restoredDB.Close()
+		restoredEntryCount, err := Restore(path, restoredDB, testNetwork)
+		if err != nil {
+			t.Fatalf("%s: Restore: %s", testName, err)
+		}
+		if restoredEntryCount != result.EntryCount {
+			t.Fatalf("%s: Restore restored %d entries. Want: %d", testName, restoredEntryCount, result.EntryCount)
+		}
+		for i := 0; i < entryCount; i++ {
+			value, err := restoredDB.Get(bucket.Key([]byte(fmt.Sprintf("key%d", i))))
+			if err != nil {
+				t.Fatalf("%s: Get: %s", testName, err)
+			}
+			if !bytes.Equal(value, []byte(fmt.Sprintf("value%d", i))) {
+				t.Fatalf("%s: entry %d was restored with the wrong value %s", testName, i, value)
+			}
+		}
+		value, err := restoredDB.Get(emptyValueKey)
+		if err != nil {
+			t.Fatalf("%s: Get: %s", testName, err)
+		}
+		if len(value) != 0 {
+			t.Fatalf("%s: the empty value was restored as %x", testName, value)
+		}
+
+		_, err = Restore(path, memorydb.NewMemoryDB(), "kaspa-mainnet")
+		if err == nil || !strings.Contains(err.Error(), "rather than on kaspa-mainnet") {
+			t.Fatalf("%s: Restore on the wrong network returned an unexpected error: %v", testName, err)
+		}
+
+		// A truncated snapshot must be rejected
+		snapshotBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("%s: ReadFile: %s", testName, err)
+		}
+		truncatedPath := filepath.Join(dir, "truncated")
+		err = ioutil.WriteFile(truncatedPath, snapshotBytes[:len(snapshotBytes)-4], 0600)
+		if err != nil {
+			t.Fatalf("%s: WriteFile: %s", testName, err)
+		}
+		_, err = Restore(truncatedPath, memorydb.NewMemoryDB(), testNetwork)
+		if err == nil {
+			t.Fatalf("%s: Restore of a truncated snapshot unexpectedly succeeded", testName)
+		}
+	}
+}
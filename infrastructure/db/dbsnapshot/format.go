@@ -0,0 +1,107 @@
+package dbsnapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// A snapshot file is made of:
+//   - The magic bytes
+//   - The format version, as a little-endian uint32
+//   - The name of the network the database belongs to, prefixed by
+//     its length as a uvarint
+//   - The database entries, each made of its key and its value, both
+//     prefixed by their lengths as uvarints
+//   - An empty key, marking the end of the entries
+//   - The number of entries, as a little-endian uint64
+//
+// The whole file may be gzip compressed.
+
+// magic identifies snapshot files
+var magic = []byte("kaspadb\x00")
+
+// formatVersion is the version of the snapshot file format
+const formatVersion = 1
+
+// gzipMagic are the first bytes of every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// maxFieldLength is the maximum length of a key or a value in a snapshot
+// file. It protects against allocating huge buffers for corrupted files.
+const maxFieldLength = 1 << 30
+
+func writeHeader(writer io.Writer, network string) error {
+	_, err := writer.Write(magic)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(writer, binary.LittleEndian, uint32(formatVersion))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeField(writer, []byte(network))
+}
+
+func readHeader(reader *bufio.Reader) (network string, err error) {
+	fileMagic := make([]byte, len(magic))
+	_, err = io.ReadFull(reader, fileMagic)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the snapshot header")
+	}
+	if string(fileMagic) != string(magic) {
+		return "", errors.New("the file is not a database snapshot")
+	}
+	var fileFormatVersion uint32
+	err = binary.Read(reader, binary.LittleEndian, &fileFormatVersion)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the snapshot header")
+	}
+	if fileFormatVersion != formatVersion {
+		return "", errors.Errorf("unsupported snapshot format version %d. "+
+			"Supported version is %d", fileFormatVersion, formatVersion)
+	}
+	networkBytes, err := readField(reader)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the snapshot header")
+	}
+	return string(networkBytes), nil
+}
+
+func writeField(writer io.Writer, field []byte) error {
+	lengthBytes := make([]byte, binary.MaxVarintLen64)
+	lengthSize := binary.PutUvarint(lengthBytes, uint64(len(field)))
+	_, err := writer.Write(lengthBytes[:lengthSize])
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = writer.Write(field)
+	return errors.WithStack(err)
+}
+
+func readField(reader *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if length > maxFieldLength {
+		return nil, errors.Errorf("field length %d is above the "+
+			"maximum of %d", length, maxFieldLength)
+	}
+	field := make([]byte, length)
+	_, err = io.ReadFull(reader, field)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return field, nil
+}
+
+func writeFooter(writer io.Writer, entryCount uint64) error {
+	err := writeField(writer, nil)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(binary.Write(writer, binary.LittleEndian, entryCount))
+}
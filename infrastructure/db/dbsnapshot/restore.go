@@ -0,0 +1,136 @@
+package dbsnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// readBufferSize is the size of the buffer snapshot files are read through
+const readBufferSize = 1 << 20
+
+// restoreBatchSize is the number of entries written
+// to the database in a single transaction
+const restoreBatchSize = 10_000
+
+// Restore writes the entries of the snapshot file at the given path into
+// the given database, which is expected to be empty, and returns their
+// number. Both compressed and uncompressed snapshot files are accepted.
+//
+// Restore fails if the snapshot was taken on a network other than the
+// given one. If it fails, the database may contain some of the entries
+// and should be discarded.
+func Restore(path string, db database.Database, network string) (entryCount uint64, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, readBufferSize)
+	fileMagic, err := reader.Peek(len(gzipMagic))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read the snapshot header")
+	}
+	if bytes.Equal(fileMagic, gzipMagic) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		defer gzipReader.Close()
+		reader = bufio.NewReaderSize(gzipReader, readBufferSize)
+	}
+
+	snapshotNetwork, err := readHeader(reader)
+	if err != nil {
+		return 0, err
+	}
+	if snapshotNetwork != network {
+		return 0, errors.Errorf("the snapshot was taken on %s rather than on %s",
+			snapshotNetwork, network)
+	}
+
+	entryCount, err = restoreEntries(reader, db)
+	if err != nil {
+		return 0, err
+	}
+
+	var expectedEntryCount uint64
+	err = binary.Read(reader, binary.LittleEndian, &expectedEntryCount)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read the snapshot footer")
+	}
+	if entryCount != expectedEntryCount {
+		return 0, errors.Errorf("the snapshot contains %d entries rather "+
+			"than the %d recorded in it", entryCount, expectedEntryCount)
+	}
+
+	// Reading to the end makes gzip verify its checksum
+	_, err = reader.ReadByte()
+	if err != io.EOF {
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		return 0, errors.New("unexpected data after the end of the snapshot")
+	}
+
+	return entryCount, nil
+}
+
+func restoreEntries(reader *bufio.Reader, db database.Database) (entryCount uint64, err error) {
+	rootBucket := database.MakeBucket(nil)
+	dbTx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		rollbackErr := dbTx.RollbackUnlessClosed()
+		if err == nil {
+			err = rollbackErr
+		}
+	}()
+
+	for {
+		key, err := readField(reader)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to read entry %d", entryCount)
+		}
+		// An empty key marks the end of the entries
+		if len(key) == 0 {
+			break
+		}
+		value, err := readField(reader)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to read entry %d", entryCount)
+		}
+		err = dbTx.Put(rootBucket.Key(key), value)
+		if err != nil {
+			return 0, err
+		}
+		entryCount++
+
+		if entryCount%restoreBatchSize == 0 {
+			err = dbTx.Commit()
+			if err != nil {
+				return 0, err
+			}
+			dbTx, err = db.Begin()
+			if err != nil {
+				return 0, err
+			}
+			log.Debugf("Restored %d database entries", entryCount)
+		}
+	}
+
+	err = dbTx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return entryCount, nil
+}
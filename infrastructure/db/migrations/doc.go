@@ -0,0 +1,14 @@
+/*
+Package migrations keeps track of the schema version of kaspad's database,
+and upgrades databases that were created by older versions of kaspad.
+
+Every change to the format of data that is already stored in the database
+comes with a Migration, appended to the migrations list with the next
+version. Migrate runs at startup, before the database is handed to
+consensus, and runs every migration that the database hasn't gone
+through yet.
+
+A migration is tested by creating a database in the format of the version
+before it, running the migrator over it and checking the result.
+*/
+package migrations
@@ -0,0 +1,7 @@
+package migrations
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("MIGR")
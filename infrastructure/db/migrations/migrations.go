@@ -0,0 +1,174 @@
+package migrations
+
+import (
+	"encoding/binary"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedVersion is returned when a database's schema version can
+// neither be used as-is nor be migrated to CurrentVersion
+var ErrUnsupportedVersion = errors.New("unsupported database schema version")
+
+// Migration upgrades a database from the schema version right before
+// Version to Version.
+//
+// Migrate may be interrupted at any point, in which case it's run again
+// from the start on the next startup, so it must be idempotent.
+type Migration struct {
+	Version     uint64
+	Description string
+	Migrate     func(db database.Database) error
+}
+
+const (
+	// minimumSupportedVersion is the oldest database schema version that
+	// can be migrated to CurrentVersion. Databases older than that must be
+	// resynced from scratch.
+	minimumSupportedVersion = 1
+
+	// unversionedDatabaseVersion is the version assumed for databases that
+	// were created before the schema version was stored
+	unversionedDatabaseVersion = 1
+)
+
+// migrations are the migrations from minimumSupportedVersion to
+// CurrentVersion, ordered by their version
+var migrations = []*Migration{
+	{
+		Version:     2,
+		Description: "move the not banned addresses into the new address table",
+		Migrate:     moveNotBannedAddresses,
+	},
+}
+
+var schemaVersionKey = database.MakeBucket([]byte("database-schema")).Key([]byte("version"))
+
+// CurrentVersion returns the database schema version that new databases
+// are created with, and that older databases are migrated to
+func CurrentVersion() uint64 {
+	return newMigrator(migrations, minimumSupportedVersion).currentVersion()
+}
+
+// Migrate brings the given database to CurrentVersion. A new database is
+// stamped with CurrentVersion, and an older one is run through every
+// migration after its version, in order. The version is stored after
+// every migration, so an interrupted upgrade resumes from the migration
+// it was interrupted at.
+//
+// Migrate returns ErrUnsupportedVersion if the database is either too old
+// to be migrated or newer than CurrentVersion.
+func Migrate(db database.Database) error {
+	return newMigrator(migrations, minimumSupportedVersion).migrate(db)
+}
+
+type migrator struct {
+	migrations              []*Migration
+	minimumSupportedVersion uint64
+}
+
+func newMigrator(migrations []*Migration, minimumSupportedVersion uint64) *migrator {
+	return &migrator{
+		migrations:              migrations,
+		minimumSupportedVersion: minimumSupportedVersion,
+	}
+}
+
+func (m *migrator) currentVersion() uint64 {
+	return m.minimumSupportedVersion + uint64(len(m.migrations))
+}
+
+func (m *migrator) migrate(db database.Database) error {
+	for i, migration := range m.migrations {
+		expectedVersion := m.minimumSupportedVersion + uint64(i) + 1
+		if migration.Version != expectedVersion {
+			return errors.Errorf("migration %d has version %d while expecting version %d",
+				i, migration.Version, expectedVersion)
+		}
+	}
+
+	version, err := m.databaseVersion(db)
+	if err != nil {
+		return err
+	}
+
+	currentVersion := m.currentVersion()
+	if version > currentVersion {
+		return errors.Wrapf(ErrUnsupportedVersion, "the database schema version is %d, which is newer than "+
+			"the latest version supported by this version of kaspad (%d). Please upgrade kaspad, "+
+			"or run with --reset-db to resync", version, currentVersion)
+	}
+	if version < m.minimumSupportedVersion {
+		return errors.Wrapf(ErrUnsupportedVersion, "the database schema version is %d, which is too old "+
+			"to be migrated (the oldest supported version is %d). Please run with --reset-db to resync",
+			version, m.minimumSupportedVersion)
+	}
+	if version == currentVersion {
+		log.Debugf("The database schema is up to date (version %d)", version)
+		return nil
+	}
+
+	log.Infof("Migrating the database schema from version %d to version %d", version, currentVersion)
+	for _, migration := range m.migrations[version-m.minimumSupportedVersion:] {
+		log.Infof("Running database migration %d: %s", migration.Version, migration.Description)
+		err := migration.Migrate(db)
+		if err != nil {
+			return errors.Wrapf(err, "database migration %d failed", migration.Version)
+		}
+		err = storeVersion(db, migration.Version)
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof("Finished migrating the database schema to version %d", currentVersion)
+	return nil
+}
+
+// databaseVersion returns the schema version of the given database,
+// stamping it with the current version if it's a new one
+func (m *migrator) databaseVersion(db database.Database) (uint64, error) {
+	versionBytes, err := db.Get(schemaVersionKey)
+	if err == nil {
+		if len(versionBytes) != 8 {
+			return 0, errors.Errorf("malformed database schema version %x", versionBytes)
+		}
+		return binary.LittleEndian.Uint64(versionBytes), nil
+	}
+	if !database.IsNotFoundError(err) {
+		return 0, err
+	}
+
+	isEmpty, err := isEmpty(db)
+	if err != nil {
+		return 0, err
+	}
+	if !isEmpty {
+		log.Infof("The database has no schema version. Assuming version %d", unversionedDatabaseVersion)
+		return unversionedDatabaseVersion, nil
+	}
+
+	currentVersion := m.currentVersion()
+	log.Debugf("Creating a new database with schema version %d", currentVersion)
+	err = storeVersion(db, currentVersion)
+	if err != nil {
+		return 0, err
+	}
+	return currentVersion, nil
+}
+
+func isEmpty(db database.Database) (bool, error) {
+	cursor, err := db.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close()
+
+	return !cursor.First(), nil
+}
+
+func storeVersion(db database.Database, version uint64) error {
+	versionBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(versionBytes, version)
+	return db.Put(schemaVersionKey, versionBytes)
+}
@@ -0,0 +1,260 @@
+package migrations
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+var testBucket = database.MakeBucket([]byte("test"))
+
+// createFixtureDatabase creates a database with the given schema version
+// and entries. A version of 0 creates an unversioned database.
+func createFixtureDatabase(t *testing.T, version uint64, entries map[string]string) (
+	db database.Database, teardown func()) {
+
+	path, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	db, err = ldb.NewLevelDB(path, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	teardown = func() {
+		err := db.Close()
+		if err != nil {
+			t.Errorf("Close: %s", err)
+		}
+		os.RemoveAll(path)
+	}
+
+	if version != 0 {
+		err := storeVersion(db, version)
+		if err != nil {
+			t.Fatalf("storeVersion: %s", err)
+		}
+	}
+	for key, value := range entries {
+		err := db.Put(testBucket.Key([]byte(key)), []byte(value))
+		if err != nil {
+			t.Fatalf("Put: %s", err)
+		}
+	}
+	return db, teardown
+}
+
+func storedVersion(t *testing.T, db database.Database) uint64 {
+	versionBytes, err := db.Get(schemaVersionKey)
+	if err != nil {
+		t.Fatalf("Get: %s", err)
+	}
+	return binary.LittleEndian.Uint64(versionBytes)
+}
+
+func storedValue(t *testing.T, db database.Database, key string) string {
+	value, err := db.Get(testBucket.Key([]byte(key)))
+	if err != nil {
+		t.Fatalf("Get: %s", err)
+	}
+	return string(value)
+}
+
+// testMigrations are migrations from version 1 to 3. The first renames the
+// "old" entry to "new", and the second appends a suffix to it.
+func testMigrations(runs *[]uint64) []*Migration {
+	return []*Migration{
+		{
+			Version:     2,
+			Description: "rename old to new",
+			Migrate: func(db database.Database) error {
+				*runs = append(*runs, 2)
+				value, err := db.Get(testBucket.Key([]byte("old")))
+				if database.IsNotFoundError(err) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				err = db.Put(testBucket.Key([]byte("new")), value)
+				if err != nil {
+					return err
+				}
+				return db.Delete(testBucket.Key([]byte("old")))
+			},
+		},
+		{
+			Version:     3,
+			Description: "append a suffix to new",
+			Migrate: func(db database.Database) error {
+				*runs = append(*runs, 3)
+				value, err := db.Get(testBucket.Key([]byte("new")))
+				if err != nil {
+					return err
+				}
+				return db.Put(testBucket.Key([]byte("new")), append(value, []byte("-v3")...))
+			},
+		},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       uint64
+		entries       map[string]string
+		expectedRuns  []uint64
+		expectedValue string
+	}{
+		{
+			name:          "version 1",
+			version:       1,
+			entries:       map[string]string{"old": "value"},
+			expectedRuns:  []uint64{2, 3},
+			expectedValue: "value-v3",
+		},
+		{
+			name:          "version 2",
+			version:       2,
+			entries:       map[string]string{"new": "value"},
+			expectedRuns:  []uint64{3},
+			expectedValue: "value-v3",
+		},
+		{
+			name:          "up to date",
+			version:       3,
+			entries:       map[string]string{"new": "value"},
+			expectedRuns:  nil,
+			expectedValue: "value",
+		},
+		{
+			name:          "unversioned",
+			version:       0,
+			entries:       map[string]string{"old": "value"},
+			expectedRuns:  []uint64{2, 3},
+			expectedValue: "value-v3",
+		},
+	}
+
+	for _, test := range tests {
+		func() {
+			db, teardown := createFixtureDatabase(t, test.version, test.entries)
+			defer teardown()
+
+			var runs []uint64
+			err := newMigrator(testMigrations(&runs), 1).migrate(db)
+			if err != nil {
+				t.Fatalf("%s: migrate: %s", test.name, err)
+			}
+			if len(runs) != len(test.expectedRuns) {
+				t.Fatalf("%s: expected migrations %v to run, got %v", test.name, test.expectedRuns, runs)
+			}
+			for i := range runs {
+				if runs[i] != test.expectedRuns[i] {
+					t.Fatalf("%s: expected migrations %v to run, got %v", test.name, test.expectedRuns, runs)
+				}
+			}
+			if version := storedVersion(t, db); version != 3 {
+				t.Fatalf("%s: expected version 3, got %d", test.name, version)
+			}
+			if value := storedValue(t, db, "new"); value != test.expectedValue {
+				t.Fatalf("%s: expected value %s, got %s", test.name, test.expectedValue, value)
+			}
+		}()
+	}
+}
+
+func TestMigrateNewDatabase(t *testing.T) {
+	db, teardown := createFixtureDatabase(t, 0, nil)
+	defer teardown()
+
+	var runs []uint64
+	err := newMigrator(testMigrations(&runs), 1).migrate(db)
+	if err != nil {
+		t.Fatalf("migrate: %s", err)
+	}
+	if len(runs) != 0 {
+		t.Fatalf("Expected no migrations to run on a new database, got %v", runs)
+	}
+	if version := storedVersion(t, db); version != 3 {
+		t.Fatalf("Expected version 3, got %d", version)
+	}
+}
+
+func TestMigrateResume(t *testing.T) {
+	db, teardown := createFixtureDatabase(t, 1, map[string]string{"old": "value"})
+	defer teardown()
+
+	var runs []uint64
+	failingMigrations := testMigrations(&runs)
+	failingMigrations[1].Migrate = func(db database.Database) error {
+		return errors.New("interrupted")
+	}
+	err := newMigrator(failingMigrations, 1).migrate(db)
+	if err == nil {
+		t.Fatalf("Expected the migration to fail")
+	}
+	if version := storedVersion(t, db); version != 2 {
+		t.Fatalf("Expected the migration to stop at version 2, got %d", version)
+	}
+
+	runs = nil
+	err = newMigrator(testMigrations(&runs), 1).migrate(db)
+	if err != nil {
+		t.Fatalf("migrate: %s", err)
+	}
+	if len(runs) != 1 || runs[0] != 3 {
+		t.Fatalf("Expected only migration 3 to run when resuming, got %v", runs)
+	}
+	if value := storedValue(t, db, "new"); value != "value-v3" {
+		t.Fatalf("Expected value value-v3, got %s", value)
+	}
+}
+
+func TestMigrateUnsupportedVersion(t *testing.T) {
+	for _, version := range []uint64{1, 4} {
+		func() {
+			db, teardown := createFixtureDatabase(t, version, map[string]string{"new": "value"})
+			defer teardown()
+
+			var runs []uint64
+			err := newMigrator(testMigrations(&runs)[1:], 2).migrate(db)
+			if !errors.Is(err, ErrUnsupportedVersion) {
+				t.Fatalf("version %d: expected ErrUnsupportedVersion, got %v", version, err)
+			}
+			if len(runs) != 0 {
+				t.Fatalf("version %d: expected no migrations to run, got %v", version, runs)
+			}
+			if storedVersion(t, db) != version {
+				t.Fatalf("version %d: expected the version to remain unchanged", version)
+			}
+		}()
+	}
+}
+
+func TestMigrationVersionsOrder(t *testing.T) {
+	db, teardown := createFixtureDatabase(t, 1, nil)
+	defer teardown()
+
+	var runs []uint64
+	outOfOrderMigrations := testMigrations(&runs)
+	outOfOrderMigrations[0], outOfOrderMigrations[1] = outOfOrderMigrations[1], outOfOrderMigrations[0]
+	err := newMigrator(outOfOrderMigrations, 1).migrate(db)
+	if err == nil {
+		t.Fatalf("Expected an error for out of order migrations")
+	}
+
+	// The migrations of this version of kaspad must be in order
+	err = Migrate(db)
+	if err != nil {
+		t.Fatalf("Migrate: %s", err)
+	}
+	if version := storedVersion(t, db); version != CurrentVersion() {
+		t.Fatalf("Expected version %d, got %d", CurrentVersion(), version)
+	}
+}
@@ -0,0 +1,90 @@
+package migrations
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// The address manager buckets, as of schema version 2
+var (
+	notBannedAddressBucket = database.MakeBucket([]byte("not-banned-addresses"))
+	newAddressBucket       = database.MakeBucket([]byte("new-addresses"))
+	triedAddressBucket     = database.MakeBucket([]byte("tried-addresses"))
+)
+
+const (
+	// serializedNetAddressSize is the size of an address in
+	// not-banned-addresses: ipv6 + port + timestamp + services
+	serializedNetAddressSize = 16 + 2 + 8 + 8
+
+	// serializedAddressInfoSize is the size of an address in the new and
+	// tried tables: net address + source ipv6 + attempts + last attempt +
+	// last success
+	serializedAddressInfoSize = serializedNetAddressSize + 16 + 4 + 8 + 8
+)
+
+// moveNotBannedAddresses moves the addresses that were stored before the
+// address manager split them into the new and tried tables into the new
+// table. Each address is recorded as learned from itself, with no connection
+// attempts. The address manager assigns the buckets of the new table when it
+// loads it.
+func moveNotBannedAddresses(db database.Database) error {
+	cursor, err := db.Cursor(notBannedAddressBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	var keysToDelete []*database.Key
+	movedCount := 0
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		databaseKey, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+		serializedNetAddress, err := cursor.Value()
+		if err != nil {
+			return err
+		}
+		// The cursor may reuse the memory of the key once it moves on
+		serializedKey := append([]byte{}, databaseKey.Suffix()...)
+		keysToDelete = append(keysToDelete, notBannedAddressBucket.Key(serializedKey))
+		if len(serializedNetAddress) != serializedNetAddressSize {
+			log.Warnf("Dropping malformed address %x", serializedNetAddress)
+			continue
+		}
+
+		isKnown, err := isKnownAddress(db, serializedKey)
+		if err != nil {
+			return err
+		}
+		if isKnown {
+			continue
+		}
+
+		serializedAddressInfo := make([]byte, serializedAddressInfoSize)
+		copy(serializedAddressInfo, serializedNetAddress)
+		copy(serializedAddressInfo[serializedNetAddressSize:], serializedNetAddress[:16])
+		err = db.Put(newAddressBucket.Key(serializedKey), serializedAddressInfo)
+		if err != nil {
+			return err
+		}
+		movedCount++
+	}
+
+	for _, databaseKey := range keysToDelete {
+		err := db.Delete(databaseKey)
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof("Moved %d addresses to the new address table", movedCount)
+	return nil
+}
+
+func isKnownAddress(db database.Database, serializedKey []byte) (bool, error) {
+	isNew, err := db.Has(newAddressBucket.Key(serializedKey))
+	if err != nil || isNew {
+		return isNew, err
+	}
+	return db.Has(triedAddressBucket.Key(serializedKey))
+}
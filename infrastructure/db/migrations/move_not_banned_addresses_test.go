@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+func serializedTestAddress(ip byte, timestamp uint64) (serializedKey []byte, serializedNetAddress []byte) {
+	serializedNetAddress = make([]byte, serializedNetAddressSize)
+	copy(serializedNetAddress, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, ip, ip, ip, ip})
+	binary.LittleEndian.PutUint16(serializedNetAddress[16:], 16111)
+	binary.LittleEndian.PutUint64(serializedNetAddress[18:], timestamp)
+	return serializedNetAddress[:18], serializedNetAddress
+}
+
+func TestMoveNotBannedAddresses(t *testing.T) {
+	db, teardown := createFixtureDatabase(t, 1, nil)
+	defer teardown()
+
+	newKey, newNetAddress := serializedTestAddress(1, 1000)
+	triedKey, triedNetAddress := serializedTestAddress(2, 2000)
+	malformedKey, _ := serializedTestAddress(3, 3000)
+	triedAddressInfo := []byte("tried address info")
+	entries := map[*database.Key][]byte{
+		notBannedAddressBucket.Key(newKey):       newNetAddress,
+		notBannedAddressBucket.Key(triedKey):     triedNetAddress,
+		notBannedAddressBucket.Key(malformedKey): {1, 2, 3},
+		triedAddressBucket.Key(triedKey):         triedAddressInfo,
+	}
+	for key, value := range entries {
+		err := db.Put(key, value)
+		if err != nil {
+			t.Fatalf("Put: %s", err)
+		}
+	}
+
+	// The migration must be idempotent, so running it twice must
+	// give the same result as running it once
+	for i := 0; i < 2; i++ {
+		err := moveNotBannedAddresses(db)
+		if err != nil {
+			t.Fatalf("moveNotBannedAddresses: %s", err)
+		}
+	}
+
+	cursor, err := db.Cursor(notBannedAddressBucket)
+	if err != nil {
+		t.Fatalf("Cursor: %s", err)
+	}
+	defer cursor.Close()
+	if cursor.First() {
+		t.Fatalf("Expected the not banned addresses to be removed")
+	}
+
+	// The address is learned from itself, and was never attempted
+	expectedAddressInfo := make([]byte, serializedAddressInfoSize)
+	copy(expectedAddressInfo, newNetAddress)
+	copy(expectedAddressInfo[serializedNetAddressSize:], newNetAddress[:16])
+	addressInfo, err := db.Get(newAddressBucket.Key(newKey))
+	if err != nil {
+		t.Fatalf("Get: %s", err)
+	}
+	if !bytes.Equal(addressInfo, expectedAddressInfo) {
+		t.Fatalf("Unexpected address info. Want: %x, got: %x", expectedAddressInfo, addressInfo)
+	}
+
+	// An address that's already known keeps its entry
+	isNew, err := db.Has(newAddressBucket.Key(triedKey))
+	if err != nil {
+		t.Fatalf("Has: %s", err)
+	}
+	if isNew {
+		t.Fatalf("Expected a tried address not to be added to the new table")
+	}
+	addressInfo, err = db.Get(triedAddressBucket.Key(triedKey))
+	if err != nil {
+		t.Fatalf("Get: %s", err)
+	}
+	if !bytes.Equal(addressInfo, triedAddressInfo) {
+		t.Fatalf("Expected the tried address info to be kept. Want: %x, got: %x", triedAddressInfo, addressInfo)
+	}
+
+	isNew, err = db.Has(newAddressBucket.Key(malformedKey))
+	if err != nil {
+		t.Fatalf("Has: %s", err)
+	}
+	if isNew {
+		t.Fatalf("Expected a malformed address not to be added to the new table")
+	}
+}
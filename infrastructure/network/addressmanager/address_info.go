@@ -0,0 +1,97 @@
+package addressmanager
+
+import (
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/util/mstime"
+)
+
+const (
+	// staleAddressAge is the age after which an address that was not seen
+	// again is considered stale
+	staleAddressAge = 30 * 24 * time.Hour
+
+	// maxFutureTimestampOffset is how far in the future an address timestamp
+	// may be before the address is considered stale
+	maxFutureTimestampOffset = 10 * time.Minute
+
+	// maxAttemptsWithoutSuccess is the number of failed connection attempts
+	// after which an address we never connected to is considered stale
+	maxAttemptsWithoutSuccess = 3
+
+	// maxAttemptsSinceSuccess is the number of failed connection attempts
+	// after which an address we did not connect to for minStaleSuccessAge is
+	// considered stale
+	maxAttemptsSinceSuccess = 10
+	minStaleSuccessAge      = 7 * 24 * time.Hour
+
+	// recentAttemptInterval is the interval during which a connection attempt
+	// is considered recent. Addresses attempted recently are never
+	// considered stale, and are less likely to be selected again.
+	recentAttemptInterval = 10 * time.Minute
+
+	// staleRemovalInterval is the minimum interval between two passes
+	// over the new table that remove all of its stale addresses
+	staleRemovalInterval = 10 * time.Minute
+)
+
+// addressInfo is what the address manager knows about an address: where it
+// was learned from, and how connecting to it went so far
+type addressInfo struct {
+	netAddress *appmessage.NetAddress
+
+	// source is the address of the peer the address was learned from. It
+	// is the address itself when the source is unknown.
+	source *appmessage.NetAddress
+
+	isTried     bool
+	bucket      int
+	attempts    uint32
+	lastAttempt mstime.Time
+	lastSuccess mstime.Time
+}
+
+// isStale returns whether the address is not worth keeping: it was not seen
+// for too long, has a timestamp in the future, or failed too many connection
+// attempts
+func (info *addressInfo) isStale(now mstime.Time) bool {
+	// Never evict an address that is being connected to right now
+	if !info.lastAttempt.IsZero() && now.Sub(info.lastAttempt) < time.Minute {
+		return false
+	}
+
+	if info.netAddress.Timestamp.After(now.Add(maxFutureTimestampOffset)) {
+		return true
+	}
+	if info.netAddress.Timestamp.Before(now.Add(-staleAddressAge)) {
+		return true
+	}
+	return info.hasFailedTooManyAttempts(now)
+}
+
+// hasFailedTooManyAttempts returns whether the connection attempts to the
+// address failed too many times since it was last connected to
+func (info *addressInfo) hasFailedTooManyAttempts(now mstime.Time) bool {
+	if info.lastSuccess.IsZero() && info.attempts >= maxAttemptsWithoutSuccess {
+		return true
+	}
+	if info.lastSuccess.Before(now.Add(-minStaleSuccessAge)) && info.attempts >= maxAttemptsSinceSuccess {
+		return true
+	}
+	return false
+}
+
+// selectionWeight returns the relative probability of selecting the address
+// for an outbound connection. Every failed attempt and a recent attempt make
+// the address less likely to be selected.
+func (info *addressInfo) selectionWeight(now mstime.Time) float64 {
+	weight := 1.0
+	if !info.lastAttempt.IsZero() && now.Sub(info.lastAttempt) < recentAttemptInterval {
+		weight *= 0.01
+	}
+	for i := uint32(0); i < info.attempts && i < maxAttemptsSinceSuccess; i++ {
+		weight /= 1.5
+	}
+	return weight
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/kaspanet/kaspad/util/mstime"
 	"net"
 	"sync"
-	"time"
 
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/pkg/errors"
@@ -19,29 +18,48 @@ import (
 type addressRandomizer interface {
 	RandomAddress(addresses []*appmessage.NetAddress) *appmessage.NetAddress
 	RandomAddresses(addresses []*appmessage.NetAddress, count int) []*appmessage.NetAddress
+	WeightedRandomIndex(weights []float64) int
 }
 
-// addressKey represents a pair of IP and port, the IP is always in V6 representation
+// addressKey represents a pair of host and port
 type addressKey struct {
-	port    uint16
-	address ipv6
+	port uint16
+	host hostKey
+}
+
+// hostKey represents the host of an address: either its IP, always in V6
+// representation, or the public key of its onion service
+type hostKey struct {
+	ip             ipv6
+	onionPublicKey onionPublicKey
 }
 
 type ipv6 [net.IPv6len]byte
 
-func (i ipv6) equal(other ipv6) bool {
-	return i == other
+type onionPublicKey [appmessage.OnionPublicKeySize]byte
+
+func (h hostKey) isOnion() bool {
+	return h.onionPublicKey != onionPublicKey{}
 }
 
 // ErrAddressNotFound is an error returned from some functions when a
 // given address is not found in the address manager
 var ErrAddressNotFound = errors.New("address not found")
 
-// NetAddressKey returns a key of the ip address to use it in maps.
+// NetAddressKey returns a key of the address to use it in maps.
 func netAddressKey(netAddress *appmessage.NetAddress) addressKey {
-	key := addressKey{port: netAddress.Port}
+	return addressKey{port: netAddress.Port, host: netAddressHostKey(netAddress)}
+}
+
+// netAddressHostKey returns a key of the host of the address to use it in maps.
+func netAddressHostKey(netAddress *appmessage.NetAddress) hostKey {
+	var key hostKey
+	if netAddress.IsOnion() {
+		copy(key.onionPublicKey[:], netAddress.OnionPublicKey)
+		return key
+	}
 	// all IPv4 can be represented as IPv6.
-	copy(key.address[:], netAddress.IP.To16())
+	copy(key.ip[:], netAddress.IP.To16())
 	return key
 }
 
@@ -68,7 +86,9 @@ type AddressManager struct {
 
 // New returns a new Kaspa address manager.
 func New(cfg *Config, database database.Database) (*AddressManager, error) {
-	addressStore, err := newAddressStore(database)
+	addressStore, err := newAddressStore(database, func(netAddress *appmessage.NetAddress) string {
+		return groupKey(netAddress, cfg.AcceptUnroutable)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -85,13 +105,32 @@ func New(cfg *Config, database database.Database) (*AddressManager, error) {
 	}, nil
 }
 
-func (am *AddressManager) addAddressNoLock(address *appmessage.NetAddress) error {
+func (am *AddressManager) addAddressNoLock(address *appmessage.NetAddress, source *appmessage.NetAddress) error {
 	if !IsRoutable(address, am.cfg.AcceptUnroutable) {
 		return nil
 	}
+	if am.cfg.OnlyOnion && !address.IsOnion() {
+		return nil
+	}
+	// Onion addresses can only be connected to through a proxy
+	if !am.cfg.ReachOnion && address.IsOnion() {
+		return nil
+	}
 
 	key := netAddressKey(address)
-	return am.store.add(key, address)
+	if info, ok := am.store.get(key); ok {
+		// Refresh the timestamp and services of addresses that are
+		// advertised again
+		if !address.Timestamp.After(info.netAddress.Timestamp) {
+			return nil
+		}
+		info.netAddress.Timestamp = address.Timestamp
+		info.netAddress.Services |= address.Services
+		return am.store.update(key, info)
+	}
+
+	netAddress := *address
+	return am.store.addNew(key, &netAddress, source)
 }
 
 // AddAddress adds address to the address manager
@@ -99,16 +138,18 @@ func (am *AddressManager) AddAddress(address *appmessage.NetAddress) error {
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
-	return am.addAddressNoLock(address)
+	return am.addAddressNoLock(address, address)
 }
 
-// AddAddresses adds addresses to the address manager
+// AddAddresses adds addresses to the address manager. Every address is
+// considered to be its own source, as done for addresses that peers advertise
+// about themselves.
 func (am *AddressManager) AddAddresses(addresses ...*appmessage.NetAddress) error {
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
 	for _, address := range addresses {
-		err := am.addAddressNoLock(address)
+		err := am.addAddressNoLock(address, address)
 		if err != nil {
 			return err
 		}
@@ -116,6 +157,77 @@ func (am *AddressManager) AddAddresses(addresses ...*appmessage.NetAddress) erro
 	return nil
 }
 
+// AddAddressesFromSource adds addresses that were learned from the given
+// source to the address manager. The addresses learned from a single source
+// netgroup can only take up a small part of the address manager.
+func (am *AddressManager) AddAddressesFromSource(source *appmessage.NetAddress, addresses ...*appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	for _, address := range addresses {
+		err := am.addAddressNoLock(address, source)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarkConnectionAttempt records an attempt to connect to the given address
+func (am *AddressManager) MarkConnectionAttempt(address *appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	key := netAddressKey(address)
+	info, ok := am.store.get(key)
+	if !ok {
+		return nil
+	}
+	info.attempts++
+	info.lastAttempt = mstime.Now()
+	return am.store.update(key, info)
+}
+
+// MarkConnectionSuccess records a successful connection to the given address,
+// and moves it to the tried table
+func (am *AddressManager) MarkConnectionSuccess(address *appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	key := netAddressKey(address)
+	info, ok := am.store.get(key)
+	if !ok {
+		return nil
+	}
+	now := mstime.Now()
+	info.attempts = 0
+	info.lastAttempt = now
+	info.lastSuccess = now
+	info.netAddress.Timestamp = now
+	if info.isTried {
+		return am.store.update(key, info)
+	}
+	return am.store.markTried(key)
+}
+
+// MarkConnectionFailure records that the connection attempt to the given address,
+// previously recorded with MarkConnectionAttempt, failed. The address is removed
+// once it failed too many attempts since it was last connected to.
+func (am *AddressManager) MarkConnectionFailure(address *appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	key := netAddressKey(address)
+	info, ok := am.store.get(key)
+	if !ok {
+		return nil
+	}
+	if !info.hasFailedTooManyAttempts(mstime.Now()) {
+		return nil
+	}
+	return am.store.remove(key)
+}
+
 // RemoveAddress removes addresses from the address manager
 func (am *AddressManager) RemoveAddress(address *appmessage.NetAddress) error {
 	am.mutex.Lock()
@@ -141,24 +253,142 @@ func (am *AddressManager) BannedAddresses() []*appmessage.NetAddress {
 	return am.store.getAllBanned()
 }
 
-// notBannedAddressesWithException returns all not banned addresses with excpetion
-func (am *AddressManager) notBannedAddressesWithException(exceptions []*appmessage.NetAddress) []*appmessage.NetAddress {
+// RandomAddress returns a random address that isn't banned and isn't in exceptions
+func (am *AddressManager) RandomAddress(exceptions []*appmessage.NetAddress) *appmessage.NetAddress {
+	addresses := am.RandomAddresses(1, exceptions)
+	if len(addresses) == 0 {
+		return nil
+	}
+	return addresses[0]
+}
+
+// RandomAddresses returns count addresses at random that aren't banned and aren't in exceptions.
+// Addresses are picked from the new and tried tables with equal probability, and addresses that
+// failed connection attempts or were attempted recently are less likely to be picked.
+// Stale addresses in the new table are never picked.
+func (am *AddressManager) RandomAddresses(count int, exceptions []*appmessage.NetAddress) []*appmessage.NetAddress {
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
-	return am.store.getAllNotBannedWithout(exceptions)
+	return am.randomAddressesNoLock(count, exceptions, nil)
 }
 
-// RandomAddress returns a random address that isn't banned and isn't in exceptions
-func (am *AddressManager) RandomAddress(exceptions []*appmessage.NetAddress) *appmessage.NetAddress {
-	validAddresses := am.notBannedAddressesWithException(exceptions)
-	return am.random.RandomAddress(validAddresses)
+// RandomAddressesFromDistinctGroups returns count addresses at random the same way
+// RandomAddresses does, except that no two of the returned addresses share a netgroup,
+// and none of them is in one of occupiedGroups. Local and unroutable addresses are not
+// limited this way, since they are all in the same netgroup.
+func (am *AddressManager) RandomAddressesFromDistinctGroups(count int, exceptions []*appmessage.NetAddress,
+	occupiedGroups map[string]struct{}) []*appmessage.NetAddress {
+
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	excludedGroups := make(map[string]struct{}, len(occupiedGroups)+count)
+	for group := range occupiedGroups {
+		excludedGroups[group] = struct{}{}
+	}
+	return am.randomAddressesNoLock(count, exceptions, excludedGroups)
 }
 
-// RandomAddresses returns count addresses at random that aren't banned and aren't in exceptions
-func (am *AddressManager) RandomAddresses(count int, exceptions []*appmessage.NetAddress) []*appmessage.NetAddress {
-	validAddresses := am.notBannedAddressesWithException(exceptions)
-	return am.random.RandomAddresses(validAddresses, count)
+// randomAddressesNoLock picks the addresses for RandomAddresses and RandomAddressesFromDistinctGroups.
+// If excludedGroups is not nil, addresses in the netgroups it contains are skipped, and the netgroup
+// of every picked address is added to it.
+func (am *AddressManager) randomAddressesNoLock(count int, exceptions []*appmessage.NetAddress,
+	excludedGroups map[string]struct{}) []*appmessage.NetAddress {
+
+	now := mstime.Now()
+	// Onion addresses may have been stored while a proxy was configured
+	newInfos, triedInfos := am.store.getAllNotBannedInfosWithout(exceptions, am.cfg.ReachOnion)
+
+	// Stale addresses are removed from the store when addresses are added.
+	// Until then, they are only skipped.
+	notStaleNewInfos := newInfos[:0]
+	for _, info := range newInfos {
+		if !info.isStale(now) {
+			notStaleNewInfos = append(notStaleNewInfos, info)
+		}
+	}
+	newInfos = notStaleNewInfos
+	tables := [][]*addressInfo{newInfos, triedInfos}
+
+	result := make([]*appmessage.NetAddress, 0, count)
+	for len(result) < count && len(newInfos)+len(triedInfos) > 0 {
+		tableWeights := make([]float64, len(tables))
+		for i, table := range tables {
+			if len(table) > 0 {
+				tableWeights[i] = 1
+			}
+		}
+		tableIndex := am.random.WeightedRandomIndex(tableWeights)
+		table := tables[tableIndex]
+
+		weights := make([]float64, len(table))
+		for i, info := range table {
+			weights[i] = info.selectionWeight(now)
+		}
+		index := am.random.WeightedRandomIndex(weights)
+		netAddress := table[index].netAddress
+
+		// Remove the selected address from its table
+		table[index] = table[len(table)-1]
+		tables[tableIndex] = table[:len(table)-1]
+		newInfos, triedInfos = tables[0], tables[1]
+
+		if excludedGroups != nil && IsRoutable(netAddress, false) {
+			group := am.GroupKey(netAddress)
+			if _, ok := excludedGroups[group]; ok {
+				continue
+			}
+			excludedGroups[group] = struct{}{}
+		}
+		result = append(result, netAddress)
+	}
+	return result
+}
+
+// AnchorAddresses returns the addresses of the outgoing peers that were set
+// as anchors using SetAnchorAddresses, possibly before a restart
+func (am *AddressManager) AnchorAddresses() []*appmessage.NetAddress {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	return am.store.getAnchors()
+}
+
+// SetAnchorAddresses replaces the persisted anchor addresses, which are the
+// outgoing peers that should be reconnected to first after a restart
+func (am *AddressManager) SetAnchorAddresses(addresses []*appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	return am.store.setAnchors(addresses)
+}
+
+// PermanentPeers returns the addresses of the peers that were added using
+// AddPermanentPeer, possibly before a restart
+func (am *AddressManager) PermanentPeers() []string {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	return am.store.getPermanentPeers()
+}
+
+// AddPermanentPeer persists the given address as a peer that should be
+// connected to permanently, including after a restart
+func (am *AddressManager) AddPermanentPeer(address string) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	return am.store.addPermanentPeer(address)
+}
+
+// RemovePermanentPeer removes the given address from the persisted
+// permanent peers. It does nothing if the address isn't one of them.
+func (am *AddressManager) RemovePermanentPeer(address string) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	return am.store.removePermanentPeer(address)
 }
 
 // BestLocalAddress returns the most appropriate local address to use
@@ -167,6 +397,18 @@ func (am *AddressManager) BestLocalAddress(remoteAddress *appmessage.NetAddress)
 	return am.localAddresses.bestLocalAddress(remoteAddress)
 }
 
+// AddLocalAddress adds an address that this node is reachable on, as
+// discovered by the given method, to be advertised to peers.
+func (am *AddressManager) AddLocalAddress(address *appmessage.NetAddress, priority AddressPriority) error {
+	return am.localAddresses.addLocalNetAddress(address, priority)
+}
+
+// RemoveLocalAddress stops advertising an address that this node is no longer
+// reachable on.
+func (am *AddressManager) RemoveLocalAddress(address *appmessage.NetAddress) {
+	am.localAddresses.removeLocalNetAddress(address)
+}
+
 // Ban marks the given address as banned
 func (am *AddressManager) Ban(addressToBan *appmessage.NetAddress) error {
 	am.mutex.Lock()
@@ -176,7 +418,7 @@ func (am *AddressManager) Ban(addressToBan *appmessage.NetAddress) error {
 	keysToDelete := make([]addressKey, 0)
 	for _, address := range am.store.getAllNotBanned() {
 		key := netAddressKey(address)
-		if key.address.equal(keyToBan.address) {
+		if key.host == keyToBan.host {
 			keysToDelete = append(keysToDelete, key)
 		}
 	}
@@ -232,8 +474,7 @@ func (am *AddressManager) unbanIfOldEnough(key addressKey) error {
 		return nil
 	}
 
-	const maxBanTime = 24 * time.Hour
-	if mstime.Since(address.Timestamp) > maxBanTime {
+	if mstime.Since(address.Timestamp) > am.cfg.BanDuration {
 		err := am.store.removeBanned(key)
 		if err != nil {
 			return err
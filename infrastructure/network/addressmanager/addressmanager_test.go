@@ -6,11 +6,15 @@ package addressmanager
 
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/migrations"
 	"github.com/kaspanet/kaspad/util/mstime"
 	"net"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/kaspanet/kaspad/infrastructure/config"
 )
@@ -303,3 +307,484 @@ func TestRestoreAddressManager(t *testing.T) {
 		t.Fatalf("Banned address %s not returned from BannedAddresses()", addressToBan.IP)
 	}
 }
+
+func TestNewAddressesFromSingleSource(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestNewAddressesFromSingleSource")
+	defer teardown()
+
+	// Add more addresses than the new buckets of a single source netgroup can hold
+	source := &appmessage.NetAddress{IP: net.ParseIP("7.7.7.7"), Timestamp: mstime.Now()}
+	addressCount := newBucketsPerSourceGroup*newBucketSize + 1000
+	addresses := make([]*appmessage.NetAddress, addressCount)
+	for i := range addresses {
+		ip := net.IPv4(byte(10+i%200), byte(i/200), 1, 1)
+		addresses[i] = &appmessage.NetAddress{IP: ip, Port: 16111, Timestamp: mstime.Now()}
+	}
+	err := addressManager.AddAddressesFromSource(source, addresses...)
+	if err != nil {
+		t.Fatalf("AddAddressesFromSource() failed: %s", err)
+	}
+
+	usedBuckets := make(map[int]struct{})
+	for _, info := range addressManager.store.newAddresses {
+		usedBuckets[info.bucket] = struct{}{}
+	}
+	if len(usedBuckets) > newBucketsPerSourceGroup {
+		t.Fatalf("Addresses from a single source are spread over %d buckets, "+
+			"which is more than %d", len(usedBuckets), newBucketsPerSourceGroup)
+	}
+	if len(addressManager.Addresses()) > newBucketsPerSourceGroup*newBucketSize {
+		t.Fatalf("Addresses from a single source take up %d entries, "+
+			"which is more than %d", len(addressManager.Addresses()), newBucketsPerSourceGroup*newBucketSize)
+	}
+}
+
+func TestConnectionTracking(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	goodAddress := &appmessage.NetAddress{IP: net.ParseIP("1.2.3.4"), Port: 16111, Timestamp: mstime.Now()}
+	failingAddress := &appmessage.NetAddress{IP: net.ParseIP("5.6.7.8"), Port: 16111, Timestamp: mstime.Now()}
+	err = addressManager.AddAddresses(goodAddress, failingAddress)
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+
+	// A successful connection moves the address to the tried table
+	err = addressManager.MarkConnectionAttempt(goodAddress)
+	if err != nil {
+		t.Fatalf("MarkConnectionAttempt() failed: %s", err)
+	}
+	err = addressManager.MarkConnectionSuccess(goodAddress)
+	if err != nil {
+		t.Fatalf("MarkConnectionSuccess() failed: %s", err)
+	}
+	goodInfo, ok := addressManager.store.triedAddresses[netAddressKey(goodAddress)]
+	if !ok {
+		t.Fatalf("Address %s was not moved to the tried table", goodAddress.IP)
+	}
+	if goodInfo.lastSuccess.IsZero() || goodInfo.attempts != 0 {
+		t.Fatalf("Unexpected connection stats for %s: last success %s, %d attempts",
+			goodAddress.IP, goodInfo.lastSuccess, goodInfo.attempts)
+	}
+
+	// Failed attempts make the address less likely to be selected
+	for i := 0; i < maxAttemptsWithoutSuccess; i++ {
+		err = addressManager.MarkConnectionAttempt(failingAddress)
+		if err != nil {
+			t.Fatalf("MarkConnectionAttempt() failed: %s", err)
+		}
+	}
+	failingInfo, ok := addressManager.store.newAddresses[netAddressKey(failingAddress)]
+	if !ok {
+		t.Fatalf("Address %s is unexpectedly not in the new table", failingAddress.IP)
+	}
+	if failingInfo.attempts != maxAttemptsWithoutSuccess {
+		t.Fatalf("Unexpected amount of attempts. Want: %d, got: %d",
+			maxAttemptsWithoutSuccess, failingInfo.attempts)
+	}
+	if failingInfo.selectionWeight(mstime.Now()) >= goodInfo.selectionWeight(mstime.Now()) {
+		t.Fatalf("The failing address is not less likely to be selected than the good address")
+	}
+
+	// The connection stats and tables are restored after a restart
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	restoredGoodInfo, ok := addressManager.store.triedAddresses[netAddressKey(goodAddress)]
+	if !ok {
+		t.Fatalf("Address %s was not restored to the tried table", goodAddress.IP)
+	}
+	if restoredGoodInfo.lastSuccess.UnixMilliseconds() != goodInfo.lastSuccess.UnixMilliseconds() {
+		t.Fatalf("Unexpected restored last success. Want: %s, got: %s",
+			goodInfo.lastSuccess, restoredGoodInfo.lastSuccess)
+	}
+	failingInfo, ok = addressManager.store.newAddresses[netAddressKey(failingAddress)]
+	if !ok || failingInfo.attempts != maxAttemptsWithoutSuccess {
+		t.Fatalf("The attempts of %s were not restored", failingAddress.IP)
+	}
+
+	// Once the last attempt is not recent, the failing address is stale and
+	// is no longer selected
+	failingInfo.lastAttempt = mstime.Now().Add(-time.Hour)
+	addresses := addressManager.RandomAddresses(2, nil)
+	if len(addresses) != 1 || !addresses[0].IP.Equal(goodAddress.IP) {
+		t.Fatalf("Unexpected addresses returned from RandomAddresses(). Want: [%s], got: %v",
+			goodAddress.IP, addresses)
+	}
+
+	// It is evicted when a new address is added
+	err = addressManager.AddAddresses(appmessage.NewNetAddressIPPort(net.ParseIP("9.10.11.12"), 16111, appmessage.SFNodeNetwork))
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	if _, ok := addressManager.store.get(netAddressKey(failingAddress)); ok {
+		t.Fatalf("Stale address %s was not evicted", failingAddress.IP)
+	}
+
+	// Stale addresses are only removed once per staleRemovalInterval
+	staleAddress := appmessage.NewNetAddressIPPort(net.ParseIP("13.14.15.16"), 16111, appmessage.SFNodeNetwork)
+	staleAddress.Timestamp = mstime.Now().Add(-2 * staleAddressAge)
+	err = addressManager.AddAddresses(staleAddress)
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	err = addressManager.AddAddresses(appmessage.NewNetAddressIPPort(net.ParseIP("17.18.19.20"), 16111, appmessage.SFNodeNetwork))
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	if _, ok := addressManager.store.get(netAddressKey(staleAddress)); !ok {
+		t.Fatalf("Stale address %s was evicted before staleRemovalInterval passed", staleAddress.IP)
+	}
+}
+
+func TestMigrateNotBannedAddresses(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	datadir := t.TempDir()
+	db, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer db.Close()
+
+	// Store an address the way it was stored before the new and tried tables existed
+	notBannedAddressBucket := database.MakeBucket([]byte("not-banned-addresses"))
+	testAddress := &appmessage.NetAddress{IP: net.ParseIP("1.2.3.4"), Port: 16111, Timestamp: mstime.Now()}
+	serializer := &addressStore{}
+	key := netAddressKey(testAddress)
+	err = db.Put(notBannedAddressBucket.Key(serializer.serializeAddressKey(key)),
+		serializer.serializeNetAddress(testAddress))
+	if err != nil {
+		t.Fatalf("Put() failed: %s", err)
+	}
+
+	err = migrations.Migrate(db)
+	if err != nil {
+		t.Fatalf("Migrate() failed: %s", err)
+	}
+	addressManager, err := New(NewConfig(cfg), db)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	info, ok := addressManager.store.newAddresses[key]
+	if !ok {
+		t.Fatalf("Address %s was not migrated to the new table", testAddress.IP)
+	}
+	if !info.netAddress.IP.Equal(testAddress.IP) || info.netAddress.Timestamp != testAddress.Timestamp ||
+		!info.source.IP.Equal(testAddress.IP) {
+		t.Fatalf("Unexpected migrated address. Want: %+v from itself, got: %+v from %s",
+			testAddress, info.netAddress, info.source.IP)
+	}
+}
+
+func TestRandomAddressesFromDistinctGroups(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestRandomAddressesFromDistinctGroups")
+	defer teardown()
+
+	// Add 5 addresses in each of the netgroups 1.1, 2.2, 3.3 and 4.4
+	for group := byte(1); group <= 4; group++ {
+		for i := byte(1); i <= 5; i++ {
+			address := &appmessage.NetAddress{IP: net.IPv4(group, group, i, 1), Port: 16111, Timestamp: mstime.Now()}
+			err := addressManager.AddAddress(address)
+			if err != nil {
+				t.Fatalf("AddAddress() failed: %s", err)
+			}
+		}
+	}
+
+	occupiedGroups := map[string]struct{}{"4.4.0.0": {}}
+	addresses := addressManager.RandomAddressesFromDistinctGroups(10, nil, occupiedGroups)
+	if len(addresses) != 3 {
+		t.Fatalf("Unexpected amount of addresses. Want: %d, got: %d", 3, len(addresses))
+	}
+	groups := make(map[string]struct{})
+	for _, address := range addresses {
+		group := addressManager.GroupKey(address)
+		if _, ok := groups[group]; ok {
+			t.Fatalf("Got more than one address in netgroup %s", group)
+		}
+		if _, ok := occupiedGroups[group]; ok {
+			t.Fatalf("Got an address in the occupied netgroup %s", group)
+		}
+		groups[group] = struct{}{}
+	}
+	if len(occupiedGroups) != 1 {
+		t.Fatalf("The occupied groups were unexpectedly modified")
+	}
+}
+
+func TestAnchorAddresses(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	if len(addressManager.AnchorAddresses()) != 0 {
+		t.Fatalf("Unexpected anchors on a new address manager: %v", addressManager.AnchorAddresses())
+	}
+
+	anchors := []*appmessage.NetAddress{
+		{IP: net.ParseIP("1.2.3.4"), Port: 16111, Timestamp: mstime.Now()},
+		appmessage.NewOnionNetAddress(newTestOnionPublicKey(1), 16113, appmessage.SFNodeNetwork),
+		{IP: net.ParseIP("5.6.7.8"), Port: 16112, Timestamp: mstime.Now()},
+	}
+	err = addressManager.SetAnchorAddresses(anchors)
+	if err != nil {
+		t.Fatalf("SetAnchorAddresses() failed: %s", err)
+	}
+
+	// The anchors are restored after a restart
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	restoredAnchors := addressManager.AnchorAddresses()
+	if len(restoredAnchors) != len(anchors) {
+		t.Fatalf("Unexpected amount of restored anchors. Want: %d, got: %d", len(anchors), len(restoredAnchors))
+	}
+	for i, anchor := range anchors {
+		if restoredAnchors[i].String() != anchor.String() {
+			t.Fatalf("Unexpected restored anchor. Want: %s, got: %s", anchor, restoredAnchors[i])
+		}
+	}
+}
+
+func TestPermanentPeers(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	if len(addressManager.PermanentPeers()) != 0 {
+		t.Fatalf("Unexpected permanent peers on a new address manager: %v", addressManager.PermanentPeers())
+	}
+
+	for _, address := range []string{"1.2.3.4:16111", "peer.example.com:16111", "5.6.7.8:16112"} {
+		err = addressManager.AddPermanentPeer(address)
+		if err != nil {
+			t.Fatalf("AddPermanentPeer() failed: %s", err)
+		}
+	}
+	err = addressManager.RemovePermanentPeer("5.6.7.8:16112")
+	if err != nil {
+		t.Fatalf("RemovePermanentPeer() failed: %s", err)
+	}
+	// Removing a peer that isn't permanent is a no-op
+	err = addressManager.RemovePermanentPeer("9.9.9.9:16111")
+	if err != nil {
+		t.Fatalf("RemovePermanentPeer() failed: %s", err)
+	}
+
+	// The permanent peers are restored after a restart
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	restoredPermanentPeers := addressManager.PermanentPeers()
+	sort.Strings(restoredPermanentPeers)
+	expectedPermanentPeers := []string{"1.2.3.4:16111", "peer.example.com:16111"}
+	if !reflect.DeepEqual(restoredPermanentPeers, expectedPermanentPeers) {
+		t.Fatalf("Unexpected restored permanent peers. Want: %v, got: %v", expectedPermanentPeers, restoredPermanentPeers)
+	}
+}
+
+func TestOnionAddressesRequireProxy(t *testing.T) {
+	onionPublicKey, err := appmessage.OnionHostToPublicKey("duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion")
+	if err != nil {
+		t.Fatalf("OnionHostToPublicKey() failed: %s", err)
+	}
+	onionAddress := appmessage.NewOnionNetAddress(onionPublicKey, 16111, appmessage.SFNodeNetwork)
+	bannedOnionAddress := appmessage.NewOnionNetAddress(newTestOnionPublicKey(1), 16111, appmessage.SFNodeNetwork)
+	ipAddress := appmessage.NewNetAddressIPPort(net.ParseIP("1.2.3.4"), 16111, appmessage.SFNodeNetwork)
+
+	cfg := config.DefaultConfig()
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+
+	// Without a proxy, onion addresses can't be connected to, so they aren't stored
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	err = addressManager.AddAddresses(onionAddress, ipAddress)
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	addresses := addressManager.Addresses()
+	if len(addresses) != 1 || !addresses[0].IP.Equal(ipAddress.IP) {
+		t.Fatalf("Expected only %s to be stored without a proxy, got: %v", ipAddress.IP, addresses)
+	}
+
+	// With a proxy they are stored and handed out
+	cfg.Proxy = "127.0.0.1:9050"
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	err = addressManager.AddAddresses(onionAddress)
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	if len(addressManager.RandomAddresses(2, nil)) != 2 {
+		t.Fatalf("Expected the onion address to be picked when a proxy is configured")
+	}
+	err = addressManager.MarkConnectionSuccess(onionAddress)
+	if err != nil {
+		t.Fatalf("MarkConnectionSuccess() failed: %s", err)
+	}
+	err = addressManager.Ban(bannedOnionAddress)
+	if err != nil {
+		t.Fatalf("Ban() failed: %s", err)
+	}
+
+	// Onion addresses that were stored while a proxy was configured are
+	// not handed out once it isn't
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	cfg.Proxy = ""
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	randomAddresses := addressManager.RandomAddresses(2, nil)
+	if len(randomAddresses) != 1 || !randomAddresses[0].IP.Equal(ipAddress.IP) {
+		t.Fatalf("Expected only %s to be picked without a proxy, got: %v", ipAddress.IP, randomAddresses)
+	}
+
+	// But they are still kept, along with the banned ones
+	info, ok := addressManager.store.get(netAddressKey(onionAddress))
+	if !ok || !info.isTried || info.netAddress.String() != onionAddress.String() {
+		t.Fatalf("Expected %s to be restored into the tried table", onionAddress)
+	}
+	isBanned, err := addressManager.IsBanned(bannedOnionAddress)
+	if err != nil {
+		t.Fatalf("IsBanned() failed: %s", err)
+	}
+	if !isBanned {
+		t.Fatalf("Expected %s to still be banned after a restart", bannedOnionAddress)
+	}
+}
+
+func TestMarkConnectionFailure(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestMarkConnectionFailure")
+	defer teardown()
+
+	failingAddress := &appmessage.NetAddress{IP: net.ParseIP("1.2.3.4"), Port: 16111, Timestamp: mstime.Now()}
+	triedAddress := &appmessage.NetAddress{IP: net.ParseIP("5.6.7.8"), Port: 16111, Timestamp: mstime.Now()}
+	err := addressManager.AddAddresses(failingAddress, triedAddress)
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	err = addressManager.MarkConnectionSuccess(triedAddress)
+	if err != nil {
+		t.Fatalf("MarkConnectionSuccess() failed: %s", err)
+	}
+
+	failConnection := func(address *appmessage.NetAddress) {
+		err := addressManager.MarkConnectionAttempt(address)
+		if err != nil {
+			t.Fatalf("MarkConnectionAttempt() failed: %s", err)
+		}
+		err = addressManager.MarkConnectionFailure(address)
+		if err != nil {
+			t.Fatalf("MarkConnectionFailure() failed: %s", err)
+		}
+	}
+	isKnown := func(address *appmessage.NetAddress) bool {
+		_, ok := addressManager.store.get(netAddressKey(address))
+		return ok
+	}
+
+	// An address we never connected to is removed once it fails
+	// maxAttemptsWithoutSuccess times
+	for i := 0; i < maxAttemptsWithoutSuccess-1; i++ {
+		failConnection(failingAddress)
+	}
+	if !isKnown(failingAddress) {
+		t.Fatalf("Address %s was removed after %d failed attempts", failingAddress.IP, maxAttemptsWithoutSuccess-1)
+	}
+	failConnection(failingAddress)
+	if isKnown(failingAddress) {
+		t.Fatalf("Address %s was not removed after %d failed attempts", failingAddress.IP, maxAttemptsWithoutSuccess)
+	}
+
+	// An address we recently connected to is kept despite failures
+	for i := 0; i < maxAttemptsSinceSuccess; i++ {
+		failConnection(triedAddress)
+	}
+	if !isKnown(triedAddress) {
+		t.Fatalf("Recently connected address %s was removed", triedAddress.IP)
+	}
+
+	// Once it wasn't connected to for minStaleSuccessAge, it is removed as well
+	addressManager.store.triedAddresses[netAddressKey(triedAddress)].lastSuccess =
+		mstime.Now().Add(-2 * minStaleSuccessAge)
+	failConnection(triedAddress)
+	if isKnown(triedAddress) {
+		t.Fatalf("Address %s was not removed after %d failed attempts since it was last connected to",
+			triedAddress.IP, maxAttemptsSinceSuccess)
+	}
+}
@@ -44,3 +44,25 @@ func (amc *AddressRandomize) RandomAddresses(addresses []*appmessage.NetAddress,
 
 	return result
 }
+
+// WeightedRandomIndex returns a random index into weights, where the
+// probability of each index is proportional to its weight. It returns -1 if
+// weights is empty.
+func (amc *AddressRandomize) WeightedRandomIndex(weights []float64) int {
+	totalWeight := 0.0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if len(weights) == 0 {
+		return -1
+	}
+
+	target := amc.random.Float64() * totalWeight
+	for i, weight := range weights {
+		if target < weight {
+			return i
+		}
+		target -= weight
+	}
+	return len(weights) - 1
+}
@@ -2,6 +2,7 @@ package addressmanager
 
 import (
 	"net"
+	"time"
 
 	"github.com/kaspanet/kaspad/infrastructure/config"
 )
@@ -13,6 +14,12 @@ type Config struct {
 	ExternalIPs      []string
 	Listeners        []string
 	Lookup           func(string) ([]net.IP, error)
+	BanDuration      time.Duration
+	OnlyOnion        bool
+
+	// ReachOnion is whether Tor .onion addresses can be connected to,
+	// which requires a proxy
+	ReachOnion bool
 }
 
 // NewConfig returns a new address manager Config.
@@ -23,5 +30,8 @@ func NewConfig(cfg *config.Config) *Config {
 		ExternalIPs:      cfg.ExternalIPs,
 		Listeners:        cfg.Listeners,
 		Lookup:           cfg.Lookup,
+		BanDuration:      cfg.BanDuration,
+		OnlyOnion:        cfg.OnlyOnion,
+		ReachOnion:       cfg.Proxy != "",
 	}
 }
@@ -63,7 +63,10 @@ func newLocalAddressManager(cfg *Config) (*localAddressManager, error) {
 // with the given priority.
 func (lam *localAddressManager) addLocalNetAddress(netAddress *appmessage.NetAddress, priority AddressPriority) error {
 	if !IsRoutable(netAddress, lam.cfg.AcceptUnroutable) {
-		return errors.Errorf("address %s is not routable", netAddress.IP)
+		return errors.Errorf("address %s is not routable", netAddress.Host())
+	}
+	if lam.cfg.OnlyOnion && !netAddress.IsOnion() {
+		return errors.Errorf("address %s is not a .onion address", netAddress.Host())
 	}
 
 	lam.mutex.Lock()
@@ -84,6 +87,14 @@ func (lam *localAddressManager) addLocalNetAddress(netAddress *appmessage.NetAdd
 	return nil
 }
 
+// removeLocalNetAddress removes netAddress from the list of known local addresses
+func (lam *localAddressManager) removeLocalNetAddress(netAddress *appmessage.NetAddress) {
+	lam.mutex.Lock()
+	defer lam.mutex.Unlock()
+
+	delete(lam.localAddresses, netAddressKey(netAddress))
+}
+
 // bestLocalAddress returns the most appropriate local address to use
 // for the given remote address.
 func (lam *localAddressManager) bestLocalAddress(remoteAddress *appmessage.NetAddress) *appmessage.NetAddress {
@@ -231,8 +242,17 @@ func (lam *localAddressManager) initListeners() error {
 }
 
 // hostToNetAddress returns a netaddress given a host address. If
-// the host is not an IP address it will be resolved.
+// the host is a .onion address it will be parsed into its onion service
+// public key, and if it is any other non-IP host name it will be resolved.
 func (lam *localAddressManager) hostToNetAddress(host string, port uint16, services appmessage.ServiceFlag) (*appmessage.NetAddress, error) {
+	if appmessage.IsOnionHost(host) {
+		publicKey, err := appmessage.OnionHostToPublicKey(host)
+		if err != nil {
+			return nil, err
+		}
+		return appmessage.NewOnionNetAddress(publicKey, port, services), nil
+	}
+
 	ip := net.ParseIP(host)
 	if ip == nil {
 		ips, err := lam.lookupFunc(host)
@@ -313,6 +333,9 @@ func reachabilityFrom(localAddress, remoteAddress *appmessage.NetAddress, accept
 	)
 
 	IsRoutable := func(na *appmessage.NetAddress) bool {
+		if na.IsOnion() {
+			return true
+		}
 		if acceptUnroutable {
 			return !IsLocal(na)
 		}
@@ -327,6 +350,18 @@ func reachabilityFrom(localAddress, remoteAddress *appmessage.NetAddress, accept
 		return Unreachable
 	}
 
+	if remoteAddress.IsOnion() {
+		if localAddress.IsOnion() {
+			return Private
+		}
+
+		if IsRoutable(localAddress) && IsIPv4(localAddress) {
+			return Ipv4
+		}
+
+		return Default
+	}
+
 	if IsRFC4380(remoteAddress) {
 		if !IsRoutable(localAddress) {
 			return Default
@@ -5,6 +5,7 @@
 package addressmanager
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/kaspanet/kaspad/app/appmessage"
@@ -206,8 +207,11 @@ func IsValid(na *appmessage.NetAddress) bool {
 
 // IsRoutable returns whether or not the passed address is routable over
 // the public internet. This is true as long as the address is valid and is not
-// in any reserved ranges.
+// in any reserved ranges, or is a Tor .onion address.
 func IsRoutable(na *appmessage.NetAddress, acceptUnroutable bool) bool {
+	if na.IsOnion() {
+		return true
+	}
 	if acceptUnroutable {
 		return !IsLocal(na)
 	}
@@ -220,15 +224,24 @@ func IsRoutable(na *appmessage.NetAddress, acceptUnroutable bool) bool {
 
 // GroupKey returns a string representing the network group an address is part
 // of. This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, the string
-// "local" for a local address, and the string "unroutable" for an unroutable
-// address.
+// "local" for a local address, the string "tor:key" where key is the /4 of the
+// onion address for a Tor address, and the string "unroutable" for an
+// unroutable address.
 func (am *AddressManager) GroupKey(na *appmessage.NetAddress) string {
+	return groupKey(na, am.cfg.AcceptUnroutable)
+}
+
+func groupKey(na *appmessage.NetAddress, acceptUnroutable bool) string {
 	if IsLocal(na) {
 		return "local"
 	}
-	if !IsRoutable(na, am.cfg.AcceptUnroutable) {
+	if !IsRoutable(na, acceptUnroutable) {
 		return "unroutable"
 	}
+	if na.IsOnion() {
+		// group is keyed off the first 4 bits of the actual onion key.
+		return fmt.Sprintf("tor:%d", na.OnionPublicKey[0]>>4)
+	}
 	if IsIPv4(na) {
 		return na.IP.Mask(net.CIDRMask(16, 32)).String()
 	}
@@ -204,3 +204,33 @@ func TestGroupKey(t *testing.T) {
 		}
 	}
 }
+
+// TestOnionGroupKey tests that .onion addresses are routable and grouped
+// by the first 4 bits of their public key.
+func TestOnionGroupKey(t *testing.T) {
+	amgr, teardown := newAddressManagerForTest(t, "TestOnionGroupKey")
+	defer teardown()
+
+	tests := []struct {
+		name         string
+		firstKeyByte byte
+		expected     string
+	}{
+		{name: "lowest group", firstKeyByte: 0x0f, expected: "tor:0"},
+		{name: "middle group", firstKeyByte: 0x1d, expected: "tor:1"},
+		{name: "highest group", firstKeyByte: 0xf0, expected: "tor:15"},
+	}
+
+	for _, test := range tests {
+		publicKey := make([]byte, appmessage.OnionPublicKeySize)
+		publicKey[0] = test.firstKeyByte
+		na := appmessage.NewOnionNetAddress(publicKey, 16111, appmessage.SFNodeNetwork)
+		if !IsRoutable(na, false) {
+			t.Errorf("TestOnionGroupKey (%s): .onion address is unexpectedly unroutable", test.name)
+		}
+		if key := amgr.GroupKey(na); key != test.expected {
+			t.Errorf("TestOnionGroupKey (%s): unexpected group key "+
+				"- got '%s', want '%s'", test.name, key, test.expected)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package addressmanager
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
@@ -8,22 +10,90 @@ import (
 	"net"
 )
 
-var notBannedAddressBucket = database.MakeBucket([]byte("not-banned-addresses"))
+var newAddressBucket = database.MakeBucket([]byte("new-addresses"))
+var triedAddressBucket = database.MakeBucket([]byte("tried-addresses"))
 var bannedAddressBucket = database.MakeBucket([]byte("banned-addresses"))
+var bucketingKeyKey = database.MakeBucket(nil).Key([]byte("address-bucketing-key"))
+var anchorAddressesKey = database.MakeBucket(nil).Key([]byte("anchor-addresses"))
+var permanentPeerBucket = database.MakeBucket([]byte("permanent-peers"))
 
+const (
+	// newBucketCount is the number of buckets in the new table, which holds
+	// addresses we have not connected to yet
+	newBucketCount = 1024
+
+	// newBucketSize is the maximum number of addresses in a new bucket
+	newBucketSize = 64
+
+	// newBucketsPerSourceGroup is the number of new buckets that the
+	// addresses learned from a single netgroup are spread over. This
+	// limits how much of the new table a single netgroup can fill.
+	newBucketsPerSourceGroup = 64
+
+	// triedBucketCount is the number of buckets in the tried table, which
+	// holds addresses we have successfully connected to
+	triedBucketCount = 256
+
+	// triedBucketSize is the maximum number of addresses in a tried bucket
+	triedBucketSize = 64
+
+	// triedBucketsPerGroup is the number of tried buckets that the
+	// addresses of a single netgroup are spread over
+	triedBucketsPerGroup = 8
+
+	bucketingKeySize = 32
+)
+
+// addressStore keeps the known addresses in two tables: the new table for
+// addresses we have not connected to yet, and the tried table for addresses
+// we have. Each table is split into buckets, chosen by hashing the netgroup of
+// the address (and for new addresses, the netgroup of their source) with a
+// secret key. Since the buckets are small, a single netgroup can only ever
+// take up a small part of each table, which makes it hard for an attacker to
+// eclipse this node by flooding it with addresses.
 type addressStore struct {
-	database           database.Database
-	notBannedAddresses map[addressKey]*appmessage.NetAddress
-	bannedAddresses    map[ipv6]*appmessage.NetAddress
+	database     database.Database
+	groupKey     func(netAddress *appmessage.NetAddress) string
+	bucketingKey []byte
+
+	newAddresses    map[addressKey]*addressInfo
+	triedAddresses  map[addressKey]*addressInfo
+	newBuckets      [newBucketCount]map[addressKey]struct{}
+	triedBuckets    [triedBucketCount]map[addressKey]struct{}
+	bannedAddresses map[hostKey]*appmessage.NetAddress
+	anchorAddresses []*appmessage.NetAddress
+	permanentPeers  map[string]struct{}
+
+	lastStaleRemoval mstime.Time
 }
 
-func newAddressStore(database database.Database) (*addressStore, error) {
+func newAddressStore(database database.Database,
+	groupKey func(netAddress *appmessage.NetAddress) string) (*addressStore, error) {
+
 	addressStore := &addressStore{
-		database:           database,
-		notBannedAddresses: map[addressKey]*appmessage.NetAddress{},
-		bannedAddresses:    map[ipv6]*appmessage.NetAddress{},
+		database:        database,
+		groupKey:        groupKey,
+		newAddresses:    map[addressKey]*addressInfo{},
+		triedAddresses:  map[addressKey]*addressInfo{},
+		bannedAddresses: map[hostKey]*appmessage.NetAddress{},
+		permanentPeers:  map[string]struct{}{},
 	}
-	err := addressStore.restoreNotBannedAddresses()
+	for i := range addressStore.newBuckets {
+		addressStore.newBuckets[i] = map[addressKey]struct{}{}
+	}
+	for i := range addressStore.triedBuckets {
+		addressStore.triedBuckets[i] = map[addressKey]struct{}{}
+	}
+
+	err := addressStore.restoreBucketingKey()
+	if err != nil {
+		return nil, err
+	}
+	err = addressStore.restoreAddresses(triedAddressBucket, true)
+	if err != nil {
+		return nil, err
+	}
+	err = addressStore.restoreAddresses(newAddressBucket, false)
 	if err != nil {
 		return nil, err
 	}
@@ -31,15 +101,45 @@ func newAddressStore(database database.Database) (*addressStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = addressStore.restoreAnchorAddresses()
+	if err != nil {
+		return nil, err
+	}
+	err = addressStore.restorePermanentPeers()
+	if err != nil {
+		return nil, err
+	}
 
-	log.Infof("Loaded %d addresses and %d banned addresses",
-		len(addressStore.notBannedAddresses), len(addressStore.bannedAddresses))
+	log.Infof("Loaded %d new addresses, %d tried addresses and %d banned addresses",
+		len(addressStore.newAddresses), len(addressStore.triedAddresses), len(addressStore.bannedAddresses))
 
 	return addressStore, nil
 }
 
-func (as *addressStore) restoreNotBannedAddresses() error {
-	cursor, err := as.database.Cursor(notBannedAddressBucket)
+// restoreBucketingKey loads the secret key used to choose the buckets of
+// addresses, generating it on first use. It must persist across restarts for
+// the restored addresses to remain in their buckets.
+func (as *addressStore) restoreBucketingKey() error {
+	bucketingKey, err := as.database.Get(bucketingKeyKey)
+	if err == nil {
+		as.bucketingKey = bucketingKey
+		return nil
+	}
+	if !database.IsNotFoundError(err) {
+		return err
+	}
+
+	bucketingKey = make([]byte, bucketingKeySize)
+	_, err = rand.Read(bucketingKey)
+	if err != nil {
+		return err
+	}
+	as.bucketingKey = bucketingKey
+	return as.database.Put(bucketingKeyKey, bucketingKey)
+}
+
+func (as *addressStore) restoreAddresses(bucket *database.Bucket, isTried bool) error {
+	cursor, err := as.database.Cursor(bucket)
 	if err != nil {
 		return err
 	}
@@ -52,12 +152,30 @@ func (as *addressStore) restoreNotBannedAddresses() error {
 		serializedKey := databaseKey.Suffix()
 		key := as.deserializeAddressKey(serializedKey)
 
-		serializedNetAddress, err := cursor.Value()
+		serializedAddressInfo, err := cursor.Value()
 		if err != nil {
 			return err
 		}
-		netAddress := as.deserializeNetAddress(serializedNetAddress)
-		as.notBannedAddresses[key] = netAddress
+		info := as.deserializeAddressInfo(serializedAddressInfo)
+		if isTried {
+			info.isTried = true
+			info.bucket = as.triedBucketIndex(info.netAddress)
+			as.triedAddresses[key] = info
+			as.triedBuckets[info.bucket][key] = struct{}{}
+		} else {
+			info.bucket = as.newBucketIndex(info.netAddress, info.source)
+			// Addresses that were migrated from an older database may
+			// overflow their bucket
+			if len(as.newBuckets[info.bucket]) >= newBucketSize {
+				err := as.database.Delete(databaseKey)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			as.newAddresses[key] = info
+			as.newBuckets[info.bucket][key] = struct{}{}
+		}
 	}
 	return nil
 }
@@ -73,69 +191,312 @@ func (as *addressStore) restoreBannedAddresses() error {
 		if err != nil {
 			return err
 		}
-		var ipv6 ipv6
-		copy(ipv6[:], databaseKey.Suffix())
+		host := deserializeHostKey(databaseKey.Suffix())
 
 		serializedNetAddress, err := cursor.Value()
 		if err != nil {
 			return err
 		}
 		netAddress := as.deserializeNetAddress(serializedNetAddress)
-		as.bannedAddresses[ipv6] = netAddress
+		as.bannedAddresses[host] = netAddress
 	}
 	return nil
 }
 
-func (as *addressStore) add(key addressKey, address *appmessage.NetAddress) error {
-	if _, ok := as.notBannedAddresses[key]; ok {
+func (as *addressStore) restoreAnchorAddresses() error {
+	serializedAnchorAddresses, err := as.database.Get(anchorAddressesKey)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	// Each anchor address is prefixed by its serialized size
+	for i := 0; i < len(serializedAnchorAddresses); {
+		size := int(serializedAnchorAddresses[i])
+		i++
+		if (size != serializedNetAddressSize && size != serializedOnionNetAddressSize) ||
+			i+size > len(serializedAnchorAddresses) {
+
+			log.Warnf("Ignoring malformed anchor addresses")
+			as.anchorAddresses = nil
+			return nil
+		}
+		netAddress := as.deserializeNetAddress(serializedAnchorAddresses[i : i+size])
+		as.anchorAddresses = append(as.anchorAddresses, netAddress)
+		i += size
+	}
+	return nil
+}
+
+func (as *addressStore) restorePermanentPeers() error {
+	cursor, err := as.database.Cursor(permanentPeerBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		databaseKey, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+		as.permanentPeers[string(databaseKey.Suffix())] = struct{}{}
+	}
+	return nil
+}
+
+// bucketHash hashes the given data with the bucketing key
+func (as *addressStore) bucketHash(data ...[]byte) uint64 {
+	hasher := sha256.New()
+	hasher.Write(as.bucketingKey)
+	for _, item := range data {
+		hasher.Write(item)
+	}
+	return binary.LittleEndian.Uint64(hasher.Sum(nil))
+}
+
+// newBucketIndex returns the new bucket of an address learned from the given
+// source. The addresses learned from a single source netgroup are spread over
+// newBucketsPerSourceGroup buckets.
+func (as *addressStore) newBucketIndex(netAddress *appmessage.NetAddress, source *appmessage.NetAddress) int {
+	sourceGroup := []byte(as.groupKey(source))
+	groupHash := as.bucketHash([]byte(as.groupKey(netAddress)), sourceGroup) % newBucketsPerSourceGroup
+
+	groupHashBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(groupHashBytes, groupHash)
+	return int(as.bucketHash(sourceGroup, groupHashBytes) % newBucketCount)
+}
+
+// triedBucketIndex returns the tried bucket of an address. The addresses of a
+// single netgroup are spread over triedBucketsPerGroup buckets.
+func (as *addressStore) triedBucketIndex(netAddress *appmessage.NetAddress) int {
+	key := netAddressKey(netAddress)
+	addressHash := as.bucketHash(as.serializeAddressKey(key)) % triedBucketsPerGroup
+
+	addressHashBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(addressHashBytes, addressHash)
+	return int(as.bucketHash([]byte(as.groupKey(netAddress)), addressHashBytes) % triedBucketCount)
+}
+
+// addNew adds an address learned from the given source to the new table,
+// making room for it in its bucket if necessary
+func (as *addressStore) addNew(key addressKey, netAddress *appmessage.NetAddress,
+	source *appmessage.NetAddress) error {
+
+	if _, ok := as.get(key); ok {
 		return nil
 	}
 
-	as.notBannedAddresses[key] = address
+	err := as.removeStaleIfDue()
+	if err != nil {
+		return err
+	}
 
-	databaseKey := as.notBannedDatabaseKey(key)
-	serializedAddress := as.serializeNetAddress(address)
-	return as.database.Put(databaseKey, serializedAddress)
+	return as.insertNew(key, &addressInfo{
+		netAddress: netAddress,
+		source:     source,
+	})
+}
+
+// insertNew inserts the given address info into the new table, making room
+// for it in its bucket if necessary
+func (as *addressStore) insertNew(key addressKey, info *addressInfo) error {
+	info.isTried = false
+	info.bucket = as.newBucketIndex(info.netAddress, info.source)
+	if len(as.newBuckets[info.bucket]) >= newBucketSize {
+		err := as.makeRoomInNewBucket(info.bucket)
+		if err != nil {
+			return err
+		}
+	}
+	return as.insert(key, info)
+}
+
+// makeRoomInNewBucket removes the stale addresses in the given new bucket,
+// or the address that was seen least recently if none are stale
+func (as *addressStore) makeRoomInNewBucket(bucket int) error {
+	now := mstime.Now()
+	var oldestKey addressKey
+	var oldestInfo *addressInfo
+	removedAny := false
+	for key := range as.newBuckets[bucket] {
+		info := as.newAddresses[key]
+		if info.isStale(now) {
+			err := as.remove(key)
+			if err != nil {
+				return err
+			}
+			removedAny = true
+			continue
+		}
+		if oldestInfo == nil || info.netAddress.Timestamp.Before(oldestInfo.netAddress.Timestamp) {
+			oldestKey = key
+			oldestInfo = info
+		}
+	}
+	if removedAny || oldestInfo == nil {
+		return nil
+	}
+	return as.remove(oldestKey)
+}
+
+// markTried moves an address from the new table to the tried table. If its
+// tried bucket is full, the address in it that we connected to least recently
+// is moved back to the new table to make room.
+func (as *addressStore) markTried(key addressKey) error {
+	info, ok := as.newAddresses[key]
+	if !ok {
+		return nil
+	}
+
+	triedBucket := as.triedBucketIndex(info.netAddress)
+	if len(as.triedBuckets[triedBucket]) >= triedBucketSize {
+		var oldestKey addressKey
+		var oldestInfo *addressInfo
+		for triedKey := range as.triedBuckets[triedBucket] {
+			triedInfo := as.triedAddresses[triedKey]
+			if oldestInfo == nil || triedInfo.lastSuccess.Before(oldestInfo.lastSuccess) {
+				oldestKey = triedKey
+				oldestInfo = triedInfo
+			}
+		}
+		err := as.remove(oldestKey)
+		if err != nil {
+			return err
+		}
+		err = as.insertNew(oldestKey, oldestInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := as.remove(key)
+	if err != nil {
+		return err
+	}
+	info.isTried = true
+	info.bucket = triedBucket
+	return as.insert(key, info)
+}
+
+// insert adds the address info to its table and bucket, and persists it
+func (as *addressStore) insert(key addressKey, info *addressInfo) error {
+	if info.isTried {
+		as.triedAddresses[key] = info
+		as.triedBuckets[info.bucket][key] = struct{}{}
+	} else {
+		as.newAddresses[key] = info
+		as.newBuckets[info.bucket][key] = struct{}{}
+	}
+	return as.update(key, info)
+}
+
+// update persists changes to the given address info
+func (as *addressStore) update(key addressKey, info *addressInfo) error {
+	databaseKey := as.addressDatabaseKey(key, info.isTried)
+	return as.database.Put(databaseKey, as.serializeAddressInfo(info))
 }
 
 func (as *addressStore) remove(key addressKey) error {
-	delete(as.notBannedAddresses, key)
+	info, ok := as.get(key)
+	if !ok {
+		return nil
+	}
 
-	databaseKey := as.notBannedDatabaseKey(key)
+	if info.isTried {
+		delete(as.triedAddresses, key)
+		delete(as.triedBuckets[info.bucket], key)
+	} else {
+		delete(as.newAddresses, key)
+		delete(as.newBuckets[info.bucket], key)
+	}
+
+	databaseKey := as.addressDatabaseKey(key, info.isTried)
 	return as.database.Delete(databaseKey)
 }
 
+// removeStaleIfDue runs removeStale if it didn't run for staleRemovalInterval
+func (as *addressStore) removeStaleIfDue() error {
+	now := mstime.Now()
+	if now.Sub(as.lastStaleRemoval) < staleRemovalInterval {
+		return nil
+	}
+	as.lastStaleRemoval = now
+	return as.removeStale()
+}
+
+// removeStale removes the stale addresses from the new table. Addresses in
+// the tried table are only ever moved back to the new table, so that they
+// remain available if the network changes for a while.
+func (as *addressStore) removeStale() error {
+	now := mstime.Now()
+	for key, info := range as.newAddresses {
+		if info.isStale(now) {
+			err := as.remove(key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (as *addressStore) get(key addressKey) (*addressInfo, bool) {
+	if info, ok := as.triedAddresses[key]; ok {
+		return info, true
+	}
+	info, ok := as.newAddresses[key]
+	return info, ok
+}
+
 func (as *addressStore) getAllNotBanned() []*appmessage.NetAddress {
-	addresses := make([]*appmessage.NetAddress, 0, len(as.notBannedAddresses))
-	for _, address := range as.notBannedAddresses {
-		addresses = append(addresses, address)
+	addresses := make([]*appmessage.NetAddress, 0, len(as.newAddresses)+len(as.triedAddresses))
+	for _, info := range as.triedAddresses {
+		addresses = append(addresses, info.netAddress)
+	}
+	for _, info := range as.newAddresses {
+		addresses = append(addresses, info.netAddress)
 	}
 	return addresses
 }
 
-func (as *addressStore) getAllNotBannedWithout(ignoredAddresses []*appmessage.NetAddress) []*appmessage.NetAddress {
+// getAllNotBannedInfosWithout returns the infos of the addresses in the new
+// and tried tables, excluding the ignored addresses, and excluding .onion
+// addresses unless includeOnion is set
+func (as *addressStore) getAllNotBannedInfosWithout(ignoredAddresses []*appmessage.NetAddress, includeOnion bool) (
+	newInfos []*addressInfo, triedInfos []*addressInfo) {
+
 	ignoredKeys := netAddressesKeys(ignoredAddresses)
+	isIncluded := func(key addressKey, info *addressInfo) bool {
+		return !ignoredKeys[key] && (includeOnion || !info.netAddress.IsOnion())
+	}
 
-	addresses := make([]*appmessage.NetAddress, 0, len(as.notBannedAddresses))
-	for key, address := range as.notBannedAddresses {
-		if !ignoredKeys[key] {
-			addresses = append(addresses, address)
+	newInfos = make([]*addressInfo, 0, len(as.newAddresses))
+	for key, info := range as.newAddresses {
+		if isIncluded(key, info) {
+			newInfos = append(newInfos, info)
 		}
 	}
-	return addresses
+	triedInfos = make([]*addressInfo, 0, len(as.triedAddresses))
+	for key, info := range as.triedAddresses {
+		if isIncluded(key, info) {
+			triedInfos = append(triedInfos, info)
+		}
+	}
+	return newInfos, triedInfos
 }
 
 func (as *addressStore) isNotBanned(key addressKey) bool {
-	_, ok := as.notBannedAddresses[key]
+	_, ok := as.get(key)
 	return ok
 }
 
 func (as *addressStore) addBanned(key addressKey, address *appmessage.NetAddress) error {
-	if _, ok := as.bannedAddresses[key.address]; ok {
+	if _, ok := as.bannedAddresses[key.host]; ok {
 		return nil
 	}
 
-	as.bannedAddresses[key.address] = address
+	as.bannedAddresses[key.host] = address
 
 	databaseKey := as.bannedDatabaseKey(key)
 	serializedAddress := as.serializeNetAddress(address)
@@ -143,7 +504,7 @@ func (as *addressStore) addBanned(key addressKey, address *appmessage.NetAddress
 }
 
 func (as *addressStore) removeBanned(key addressKey) error {
-	delete(as.bannedAddresses, key.address)
+	delete(as.bannedAddresses, key.host)
 
 	databaseKey := as.bannedDatabaseKey(key)
 	return as.database.Delete(databaseKey)
@@ -158,70 +519,217 @@ func (as *addressStore) getAllBanned() []*appmessage.NetAddress {
 }
 
 func (as *addressStore) isBanned(key addressKey) bool {
-	_, ok := as.bannedAddresses[key.address]
+	_, ok := as.bannedAddresses[key.host]
 	return ok
 }
 
 func (as *addressStore) getBanned(key addressKey) (*appmessage.NetAddress, bool) {
-	bannedAddress, ok := as.bannedAddresses[key.address]
+	bannedAddress, ok := as.bannedAddresses[key.host]
 	return bannedAddress, ok
 }
 
-func (as *addressStore) notBannedDatabaseKey(key addressKey) *database.Key {
+func (as *addressStore) getAnchors() []*appmessage.NetAddress {
+	anchorAddresses := make([]*appmessage.NetAddress, len(as.anchorAddresses))
+	copy(anchorAddresses, as.anchorAddresses)
+	return anchorAddresses
+}
+
+func (as *addressStore) setAnchors(anchorAddresses []*appmessage.NetAddress) error {
+	as.anchorAddresses = make([]*appmessage.NetAddress, len(anchorAddresses))
+	copy(as.anchorAddresses, anchorAddresses)
+
+	serializedAnchorAddresses := make([]byte, 0, len(anchorAddresses)*(1+serializedOnionNetAddressSize))
+	for _, anchorAddress := range anchorAddresses {
+		serializedAnchorAddress := as.serializeNetAddress(anchorAddress)
+		serializedAnchorAddresses = append(serializedAnchorAddresses, byte(len(serializedAnchorAddress)))
+		serializedAnchorAddresses = append(serializedAnchorAddresses, serializedAnchorAddress...)
+	}
+	return as.database.Put(anchorAddressesKey, serializedAnchorAddresses)
+}
+
+func (as *addressStore) getPermanentPeers() []string {
+	permanentPeers := make([]string, 0, len(as.permanentPeers))
+	for permanentPeer := range as.permanentPeers {
+		permanentPeers = append(permanentPeers, permanentPeer)
+	}
+	return permanentPeers
+}
+
+func (as *addressStore) addPermanentPeer(address string) error {
+	if _, ok := as.permanentPeers[address]; ok {
+		return nil
+	}
+	as.permanentPeers[address] = struct{}{}
+	return as.database.Put(permanentPeerBucket.Key([]byte(address)), []byte{})
+}
+
+func (as *addressStore) removePermanentPeer(address string) error {
+	if _, ok := as.permanentPeers[address]; !ok {
+		return nil
+	}
+	delete(as.permanentPeers, address)
+	return as.database.Delete(permanentPeerBucket.Key([]byte(address)))
+}
+
+func (as *addressStore) addressDatabaseKey(key addressKey, isTried bool) *database.Key {
 	serializedKey := as.serializeAddressKey(key)
-	return notBannedAddressBucket.Key(serializedKey)
+	if isTried {
+		return triedAddressBucket.Key(serializedKey)
+	}
+	return newAddressBucket.Key(serializedKey)
 }
 
 func (as *addressStore) bannedDatabaseKey(key addressKey) *database.Key {
-	return bannedAddressBucket.Key(key.address[:])
+	return bannedAddressBucket.Key(serializeHostKey(key.host))
+}
+
+const (
+	serializedIPHostSize    = net.IPv6len
+	serializedOnionHostSize = appmessage.OnionPublicKeySize
+
+	serializedNetAddressSize      = serializedIPHostSize + 2 + 8 + 8    // ipv6 + port + timestamp + services
+	serializedOnionNetAddressSize = serializedOnionHostSize + 2 + 8 + 8 // onion public key + port + timestamp + services
+
+	// serializedAddressInfoSize is the size of the address info of an IP
+	// address learned from an IP address
+	serializedAddressInfoSize = serializedNetAddressSize + serializedIPHostSize + 4 + 8 + 8 // net address + source ipv6 + attempts + last attempt + last success
+)
+
+// serializeHostKey serializes the IP or the onion public key of the given
+// host. The two are told apart by their size.
+func serializeHostKey(key hostKey) []byte {
+	if key.isOnion() {
+		return append([]byte{}, key.onionPublicKey[:]...)
+	}
+	return append([]byte{}, key.ip[:]...)
+}
+
+func deserializeHostKey(serializedHostKey []byte) hostKey {
+	var key hostKey
+	if len(serializedHostKey) == serializedOnionHostSize {
+		copy(key.onionPublicKey[:], serializedHostKey)
+		return key
+	}
+	copy(key.ip[:], serializedHostKey)
+	return key
+}
+
+// hostKeyToNetAddress returns a NetAddress with only the host of the given
+// key set
+func hostKeyToNetAddress(key hostKey) *appmessage.NetAddress {
+	if key.isOnion() {
+		return &appmessage.NetAddress{OnionPublicKey: append([]byte{}, key.onionPublicKey[:]...)}
+	}
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, key.ip[:])
+	return &appmessage.NetAddress{IP: ip}
 }
 
 func (as *addressStore) serializeAddressKey(key addressKey) []byte {
-	serializedSize := 16 + 2 // ipv6 + port
-	serializedKey := make([]byte, serializedSize)
+	serializedHostKey := serializeHostKey(key.host)
+	serializedKey := make([]byte, len(serializedHostKey)+2) // host + port
 
-	copy(serializedKey[:], key.address[:])
-	binary.LittleEndian.PutUint16(serializedKey[16:], key.port)
+	copy(serializedKey[:], serializedHostKey)
+	binary.LittleEndian.PutUint16(serializedKey[len(serializedHostKey):], key.port)
 
 	return serializedKey
 }
 
 func (as *addressStore) deserializeAddressKey(serializedKey []byte) addressKey {
-	var ip ipv6
-	copy(ip[:], serializedKey[:])
+	hostSize := len(serializedKey) - 2
+	host := deserializeHostKey(serializedKey[:hostSize])
 
-	port := binary.LittleEndian.Uint16(serializedKey[16:])
+	port := binary.LittleEndian.Uint16(serializedKey[hostSize:])
 
 	return addressKey{
-		port:    port,
-		address: ip,
+		port: port,
+		host: host,
 	}
 }
 
 func (as *addressStore) serializeNetAddress(netAddress *appmessage.NetAddress) []byte {
-	serializedSize := 16 + 2 + 8 + 8 // ipv6 + port + timestamp + services
-	serializedNetAddress := make([]byte, serializedSize)
+	serializedHostKey := serializeHostKey(netAddressHostKey(netAddress))
+	hostSize := len(serializedHostKey)
+	serializedNetAddress := make([]byte, hostSize+2+8+8)
 
-	copy(serializedNetAddress[:], netAddress.IP[:])
-	binary.LittleEndian.PutUint16(serializedNetAddress[16:], netAddress.Port)
-	binary.LittleEndian.PutUint64(serializedNetAddress[18:], uint64(netAddress.Timestamp.UnixMilliseconds()))
-	binary.LittleEndian.PutUint64(serializedNetAddress[26:], uint64(netAddress.Services))
+	copy(serializedNetAddress[:], serializedHostKey)
+	binary.LittleEndian.PutUint16(serializedNetAddress[hostSize:], netAddress.Port)
+	binary.LittleEndian.PutUint64(serializedNetAddress[hostSize+2:], uint64(netAddress.Timestamp.UnixMilliseconds()))
+	binary.LittleEndian.PutUint64(serializedNetAddress[hostSize+10:], uint64(netAddress.Services))
 
 	return serializedNetAddress
 }
 
 func (as *addressStore) deserializeNetAddress(serializedNetAddress []byte) *appmessage.NetAddress {
-	ip := make(net.IP, 16)
-	copy(ip[:], serializedNetAddress[:])
+	hostSize := len(serializedNetAddress) - 2 - 8 - 8
+	netAddress := hostKeyToNetAddress(deserializeHostKey(serializedNetAddress[:hostSize]))
+
+	netAddress.Port = binary.LittleEndian.Uint16(serializedNetAddress[hostSize:])
+	netAddress.Timestamp = mstime.UnixMilliseconds(int64(binary.LittleEndian.Uint64(serializedNetAddress[hostSize+2:])))
+	netAddress.Services = appmessage.ServiceFlag(binary.LittleEndian.Uint64(serializedNetAddress[hostSize+10:]))
+
+	return netAddress
+}
+
+// serializeAddressInfo serializes the net address, the source host, the number
+// of attempts and the times of the last attempt and success of the given
+// address info. Its table and bucket are not serialized, since they are
+// derived from the database bucket it's stored in and from its addresses.
+//
+// Address infos of IP addresses learned from IP addresses are of size
+// serializedAddressInfoSize. All others are prefixed by the sizes of their
+// net address and source host, which makes them longer.
+func (as *addressStore) serializeAddressInfo(info *addressInfo) []byte {
+	serializedNetAddress := as.serializeNetAddress(info.netAddress)
+	serializedSource := serializeHostKey(netAddressHostKey(info.source))
+
+	serializedAddressInfo := make([]byte, 0, 2+len(serializedNetAddress)+len(serializedSource)+4+8+8)
+	if info.netAddress.IsOnion() || info.source.IsOnion() {
+		serializedAddressInfo = append(serializedAddressInfo, byte(len(serializedNetAddress)), byte(len(serializedSource)))
+	}
+	serializedAddressInfo = append(serializedAddressInfo, serializedNetAddress...)
+	serializedAddressInfo = append(serializedAddressInfo, serializedSource...)
 
-	port := binary.LittleEndian.Uint16(serializedNetAddress[16:])
-	timestamp := mstime.UnixMilliseconds(int64(binary.LittleEndian.Uint64(serializedNetAddress[18:])))
-	services := appmessage.ServiceFlag(binary.LittleEndian.Uint64(serializedNetAddress[26:]))
+	serializedAttempts := make([]byte, 4+8+8) // attempts + last attempt + last success
+	binary.LittleEndian.PutUint32(serializedAttempts[:], info.attempts)
+	binary.LittleEndian.PutUint64(serializedAttempts[4:], uint64(serializeTime(info.lastAttempt)))
+	binary.LittleEndian.PutUint64(serializedAttempts[12:], uint64(serializeTime(info.lastSuccess)))
+
+	return append(serializedAddressInfo, serializedAttempts...)
+}
+
+func (as *addressStore) deserializeAddressInfo(serializedAddressInfo []byte) *addressInfo {
+	netAddressSize, sourceSize := serializedNetAddressSize, serializedIPHostSize
+	if len(serializedAddressInfo) != serializedAddressInfoSize {
+		netAddressSize, sourceSize = int(serializedAddressInfo[0]), int(serializedAddressInfo[1])
+		serializedAddressInfo = serializedAddressInfo[2:]
+	}
+
+	netAddress := as.deserializeNetAddress(serializedAddressInfo[:netAddressSize])
+	source := hostKeyToNetAddress(deserializeHostKey(serializedAddressInfo[netAddressSize : netAddressSize+sourceSize]))
+	offset := netAddressSize + sourceSize
+
+	return &addressInfo{
+		netAddress:  netAddress,
+		source:      source,
+		attempts:    binary.LittleEndian.Uint32(serializedAddressInfo[offset:]),
+		lastAttempt: deserializeTime(int64(binary.LittleEndian.Uint64(serializedAddressInfo[offset+4:]))),
+		lastSuccess: deserializeTime(int64(binary.LittleEndian.Uint64(serializedAddressInfo[offset+12:]))),
+	}
+}
+
+// serializeTime serializes the given time as milliseconds since the epoch,
+// and the zero time as 0
+func serializeTime(t mstime.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilliseconds()
+}
 
-	return &appmessage.NetAddress{
-		IP:        ip,
-		Port:      port,
-		Timestamp: timestamp,
-		Services:  services,
+func deserializeTime(milliseconds int64) mstime.Time {
+	if milliseconds == 0 {
+		return mstime.Time{}
 	}
+	return mstime.UnixMilliseconds(milliseconds)
 }
@@ -6,6 +6,7 @@ import (
 	"net"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestAddressKeySerialization(t *testing.T) {
@@ -13,33 +14,122 @@ func TestAddressKeySerialization(t *testing.T) {
 	defer teardown()
 	addressStore := addressManager.store
 
-	testAddress := &appmessage.NetAddress{IP: net.ParseIP("2602:100:abcd::102"), Port: 12345}
-	testAddressKey := netAddressKey(testAddress)
+	testAddresses := []*appmessage.NetAddress{
+		{IP: net.ParseIP("2602:100:abcd::102"), Port: 12345},
+		appmessage.NewOnionNetAddress(newTestOnionPublicKey(1), 12345, 0),
+	}
+
+	for _, testAddress := range testAddresses {
+		testAddressKey := netAddressKey(testAddress)
 
-	serializedTestAddressKey := addressStore.serializeAddressKey(testAddressKey)
-	deserializedTestAddressKey := addressStore.deserializeAddressKey(serializedTestAddressKey)
-	if !reflect.DeepEqual(testAddressKey, deserializedTestAddressKey) {
-		t.Fatalf("testAddressKey and deserializedTestAddressKey are not equal\n"+
-			"testAddressKey:%+v\ndeserializedTestAddressKey:%+v", testAddressKey, deserializedTestAddressKey)
+		serializedTestAddressKey := addressStore.serializeAddressKey(testAddressKey)
+		deserializedTestAddressKey := addressStore.deserializeAddressKey(serializedTestAddressKey)
+		if !reflect.DeepEqual(testAddressKey, deserializedTestAddressKey) {
+			t.Fatalf("testAddressKey and deserializedTestAddressKey are not equal\n"+
+				"testAddressKey:%+v\ndeserializedTestAddressKey:%+v", testAddressKey, deserializedTestAddressKey)
+		}
 	}
 }
 
+func newTestOnionPublicKey(seed byte) []byte {
+	publicKey := make([]byte, appmessage.OnionPublicKeySize)
+	for i := range publicKey {
+		publicKey[i] = seed + byte(i)
+	}
+	return publicKey
+}
+
 func TestNetAddressSerialization(t *testing.T) {
 	addressManager, teardown := newAddressManagerForTest(t, "TestNetAddressSerialization")
 	defer teardown()
 	addressStore := addressManager.store
 
-	testAddress := &appmessage.NetAddress{
-		IP:        net.ParseIP("2602:100:abcd::102"),
-		Port:      12345,
-		Timestamp: mstime.Now(),
-		Services:  appmessage.ServiceFlag(6789),
+	testAddresses := []*appmessage.NetAddress{
+		{
+			IP:        net.ParseIP("2602:100:abcd::102"),
+			Port:      12345,
+			Timestamp: mstime.Now(),
+			Services:  appmessage.ServiceFlag(6789),
+		},
+		{
+			OnionPublicKey: newTestOnionPublicKey(1),
+			Port:           12345,
+			Timestamp:      mstime.Now(),
+			Services:       appmessage.ServiceFlag(6789),
+		},
+	}
+
+	for _, testAddress := range testAddresses {
+		serializedTestNetAddress := addressStore.serializeNetAddress(testAddress)
+		deserializedTestNetAddress := addressStore.deserializeNetAddress(serializedTestNetAddress)
+		if !reflect.DeepEqual(testAddress, deserializedTestNetAddress) {
+			t.Fatalf("testAddress and deserializedTestNetAddress are not equal\n"+
+				"testAddress:%+v\ndeserializedTestNetAddress:%+v", testAddress, deserializedTestNetAddress)
+		}
+	}
+}
+
+func TestAddressInfoSerialization(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestAddressInfoSerialization")
+	defer teardown()
+	addressStore := addressManager.store
+
+	testAddressInfos := []*addressInfo{
+		{
+			netAddress: &appmessage.NetAddress{
+				IP:        net.ParseIP("2602:100:abcd::102"),
+				Port:      12345,
+				Timestamp: mstime.Now(),
+				Services:  appmessage.ServiceFlag(6789),
+			},
+			source:      &appmessage.NetAddress{IP: net.ParseIP("2602:200:abcd::102")},
+			attempts:    3,
+			lastAttempt: mstime.Now(),
+			lastSuccess: mstime.Now().Add(-time.Hour),
+		},
+		{
+			netAddress: &appmessage.NetAddress{
+				IP:        net.ParseIP("1.2.3.4"),
+				Port:      16111,
+				Timestamp: mstime.Now(),
+			},
+			source: &appmessage.NetAddress{IP: net.ParseIP("5.6.7.8")},
+		},
+		{
+			netAddress: &appmessage.NetAddress{
+				OnionPublicKey: newTestOnionPublicKey(1),
+				Port:           16111,
+				Timestamp:      mstime.Now(),
+			},
+			source:   &appmessage.NetAddress{IP: net.ParseIP("5.6.7.8")},
+			attempts: 1,
+		},
+		{
+			netAddress: &appmessage.NetAddress{
+				IP:        net.ParseIP("1.2.3.4"),
+				Port:      16111,
+				Timestamp: mstime.Now(),
+			},
+			source:      &appmessage.NetAddress{OnionPublicKey: newTestOnionPublicKey(2)},
+			lastAttempt: mstime.Now(),
+		},
+		{
+			netAddress: &appmessage.NetAddress{
+				OnionPublicKey: newTestOnionPublicKey(1),
+				Port:           16111,
+				Timestamp:      mstime.Now(),
+			},
+			source:      &appmessage.NetAddress{OnionPublicKey: newTestOnionPublicKey(2)},
+			lastSuccess: mstime.Now(),
+		},
 	}
 
-	serializedTestNetAddress := addressStore.serializeNetAddress(testAddress)
-	deserializedTestNetAddress := addressStore.deserializeNetAddress(serializedTestNetAddress)
-	if !reflect.DeepEqual(testAddress, deserializedTestNetAddress) {
-		t.Fatalf("testAddress and deserializedTestNetAddress are not equal\n"+
-			"testAddress:%+v\ndeserializedTestNetAddress:%+v", testAddress, deserializedTestNetAddress)
+	for _, testAddressInfo := range testAddressInfos {
+		serializedAddressInfo := addressStore.serializeAddressInfo(testAddressInfo)
+		deserializedAddressInfo := addressStore.deserializeAddressInfo(serializedAddressInfo)
+		if !reflect.DeepEqual(testAddressInfo, deserializedAddressInfo) {
+			t.Fatalf("testAddressInfo and deserializedAddressInfo are not equal\n"+
+				"testAddressInfo:%+v\ndeserializedAddressInfo:%+v", testAddressInfo, deserializedAddressInfo)
+		}
 	}
 }
@@ -1,7 +1,10 @@
 package connmanager
 
 import (
+	"sort"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -85,14 +88,23 @@ func (c *ConnectionManager) checkRequestedConnections(connSet connectionSet) {
 	}
 }
 
-// AddConnectionRequest adds the given address to list of pending connection requests
-func (c *ConnectionManager) AddConnectionRequest(address string, isPermanent bool) {
+// AddConnectionRequest adds the given address to list of pending connection requests.
+// Permanent requests are persisted, so that they're reconnected to after a restart.
+func (c *ConnectionManager) AddConnectionRequest(address string, isPermanent bool) error {
+	if isPermanent {
+		err := c.addressManager.AddPermanentPeer(address)
+		if err != nil {
+			return err
+		}
+	}
+
 	// spawn goroutine so that caller doesn't wait in case connectionManager is in the midst of handling
 	// connection requests
 	spawn("ConnectionManager.AddConnectionRequest", func() {
 		c.addConnectionRequest(address, isPermanent)
 		c.run()
 	})
+	return nil
 }
 
 func (c *ConnectionManager) addConnectionRequest(address string, isPermanent bool) {
@@ -108,8 +120,72 @@ func (c *ConnectionManager) addConnectionRequest(address string, isPermanent boo
 	}
 }
 
+// ErrConnectionRequestNotFound is the error returned when trying to remove
+// a connection request that doesn't exist
+var ErrConnectionRequestNotFound = errors.New("ErrConnectionRequestNotFound")
+
 // RemoveConnection disconnects the connection for the given address
-// and removes it entirely from the connection manager.
-func (c *ConnectionManager) RemoveConnection(address string) {
-	panic("unimplemented")
+// and removes it entirely from the connection manager, including from
+// the persisted permanent peers.
+func (c *ConnectionManager) RemoveConnection(address string) error {
+	err := c.addressManager.RemovePermanentPeer(address)
+	if err != nil {
+		return err
+	}
+
+	c.connectionRequestsLock.Lock()
+	_, isActive := c.activeRequested[address]
+	_, isPending := c.pendingRequested[address]
+	delete(c.activeRequested, address)
+	delete(c.pendingRequested, address)
+	c.connectionRequestsLock.Unlock()
+
+	if !isActive && !isPending {
+		return errors.Wrapf(ErrConnectionRequestNotFound, "No connection request for %s", address)
+	}
+
+	for _, connection := range c.netAdapter.P2PConnections() {
+		if connection.Address() == address {
+			connection.Disconnect()
+		}
+	}
+	return nil
+}
+
+// ConnectionRequestInfo describes a single connection request
+type ConnectionRequestInfo struct {
+	Address     string
+	IsPermanent bool
+	IsConnected bool
+
+	// NextAttempt is when a pending permanent request will be retried. It's
+	// zero for connected requests and for requests that are yet to be tried.
+	NextAttempt time.Time
+}
+
+// ConnectionRequests returns all the connection requests, both the connected
+// ones and the ones that are pending, sorted by address
+func (c *ConnectionManager) ConnectionRequests() []*ConnectionRequestInfo {
+	c.connectionRequestsLock.RLock()
+	defer c.connectionRequestsLock.RUnlock()
+
+	connectionRequests := make([]*ConnectionRequestInfo, 0, len(c.activeRequested)+len(c.pendingRequested))
+	for address, connReq := range c.activeRequested {
+		connectionRequests = append(connectionRequests, &ConnectionRequestInfo{
+			Address:     address,
+			IsPermanent: connReq.isPermanent,
+			IsConnected: true,
+		})
+	}
+	for address, connReq := range c.pendingRequested {
+		connectionRequests = append(connectionRequests, &ConnectionRequestInfo{
+			Address:     address,
+			IsPermanent: connReq.isPermanent,
+			NextAttempt: connReq.nextAttempt,
+		})
+	}
+	sort.Slice(connectionRequests, func(i, j int) bool {
+		return connectionRequests[i].Address < connectionRequests[j].Address
+	})
+	return connectionRequests
 }
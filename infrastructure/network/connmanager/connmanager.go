@@ -1,6 +1,7 @@
 package connmanager
 
 import (
+	"crypto/rand"
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/pkg/errors"
 	"net"
@@ -32,11 +33,22 @@ type ConnectionManager struct {
 
 	activeRequested  map[string]*connectionRequest
 	pendingRequested map[string]*connectionRequest
-	activeOutgoing   map[string]struct{}
+	activeOutgoing   map[string]*outgoingConnection
 	targetOutgoing   int
 	activeIncoming   map[string]struct{}
 	maxIncoming      int
 
+	// pendingAnchors are the anchors of the previous run, to be connected
+	// to before any other outgoing connection. anchors are the currently
+	// persisted anchors.
+	pendingAnchors       []*appmessage.NetAddress
+	anchors              []*appmessage.NetAddress
+	lastOutgoingRotation time.Time
+
+	peerEvictionInfoProvider PeerEvictionInfoProvider
+	incomingConnectionChan   chan struct{}
+	evictionKey              []byte
+
 	stop                   uint32
 	connectionRequestsLock sync.RWMutex
 
@@ -52,13 +64,23 @@ func New(cfg *config.Config, netAdapter *netadapter.NetAdapter, addressManager *
 		addressManager:   addressManager,
 		activeRequested:  map[string]*connectionRequest{},
 		pendingRequested: map[string]*connectionRequest{},
-		activeOutgoing:   map[string]struct{}{},
+		activeOutgoing:   map[string]*outgoingConnection{},
 		activeIncoming:   map[string]struct{}{},
 		resetLoopChan:    make(chan struct{}),
 		loopTicker:       time.NewTicker(connectionsLoopInterval),
+
+		incomingConnectionChan: make(chan struct{}, 1),
+		evictionKey:            make([]byte, evictionKeySize),
+	}
+
+	_, err := rand.Read(c.evictionKey)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	connectPeers := cfg.AddPeers
+	// Permanent peers that were added over RPC are only reconnected to
+	// when the node isn't restricted to --connect peers
+	connectPeers := append(append([]string{}, cfg.AddPeers...), addressManager.PermanentPeers()...)
 	if len(cfg.ConnectPeers) > 0 {
 		connectPeers = cfg.ConnectPeers
 	}
@@ -66,6 +88,10 @@ func New(cfg *config.Config, netAdapter *netadapter.NetAdapter, addressManager *
 	c.maxIncoming = cfg.MaxInboundPeers
 	c.targetOutgoing = cfg.TargetOutboundPeers
 
+	c.anchors = addressManager.AnchorAddresses()
+	c.pendingAnchors = c.anchors
+	c.lastOutgoingRotation = time.Now()
+
 	for _, connectPeer := range connectPeers {
 		c.pendingRequested[connectPeer] = &connectionRequest{
 			address:     connectPeer,
@@ -103,6 +129,10 @@ func (c *ConnectionManager) initiateConnection(address string) error {
 
 const connectionsLoopInterval = 30 * time.Second
 
+// evictionKeySize is the size of the secret key used to choose which netgroups
+// are protected from eviction
+const evictionKeySize = 32
+
 func (c *ConnectionManager) connectionsLoop() {
 	for atomic.LoadUint32(&c.stop) == 0 {
 		connections := c.netAdapter.P2PConnections()
@@ -128,6 +158,22 @@ func (c *ConnectionManager) ConnectionCount() int {
 	return c.netAdapter.P2PConnectionCount()
 }
 
+// ErrPeerNotFound is the error returned when trying to disconnect a peer
+// that isn't connected
+var ErrPeerNotFound = errors.New("ErrPeerNotFound")
+
+// Disconnect disconnects the connected peer with the given address. If the
+// peer is a permanent connection request, it will be reconnected to later.
+func (c *ConnectionManager) Disconnect(address string) error {
+	for _, connection := range c.netAdapter.P2PConnections() {
+		if connection.Address() == address {
+			connection.Disconnect()
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrPeerNotFound, "Peer %s is not connected", address)
+}
+
 // ErrCannotBanPermanent is the error returned when trying to ban a permanent peer.
 var ErrCannotBanPermanent = errors.New("ErrCannotBanPermanent")
 
@@ -161,6 +207,18 @@ func (c *ConnectionManager) BanByIP(ip net.IP) error {
 	return c.addressManager.Ban(appmessage.NewNetAddressIPPort(ip, 0, 0))
 }
 
+// IsWhitelisted returns whether the given netConnection is from a whitelisted
+// IP, and therefore exempt from banning due to misbehavior
+func (c *ConnectionManager) IsWhitelisted(netConnection *netadapter.NetConnection) bool {
+	ip := netConnection.NetAddress().IP
+	for _, whitelist := range c.cfg.Whitelists {
+		if whitelist.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsBanned returns whether the given netConnection is banned
 func (c *ConnectionManager) IsBanned(netConnection *netadapter.NetConnection) (bool, error) {
 	if c.isPermanent(netConnection.Address()) {
@@ -174,6 +232,7 @@ func (c *ConnectionManager) waitTillNextIteration() {
 	select {
 	case <-c.resetLoopChan:
 		c.loopTicker.Reset(connectionsLoopInterval)
+	case <-c.incomingConnectionChan:
 	case <-c.loopTicker.C:
 	}
 }
@@ -240,6 +299,12 @@ func (c *ConnectionManager) extractAddressIPs(address string) ([]net.IP, error)
 		return nil, err
 	}
 
+	// .onion hosts have no IP addresses, and resolving them would leak them
+	// to the system resolver
+	if appmessage.IsOnionHost(host) {
+		return nil, nil
+	}
+
 	ip := net.ParseIP(host)
 	if ip == nil {
 		return c.cfg.Lookup(host)
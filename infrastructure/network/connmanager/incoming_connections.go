@@ -1,24 +1,250 @@
 package connmanager
 
-// checkIncomingConnections makes sure there's no more than maxIncoming incoming connections
-// if there are - it randomly disconnects enough to go below that number
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
+)
+
+const (
+	// protectedByNetgroupCount is the number of incoming connections from distinct
+	// netgroups that are protected from eviction. The netgroups are chosen by a
+	// secret key, so that an attacker can't tell which netgroups are protected.
+	protectedByNetgroupCount = 4
+
+	// protectedByPingCount is the number of incoming connections with the lowest
+	// ping that are protected from eviction
+	protectedByPingCount = 8
+
+	// protectedByTransactionsCount is the number of incoming connections that most
+	// recently relayed a transaction that are protected from eviction
+	protectedByTransactionsCount = 4
+
+	// protectedByBlocksCount is the number of incoming connections that most
+	// recently relayed a block that are protected from eviction
+	protectedByBlocksCount = 4
+)
+
+// PeerEvictionInfo is what's known about how useful a peer is, which
+// determines whether its incoming connection is protected from eviction
+type PeerEvictionInfo struct {
+	ConnectedAt         time.Time
+	LastPingDuration    time.Duration
+	LastBlockTime       time.Time
+	LastTransactionTime time.Time
+}
+
+// PeerEvictionInfoProvider returns the eviction info of all the ready peers,
+// by the address of their connection
+type PeerEvictionInfoProvider func() map[string]*PeerEvictionInfo
+
+// SetPeerEvictionInfoProvider sets the function that provides the info used to
+// choose which incoming connections to evict
+func (c *ConnectionManager) SetPeerEvictionInfoProvider(peerEvictionInfoProvider PeerEvictionInfoProvider) {
+	c.peerEvictionInfoProvider = peerEvictionInfoProvider
+}
+
+// NotifyIncomingConnection makes the connection manager check the incoming
+// connections right away rather than in its next iteration, so that a
+// connection is evicted as soon as a new one takes us over maxIncoming
+func (c *ConnectionManager) NotifyIncomingConnection() {
+	select {
+	case c.incomingConnectionChan <- struct{}{}:
+	default:
+	}
+}
+
+// evictionCandidate is an incoming connection that may be evicted
+type evictionCandidate struct {
+	connection   *netadapter.NetConnection
+	group        string
+	groupHash    uint64
+	evictionInfo *PeerEvictionInfo
+}
+
+// checkIncomingConnections makes sure there's no more than maxIncoming incoming connections.
+// If there are, it evicts connections until there are maxIncoming left.
 func (c *ConnectionManager) checkIncomingConnections(incomingConnectionSet connectionSet) {
 	if len(incomingConnectionSet) <= c.maxIncoming {
 		return
 	}
 
 	numConnectionsOverMax := len(incomingConnectionSet) - c.maxIncoming
-	log.Debugf("Got %d incoming connections while only %d are allowed. Disconnecting "+
+	log.Debugf("Got %d incoming connections while only %d are allowed. Evicting "+
 		"%d", len(incomingConnectionSet), c.maxIncoming, numConnectionsOverMax)
 
-	// randomly disconnect nodes until the number of incoming connections is smaller than maxIncoming
-	for _, connection := range incomingConnectionSet {
-		log.Debugf("Disconnecting %s due to exceeding incoming connections", connection)
+	candidates := c.evictionCandidates(incomingConnectionSet)
+	for ; numConnectionsOverMax > 0; numConnectionsOverMax-- {
+		index, ok := selectConnectionToEvict(candidates)
+		if !ok {
+			log.Warnf("Couldn't evict any more incoming connections, since they are all whitelisted")
+			return
+		}
+
+		connection := candidates[index].connection
+		log.Debugf("Evicting %s due to exceeding incoming connections", connection)
 		connection.Disconnect()
 
-		numConnectionsOverMax--
-		if numConnectionsOverMax == 0 {
-			break
+		candidates[index] = candidates[len(candidates)-1]
+		candidates = candidates[:len(candidates)-1]
+	}
+}
+
+// evictionCandidates returns all the incoming connections that are not whitelisted
+func (c *ConnectionManager) evictionCandidates(incomingConnectionSet connectionSet) []*evictionCandidate {
+	var peerEvictionInfos map[string]*PeerEvictionInfo
+	if c.peerEvictionInfoProvider != nil {
+		peerEvictionInfos = c.peerEvictionInfoProvider()
+	}
+
+	candidates := make([]*evictionCandidate, 0, len(incomingConnectionSet))
+	for address, connection := range incomingConnectionSet {
+		if c.IsWhitelisted(connection) {
+			continue
 		}
+
+		// Connections that haven't completed the handshake yet are
+		// treated as the newest connections, with nothing relayed
+		evictionInfo, ok := peerEvictionInfos[address]
+		if !ok {
+			evictionInfo = &PeerEvictionInfo{ConnectedAt: time.Now()}
+		}
+
+		group := c.addressManager.GroupKey(connection.NetAddress())
+		candidates = append(candidates, &evictionCandidate{
+			connection:   connection,
+			group:        group,
+			groupHash:    c.evictionGroupHash(group),
+			evictionInfo: evictionInfo,
+		})
+	}
+	return candidates
+}
+
+// evictionGroupHash hashes the given netgroup with the secret eviction key
+func (c *ConnectionManager) evictionGroupHash(group string) uint64 {
+	hasher := sha256.New()
+	hasher.Write(c.evictionKey)
+	hasher.Write([]byte(group))
+	return binary.LittleEndian.Uint64(hasher.Sum(nil))
+}
+
+// selectConnectionToEvict returns the index of the candidate that should be evicted.
+// It first protects the connections that are hard for an attacker to imitate: the
+// ones from a few distinct netgroups, the ones with the lowest ping, the ones that
+// recently relayed transactions and blocks, and then half of the rest, which have
+// been connected the longest. The newest connection in the netgroup with the most
+// remaining connections is then evicted. If all the candidates are protected, the
+// newest candidate is evicted.
+func selectConnectionToEvict(candidates []*evictionCandidate) (int, bool) {
+	if len(candidates) == 0 {
+		return 0, false
+	}
+
+	remaining := allIndexes(candidates)
+	remaining = protectDistinctGroups(candidates, remaining, protectedByNetgroupCount)
+
+	remaining = protect(candidates, remaining, protectedByPingCount,
+		func(info *PeerEvictionInfo) bool { return info.LastPingDuration != 0 },
+		func(info, other *PeerEvictionInfo) bool { return info.LastPingDuration < other.LastPingDuration })
+
+	remaining = protect(candidates, remaining, protectedByTransactionsCount,
+		func(info *PeerEvictionInfo) bool { return !info.LastTransactionTime.IsZero() },
+		func(info, other *PeerEvictionInfo) bool {
+			return info.LastTransactionTime.After(other.LastTransactionTime)
+		})
+
+	remaining = protect(candidates, remaining, protectedByBlocksCount,
+		func(info *PeerEvictionInfo) bool { return !info.LastBlockTime.IsZero() },
+		func(info, other *PeerEvictionInfo) bool { return info.LastBlockTime.After(other.LastBlockTime) })
+
+	remaining = protect(candidates, remaining, len(remaining)/2,
+		func(info *PeerEvictionInfo) bool { return true },
+		func(info, other *PeerEvictionInfo) bool { return info.ConnectedAt.Before(other.ConnectedAt) })
+
+	if len(remaining) == 0 {
+		return newestCandidate(candidates, allIndexes(candidates)), true
+	}
+
+	// Find the netgroup with the most remaining connections. Ties are broken in
+	// favor of the netgroup with the newest connection.
+	groups := make(map[string][]int)
+	for _, index := range remaining {
+		groups[candidates[index].group] = append(groups[candidates[index].group], index)
+	}
+	var largestGroup []int
+	for _, group := range groups {
+		if len(group) > len(largestGroup) {
+			largestGroup = group
+			continue
+		}
+		if len(group) == len(largestGroup) &&
+			candidates[newestCandidate(candidates, group)].evictionInfo.ConnectedAt.After(
+				candidates[newestCandidate(candidates, largestGroup)].evictionInfo.ConnectedAt) {
+			largestGroup = group
+		}
+	}
+	return newestCandidate(candidates, largestGroup), true
+}
+
+// protect removes from remaining up to count candidates that are eligible for
+// protection, preferring the ones that are better by isBetter
+func protect(candidates []*evictionCandidate, remaining []int, count int,
+	isEligible func(info *PeerEvictionInfo) bool, isBetter func(info, other *PeerEvictionInfo) bool) []int {
+
+	sort.SliceStable(remaining, func(i, j int) bool {
+		info, other := candidates[remaining[i]].evictionInfo, candidates[remaining[j]].evictionInfo
+		if isEligible(info) != isEligible(other) {
+			return isEligible(info)
+		}
+		return isBetter(info, other)
+	})
+
+	protectedCount := 0
+	for protectedCount < count && protectedCount < len(remaining) &&
+		isEligible(candidates[remaining[protectedCount]].evictionInfo) {
+		protectedCount++
+	}
+	return remaining[protectedCount:]
+}
+
+// protectDistinctGroups removes from remaining one candidate from each of up to
+// count netgroups, choosing the netgroups with the highest keyed hash
+func protectDistinctGroups(candidates []*evictionCandidate, remaining []int, count int) []int {
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return candidates[remaining[i]].groupHash > candidates[remaining[j]].groupHash
+	})
+
+	protectedGroups := make(map[string]struct{}, count)
+	unprotected := make([]int, 0, len(remaining))
+	for _, index := range remaining {
+		group := candidates[index].group
+		if _, ok := protectedGroups[group]; !ok && len(protectedGroups) < count {
+			protectedGroups[group] = struct{}{}
+			continue
+		}
+		unprotected = append(unprotected, index)
+	}
+	return unprotected
+}
+
+func newestCandidate(candidates []*evictionCandidate, indexes []int) int {
+	newest := indexes[0]
+	for _, index := range indexes[1:] {
+		if candidates[index].evictionInfo.ConnectedAt.After(candidates[newest].evictionInfo.ConnectedAt) {
+			newest = index
+		}
+	}
+	return newest
+}
+
+func allIndexes(candidates []*evictionCandidate) []int {
+	indexes := make([]int, len(candidates))
+	for i := range indexes {
+		indexes[i] = i
 	}
+	return indexes
 }
@@ -0,0 +1,72 @@
+package connmanager
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSelectConnectionToEvict(t *testing.T) {
+	now := time.Now()
+	var candidates []*evictionCandidate
+	addCandidate := func(group string, groupHash uint64, evictionInfo *PeerEvictionInfo) int {
+		candidates = append(candidates, &evictionCandidate{
+			group:        group,
+			groupHash:    groupHash,
+			evictionInfo: evictionInfo,
+		})
+		return len(candidates) - 1
+	}
+
+	// Candidates in the protected netgroups
+	for i := 0; i < protectedByNetgroupCount; i++ {
+		addCandidate(fmt.Sprintf("protected-%d", i), 1000, &PeerEvictionInfo{ConnectedAt: now})
+	}
+	// Candidates with a low ping
+	for i := 0; i < protectedByPingCount; i++ {
+		addCandidate("ping", 0, &PeerEvictionInfo{ConnectedAt: now, LastPingDuration: time.Millisecond})
+	}
+	// Candidates that relayed transactions and blocks
+	for i := 0; i < protectedByTransactionsCount; i++ {
+		addCandidate("transactions", 0, &PeerEvictionInfo{ConnectedAt: now, LastTransactionTime: now})
+	}
+	addCandidate("blocks", 0, &PeerEvictionInfo{ConnectedAt: now, LastBlockTime: now})
+
+	// Long lived candidates, half of which are protected by their uptime
+	for i := 0; i < 4; i++ {
+		addCandidate(fmt.Sprintf("old-%d", i), 0, &PeerEvictionInfo{ConnectedAt: now.Add(-time.Hour)})
+	}
+	// An attacker that made many connections from a single netgroup
+	var attackerCandidates []int
+	for i := 0; i < 3; i++ {
+		attackerCandidates = append(attackerCandidates, addCandidate("attacker", 0,
+			&PeerEvictionInfo{ConnectedAt: now.Add(time.Duration(i) * time.Second)}))
+	}
+	newestAttackerCandidate := attackerCandidates[len(attackerCandidates)-1]
+
+	evicted, ok := selectConnectionToEvict(candidates)
+	if !ok {
+		t.Fatalf("selectConnectionToEvict() didn't select a candidate")
+	}
+	if evicted != newestAttackerCandidate {
+		t.Fatalf("Expected the newest connection in the largest netgroup to be evicted. "+
+			"Want: %d, got: %d", newestAttackerCandidate, evicted)
+	}
+
+	// When all the candidates are protected, the newest one is evicted
+	candidates = nil
+	addCandidate("old", 0, &PeerEvictionInfo{ConnectedAt: now.Add(-time.Hour)})
+	blockRelayer := addCandidate("blocks", 0, &PeerEvictionInfo{ConnectedAt: now, LastBlockTime: now})
+	evicted, ok = selectConnectionToEvict(candidates)
+	if !ok {
+		t.Fatalf("selectConnectionToEvict() didn't select a candidate")
+	}
+	if evicted != blockRelayer {
+		t.Fatalf("Expected the newest connection to be evicted. Want: %d, got: %d", blockRelayer, evicted)
+	}
+
+	_, ok = selectConnectionToEvict(nil)
+	if ok {
+		t.Fatalf("selectConnectionToEvict() unexpectedly selected a candidate out of no candidates")
+	}
+}
@@ -1,9 +1,39 @@
 package connmanager
 
-import "github.com/kaspanet/kaspad/app/appmessage"
+import (
+	"math/rand"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+	"github.com/pkg/errors"
+)
+
+const (
+	// anchorCount is the number of the longest lived outgoing connections that
+	// are persisted as anchors, and reconnected to first after a restart
+	anchorCount = 2
+
+	// outgoingRotationInterval is how often a random outgoing connection, other
+	// than the anchors, is dropped to make room for a newly selected peer. This
+	// way, a set of peers that happened to take up all our outgoing slots can't
+	// keep them forever.
+	outgoingRotationInterval = 30 * time.Minute
+)
+
+// outgoingConnection is an active connection initiated by the connection manager
+// to an address selected by the address manager
+type outgoingConnection struct {
+	netAddress  *appmessage.NetAddress
+	group       string
+	connectedAt time.Time
+}
 
 // checkOutgoingConnections goes over all activeOutgoing and makes sure they are still active.
-// Then it opens connections so that we have targetOutgoing active connections
+// Then it opens connections so that we have targetOutgoing active connections, with no two
+// of them in the same netgroup
 func (c *ConnectionManager) checkOutgoingConnections(connSet connectionSet) {
 	for address := range c.activeOutgoing {
 		connection, ok := connSet.get(address)
@@ -16,36 +46,237 @@ func (c *ConnectionManager) checkOutgoingConnections(connSet connectionSet) {
 		delete(c.activeOutgoing, address)
 	}
 
-	connections := c.netAdapter.P2PConnections()
-	connectedAddresses := make([]*appmessage.NetAddress, len(connections))
-	for i, connection := range connections {
-		connectedAddresses[i] = connection.NetAddress()
+	c.rotateOutgoingConnections()
+
+	// Anchors are only reconnected to once, before any other outgoing connection is made
+	if c.pendingAnchors != nil {
+		c.connectToAnchors()
 	}
 
 	liveConnections := len(c.activeOutgoing)
-	if c.targetOutgoing == liveConnections {
-		return
+	if c.targetOutgoing > liveConnections {
+		log.Debugf("Have got %d outgoing connections out of target %d, adding %d more",
+			liveConnections, c.targetOutgoing, c.targetOutgoing-liveConnections)
+
+		connections := c.netAdapter.P2PConnections()
+		connectedAddresses := make([]*appmessage.NetAddress, len(connections))
+		for i, connection := range connections {
+			connectedAddresses[i] = connection.NetAddress()
+		}
+
+		connectionsNeededCount := c.targetOutgoing - len(c.activeOutgoing)
+		netAddresses := c.addressManager.RandomAddressesFromDistinctGroups(
+			connectionsNeededCount, connectedAddresses, c.outgoingGroups())
+		c.connectToAddresses(netAddresses)
 	}
 
-	log.Debugf("Have got %d outgoing connections out of target %d, adding %d more",
-		liveConnections, c.targetOutgoing, c.targetOutgoing-liveConnections)
+	c.updateAnchors()
+}
 
-	connectionsNeededCount := c.targetOutgoing - len(c.activeOutgoing)
-	netAddresses := c.addressManager.RandomAddresses(connectionsNeededCount, connectedAddresses)
+// connectToAnchors connects to the anchors of the previous run that weren't banned since
+func (c *ConnectionManager) connectToAnchors() {
+	anchors := make([]*appmessage.NetAddress, 0, len(c.pendingAnchors))
+	for _, anchor := range c.pendingAnchors {
+		if c.isAnchorBanned(anchor) {
+			continue
+		}
+		anchors = append(anchors, anchor)
+	}
+	c.pendingAnchors = nil
+
+	log.Debugf("Connecting to %d anchors", len(anchors))
+	c.connectToAddresses(anchors)
+}
 
+// isAnchorBanned returns whether the given anchor was banned since it was
+// persisted. Anchors whose ban status can't be checked are treated as banned.
+func (c *ConnectionManager) isAnchorBanned(anchor *appmessage.NetAddress) bool {
+	isBanned, err := c.addressManager.IsBanned(anchor)
+	if err != nil && !errors.Is(err, addressmanager.ErrAddressNotFound) {
+		log.Warnf("Couldn't check whether anchor %s is banned: %s", anchor, err)
+		return true
+	}
+	return isBanned
+}
+
+// connectToAddresses connects to the given addresses, as long as there are less than
+// targetOutgoing outgoing connections and no outgoing connection is in the same netgroup
+func (c *ConnectionManager) connectToAddresses(netAddresses []*appmessage.NetAddress) {
 	for _, netAddress := range netAddresses {
-		addressString := netAddress.TCPAddress().String()
+		if len(c.activeOutgoing) >= c.targetOutgoing {
+			return
+		}
+
+		addressString := netAddress.String()
+		if _, ok := c.activeOutgoing[addressString]; ok {
+			continue
+		}
+		group := c.addressManager.GroupKey(netAddress)
+		if c.isOutgoingGroupOccupied(netAddress, group) {
+			log.Debugf("Not connecting to %s because there's already an outgoing "+
+				"connection in its netgroup %s", addressString, group)
+			continue
+		}
 
 		log.Debugf("Connecting to %s because we have %d outgoing connections and the target is "+
 			"%d", addressString, len(c.activeOutgoing), c.targetOutgoing)
 
-		err := c.initiateConnection(addressString)
+		err := c.addressManager.MarkConnectionAttempt(netAddress)
+		if err != nil {
+			log.Warnf("Couldn't record the connection attempt to %s: %s", addressString, err)
+		}
+
+		err = c.initiateConnection(addressString)
 		if err != nil {
 			log.Infof("Couldn't connect to %s: %s", addressString, err)
-			c.addressManager.RemoveAddress(netAddress)
+			err = c.addressManager.MarkConnectionFailure(netAddress)
+			if err != nil {
+				log.Warnf("Couldn't record the failed connection to %s: %s", addressString, err)
+			}
+			continue
+		}
+
+		err = c.addressManager.MarkConnectionSuccess(netAddress)
+		if err != nil {
+			log.Warnf("Couldn't record the connection to %s: %s", addressString, err)
+		}
+
+		c.activeOutgoing[addressString] = &outgoingConnection{
+			netAddress:  netAddress,
+			group:       group,
+			connectedAt: time.Now(),
+		}
+	}
+}
+
+// outgoingGroups returns the netgroups of all the active outgoing connections
+func (c *ConnectionManager) outgoingGroups() map[string]struct{} {
+	groups := make(map[string]struct{}, len(c.activeOutgoing))
+	for _, connection := range c.activeOutgoing {
+		groups[connection.group] = struct{}{}
+	}
+	return groups
+}
+
+// isOutgoingGroupOccupied returns whether there's already an outgoing connection in the
+// given netgroup. Local and unroutable addresses share a single netgroup, so they are
+// never limited this way.
+func (c *ConnectionManager) isOutgoingGroupOccupied(netAddress *appmessage.NetAddress, group string) bool {
+	if !addressmanager.IsRoutable(netAddress, false) {
+		return false
+	}
+	for _, connection := range c.activeOutgoing {
+		if connection.group == group {
+			return true
+		}
+	}
+	return false
+}
+
+// outgoingConnectionsByAge returns the addresses of the active outgoing connections,
+// from the longest lived to the most recent one
+func (c *ConnectionManager) outgoingConnectionsByAge() []string {
+	addresses := make([]string, 0, len(c.activeOutgoing))
+	for address := range c.activeOutgoing {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return c.activeOutgoing[addresses[i]].connectedAt.Before(c.activeOutgoing[addresses[j]].connectedAt)
+	})
+	return addresses
+}
+
+// rotateOutgoingConnections disconnects a random outgoing connection other than the
+// anchors once every outgoingRotationInterval, provided all outgoing slots are taken.
+// The freed slot is then refilled with a newly selected address.
+func (c *ConnectionManager) rotateOutgoingConnections() {
+	if time.Since(c.lastOutgoingRotation) < outgoingRotationInterval {
+		return
+	}
+	if len(c.activeOutgoing) < c.targetOutgoing || len(c.activeOutgoing) <= anchorCount {
+		return
+	}
+	c.lastOutgoingRotation = time.Now()
+
+	rotationCandidates := c.outgoingConnectionsByAge()[anchorCount:]
+	address := rotationCandidates[rand.Intn(len(rotationCandidates))]
+	for _, connection := range c.netAdapter.P2PConnections() {
+		if connection.Address() == address {
+			log.Debugf("Disconnecting from %s to rotate outgoing connections", address)
+			connection.Disconnect()
+		}
+	}
+	delete(c.activeOutgoing, address)
+
+	// An anchor of the previous run that was rotated out is no longer kept
+	// as an anchor
+	for i, anchor := range c.anchors {
+		if anchor.String() == address {
+			c.anchors = append(append([]*appmessage.NetAddress{}, c.anchors[:i]...), c.anchors[i+1:]...)
+			break
+		}
+	}
+}
+
+// updateAnchors persists the longest lived outgoing connections as anchors,
+// if they changed since they were last persisted. Anchors we got disconnected
+// from are kept until they're replaced by other connections, unless they were
+// banned or rotated out, so that a temporary loss of connectivity doesn't
+// erase them.
+func (c *ConnectionManager) updateAnchors() {
+	// Keep the anchors of the previous run when outgoing connections are
+	// disabled, or when they are being disconnected due to shutdown
+	if c.targetOutgoing == 0 || atomic.LoadUint32(&c.stop) != 0 {
+		return
+	}
+
+	anchorAddresses := c.outgoingConnectionsByAge()
+	if len(anchorAddresses) > anchorCount {
+		anchorAddresses = anchorAddresses[:anchorCount]
+	}
+
+	anchors := make([]*appmessage.NetAddress, len(anchorAddresses), anchorCount)
+	for i, address := range anchorAddresses {
+		anchors[i] = c.activeOutgoing[address].netAddress
+	}
+	for _, anchor := range c.anchors {
+		if len(anchors) >= anchorCount {
+			break
+		}
+		if containsAddress(anchors, anchor) || c.isAnchorBanned(anchor) {
 			continue
 		}
+		anchors = append(anchors, anchor)
+	}
+	if len(anchors) == 0 || areSameAddresses(anchors, c.anchors) {
+		return
+	}
+
+	err := c.addressManager.SetAnchorAddresses(anchors)
+	if err != nil {
+		log.Warnf("Couldn't persist the anchor addresses: %s", err)
+		return
+	}
+	c.anchors = anchors
+}
+
+func containsAddress(addresses []*appmessage.NetAddress, address *appmessage.NetAddress) bool {
+	for _, otherAddress := range addresses {
+		if otherAddress.String() == address.String() {
+			return true
+		}
+	}
+	return false
+}
 
-		c.activeOutgoing[addressString] = struct{}{}
+func areSameAddresses(addresses []*appmessage.NetAddress, otherAddresses []*appmessage.NetAddress) bool {
+	if len(addresses) != len(otherAddresses) {
+		return false
+	}
+	for i := range addresses {
+		if addresses[i].String() != otherAddresses[i].String() {
+			return false
+		}
 	}
+	return true
 }
@@ -0,0 +1,164 @@
+package connmanager
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
+)
+
+func TestRotateOutgoingConnections(t *testing.T) {
+	netAdapter, err := netadapter.NewNetAdapter(config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewNetAdapter: %s", err)
+	}
+
+	// newConnectionManager returns a connection manager whose outgoing
+	// connections were made an hour apart, the first being the oldest
+	now := time.Now()
+	newConnectionManager := func(connectionCount int, targetOutgoing int) *ConnectionManager {
+		c := &ConnectionManager{
+			netAdapter:     netAdapter,
+			activeOutgoing: map[string]*outgoingConnection{},
+			targetOutgoing: targetOutgoing,
+		}
+		for i := 0; i < connectionCount; i++ {
+			c.activeOutgoing[fmt.Sprintf("10.0.%d.1:16111", i)] = &outgoingConnection{
+				group:       fmt.Sprintf("10.0.%d", i),
+				connectedAt: now.Add(time.Duration(i-connectionCount) * time.Hour),
+			}
+		}
+		return c
+	}
+
+	c := newConnectionManager(4, 4)
+	c.rotateOutgoingConnections()
+	if len(c.activeOutgoing) != 3 {
+		t.Fatalf("Expected a connection to be rotated. Want: 3 connections, got: %d", len(c.activeOutgoing))
+	}
+	for i := 0; i < anchorCount; i++ {
+		anchor := fmt.Sprintf("10.0.%d.1:16111", i)
+		if _, ok := c.activeOutgoing[anchor]; !ok {
+			t.Fatalf("Expected anchor %s not to be rotated", anchor)
+		}
+	}
+	if time.Since(c.lastOutgoingRotation) > time.Minute {
+		t.Fatalf("Expected the time of the rotation to be recorded, got %s", c.lastOutgoingRotation)
+	}
+
+	// Only one connection is rotated every outgoingRotationInterval
+	c.activeOutgoing["10.0.4.1:16111"] = &outgoingConnection{group: "10.0.4", connectedAt: now}
+	c.rotateOutgoingConnections()
+	if len(c.activeOutgoing) != 4 {
+		t.Fatalf("Expected no connection to be rotated before outgoingRotationInterval passes. "+
+			"Want: 4 connections, got: %d", len(c.activeOutgoing))
+	}
+	c.lastOutgoingRotation = now.Add(-outgoingRotationInterval)
+	c.rotateOutgoingConnections()
+	if len(c.activeOutgoing) != 3 {
+		t.Fatalf("Expected a connection to be rotated after outgoingRotationInterval passes. "+
+			"Want: 3 connections, got: %d", len(c.activeOutgoing))
+	}
+
+	// Connections are only rotated when all outgoing slots are taken
+	c = newConnectionManager(3, 4)
+	c.rotateOutgoingConnections()
+	if len(c.activeOutgoing) != 3 {
+		t.Fatalf("Expected no connection to be rotated while there are free outgoing slots. "+
+			"Want: 3 connections, got: %d", len(c.activeOutgoing))
+	}
+
+	// The anchors are never rotated
+	c = newConnectionManager(anchorCount, anchorCount)
+	c.rotateOutgoingConnections()
+	if len(c.activeOutgoing) != anchorCount {
+		t.Fatalf("Expected the anchors not to be rotated. Want: %d connections, got: %d",
+			anchorCount, len(c.activeOutgoing))
+	}
+}
+
+func TestUpdateAnchors(t *testing.T) {
+	database, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	addressManager, err := addressmanager.New(addressmanager.NewConfig(config.DefaultConfig()), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+	netAdapter, err := netadapter.NewNetAdapter(config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewNetAdapter: %s", err)
+	}
+
+	newAddress := func(ip string) *appmessage.NetAddress {
+		return appmessage.NewNetAddressIPPort(net.ParseIP(ip), 16111, appmessage.SFNodeNetwork)
+	}
+	anchorA, anchorB, newPeer := newAddress("1.2.3.4"), newAddress("5.6.7.8"), newAddress("9.10.11.12")
+	err = addressManager.SetAnchorAddresses([]*appmessage.NetAddress{anchorA, anchorB})
+	if err != nil {
+		t.Fatalf("SetAnchorAddresses() failed: %s", err)
+	}
+
+	c := &ConnectionManager{
+		netAdapter:     netAdapter,
+		addressManager: addressManager,
+		activeOutgoing: map[string]*outgoingConnection{},
+		targetOutgoing: 8,
+		anchors:        addressManager.AnchorAddresses(),
+	}
+	checkAnchors := func(expected ...*appmessage.NetAddress) {
+		t.Helper()
+		if !areSameAddresses(addressManager.AnchorAddresses(), expected) {
+			t.Fatalf("Unexpected persisted anchors. Want: %v, got: %v", expected, addressManager.AnchorAddresses())
+		}
+	}
+
+	// Losing all connections doesn't erase the anchors
+	c.updateAnchors()
+	checkAnchors(anchorA, anchorB)
+
+	// Anchors we got disconnected from are only replaced as new
+	// connections are made
+	c.activeOutgoing[newPeer.String()] = &outgoingConnection{netAddress: newPeer, connectedAt: time.Now()}
+	c.updateAnchors()
+	checkAnchors(newPeer, anchorA)
+
+	// Banned anchors are dropped
+	err = addressManager.Ban(anchorA)
+	if err != nil {
+		t.Fatalf("Ban() failed: %s", err)
+	}
+	c.anchors = []*appmessage.NetAddress{anchorA, anchorB}
+	c.updateAnchors()
+	checkAnchors(newPeer, anchorB)
+
+	// Anchors that were rotated out are dropped
+	now := time.Now()
+	c.activeOutgoing = map[string]*outgoingConnection{}
+	for i, address := range []*appmessage.NetAddress{newAddress("10.0.0.1"), newAddress("10.1.0.1"), anchorB} {
+		c.activeOutgoing[address.String()] = &outgoingConnection{
+			netAddress:  address,
+			connectedAt: now.Add(time.Duration(i) * time.Hour),
+		}
+	}
+	c.targetOutgoing = len(c.activeOutgoing)
+	c.anchors = []*appmessage.NetAddress{anchorB}
+	c.lastOutgoingRotation = now.Add(-outgoingRotationInterval)
+	c.rotateOutgoingConnections()
+	if len(c.anchors) != 0 {
+		t.Fatalf("Expected the rotated out anchor to be dropped, got: %v", c.anchors)
+	}
+
+	// An empty list of anchors is never persisted
+	c.activeOutgoing = map[string]*outgoingConnection{}
+	c.updateAnchors()
+	checkAnchors(newPeer, anchorB)
+}
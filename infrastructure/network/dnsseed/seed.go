@@ -141,38 +141,32 @@ func SeedFromGRPC(dagParams *dagconfig.Params, customSeed string, reqServices ap
 				return
 			}
 
-			seedPeers := fromProtobufAddresses(res.Addresses)
-
-			numPeers := len(seedPeers)
+			numPeers := len(res.Addresses)
 
 			log.Infof("%d addresses found from DNS seed %s", numPeers, host)
 
 			if numPeers == 0 {
 				return
 			}
-			addresses := make([]*appmessage.NetAddress, len(seedPeers))
+			addresses := make([]*appmessage.NetAddress, numPeers)
 			// if this errors then we have *real* problems
 			intPort, _ := strconv.Atoi(dagParams.DefaultPort)
-			for i, peer := range seedPeers {
+			for i, peer := range res.Addresses {
+				// Seeders that don't report a port are assumed to only
+				// serve peers that listen on the default port
+				port := uint16(peer.Port)
+				if port == 0 {
+					port = uint16(intPort)
+				}
 				addresses[i] = appmessage.NewNetAddressTimestamp(
 					// seed with addresses from a time randomly selected
 					// between 3 and 7 days ago.
 					mstime.Now().Add(-1*time.Second*time.Duration(secondsIn3Days+
 						randSource.Int31n(secondsIn4Days))),
-					0, peer, uint16(intPort))
+					0, net.IP(peer.IP), port)
 			}
 
 			seedFn(addresses)
 		})
 	}
 }
-
-func fromProtobufAddresses(proto []*pb2.NetAddress) []net.IP {
-	var addresses []net.IP
-
-	for _, pbAddr := range proto {
-		addresses = append(addresses, net.IP(pbAddr.IP))
-	}
-
-	return addresses
-}
@@ -0,0 +1,15 @@
+// +build !linux
+
+package natmapping
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// defaultGateway is only implemented on Linux. On other platforms NAT-PMP is
+// not available, and UPnP is used exclusively.
+func defaultGateway() (net.IP, error) {
+	return nil, errors.New("finding the default gateway is not supported on this platform")
+}
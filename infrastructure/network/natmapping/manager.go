@@ -0,0 +1,164 @@
+package natmapping
+
+import (
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+	"github.com/pkg/errors"
+)
+
+const (
+	discoveryTimeout = 5 * time.Second
+
+	// leaseDuration is the duration of the port mappings. They are renewed
+	// every renewalInterval, so that they never expire while kaspad runs,
+	// yet do not linger for long once it stops.
+	leaseDuration   = 20 * time.Minute
+	renewalInterval = 15 * time.Minute
+
+	mappingDescription = "kaspad listen port"
+)
+
+// localAddressAdvertiser is the part of the AddressManager used by the Manager
+type localAddressAdvertiser interface {
+	AddLocalAddress(address *appmessage.NetAddress, priority addressmanager.AddressPriority) error
+	RemoveLocalAddress(address *appmessage.NetAddress)
+}
+
+// Manager maps the P2P listening port on the gateway of the local network,
+// keeps the mapping alive, and advertises the external address of the gateway
+// through the address manager.
+type Manager struct {
+	discover       func() (NAT, error)
+	listenPort     uint16
+	addressManager localAddressAdvertiser
+
+	nat                NAT
+	mappedExternalPort uint16
+	advertisedAddress  *appmessage.NetAddress
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a new Manager that maps the port of the first P2P listener in
+// cfg
+func New(cfg *config.Config, addressManager *addressmanager.AddressManager) (*Manager, error) {
+	if len(cfg.Listeners) == 0 {
+		return nil, errors.New("port mapping requires a P2P listener")
+	}
+	_, portString, err := net.SplitHostPort(cfg.Listeners[0])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	listenPort, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	discover := func() (NAT, error) {
+		return Discover(discoveryTimeout)
+	}
+	return newManager(discover, uint16(listenPort), addressManager), nil
+}
+
+func newManager(discover func() (NAT, error), listenPort uint16, addressManager localAddressAdvertiser) *Manager {
+	return &Manager{
+		discover:       discover,
+		listenPort:     listenPort,
+		addressManager: addressManager,
+		quit:           make(chan struct{}),
+	}
+}
+
+// Start begins the operation of the Manager
+func (m *Manager) Start() {
+	m.wg.Add(1)
+	spawn("natmapping.Manager.mappingLoop", m.mappingLoop)
+}
+
+// Stop halts the operation of the Manager and removes the port mapping
+func (m *Manager) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+func (m *Manager) mappingLoop() {
+	defer m.wg.Done()
+
+	// Map immediately, and renew the mapping every renewalInterval thereafter
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			m.renewMapping()
+			timer.Reset(renewalInterval)
+		case <-m.quit:
+			m.removeMapping()
+			return
+		}
+	}
+}
+
+// renewMapping discovers the gateway if it wasn't found yet, maps the
+// listening port on it, and advertises the resulting external address in place
+// of the previous one if it changed.
+func (m *Manager) renewMapping() {
+	if m.nat == nil {
+		nat, err := m.discover()
+		if err != nil {
+			log.Warnf("Can't map the listening port: %s", err)
+			return
+		}
+		m.nat = nat
+	}
+
+	externalPort, err := m.nat.AddPortMapping("tcp", m.listenPort, m.listenPort, mappingDescription, leaseDuration)
+	if err != nil {
+		log.Warnf("Can't add port mapping: %s", err)
+		return
+	}
+	m.mappedExternalPort = externalPort
+
+	externalIP, err := m.nat.GetExternalAddress()
+	if err != nil {
+		log.Warnf("Can't get the external address of the gateway: %s", err)
+		return
+	}
+
+	netAddress := appmessage.NewNetAddressIPPort(externalIP, externalPort, appmessage.DefaultServices)
+	if m.advertisedAddress != nil && m.advertisedAddress.IP.Equal(netAddress.IP) &&
+		m.advertisedAddress.Port == netAddress.Port {
+		return
+	}
+	if m.advertisedAddress != nil {
+		m.addressManager.RemoveLocalAddress(m.advertisedAddress)
+		log.Infof("No longer advertising the former external address %s", m.advertisedAddress)
+		m.advertisedAddress = nil
+	}
+	err = m.addressManager.AddLocalAddress(netAddress, addressmanager.UpnpPrio)
+	if err != nil {
+		log.Warnf("Can't advertise the external address %s: %s", netAddress, err)
+		return
+	}
+	m.advertisedAddress = netAddress
+	log.Infof("Mapped the listening port, advertising external address %s", netAddress)
+}
+
+func (m *Manager) removeMapping() {
+	if m.mappedExternalPort == 0 {
+		return
+	}
+	err := m.nat.DeletePortMapping("tcp", m.mappedExternalPort, m.listenPort)
+	if err != nil {
+		log.Warnf("Can't remove port mapping: %s", err)
+		return
+	}
+	log.Debugf("Removed the port mapping of external port %d", m.mappedExternalPort)
+}
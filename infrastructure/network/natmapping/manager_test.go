@@ -0,0 +1,118 @@
+package natmapping
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+)
+
+type fakeAddressManager struct {
+	mutex          sync.Mutex
+	localAddresses []*appmessage.NetAddress
+}
+
+func (f *fakeAddressManager) AddLocalAddress(address *appmessage.NetAddress,
+	priority addressmanager.AddressPriority) error {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if priority != addressmanager.UpnpPrio {
+		return nil
+	}
+	f.localAddresses = append(f.localAddresses, address)
+	return nil
+}
+
+func (f *fakeAddressManager) RemoveLocalAddress(address *appmessage.NetAddress) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for i, localAddress := range f.localAddresses {
+		if localAddress.String() == address.String() {
+			f.localAddresses = append(f.localAddresses[:i], f.localAddresses[i+1:]...)
+			return
+		}
+	}
+}
+
+func (f *fakeAddressManager) addresses() []*appmessage.NetAddress {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]*appmessage.NetAddress{}, f.localAddresses...)
+}
+
+// TestManager ensures that the Manager maps the listening port on a fake
+// Internet Gateway Device, advertises the external address of the gateway
+// and removes the mapping once stopped.
+func TestManager(t *testing.T) {
+	igd := newFakeIGD(t)
+	defer igd.close()
+
+	addressManager := &fakeAddressManager{}
+	discover := func() (NAT, error) {
+		return discoverUPnP(igd.ssdpAddress(), time.Second)
+	}
+	manager := newManager(discover, 16111, addressManager)
+	manager.Start()
+
+	var addresses []*appmessage.NetAddress
+	for i := 0; i < 100 && len(addresses) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		addresses = addressManager.addresses()
+	}
+	if len(addresses) != 1 {
+		t.Fatalf("expected a single advertised address, got %d", len(addresses))
+	}
+	if addresses[0].String() != igd.externalIP+":16111" {
+		t.Errorf("got advertised address %s, want %s", addresses[0], igd.externalIP+":16111")
+	}
+	if _, ok := igd.mapping("TCP:16111"); !ok {
+		t.Errorf("the listening port was not mapped")
+	}
+
+	manager.Stop()
+	if _, ok := igd.mapping("TCP:16111"); ok {
+		t.Errorf("the mapping was not removed when the manager stopped")
+	}
+}
+
+// TestManagerExternalIPChange ensures that once the external address of the
+// gateway changes, the Manager advertises the new address in place of the
+// old one.
+func TestManagerExternalIPChange(t *testing.T) {
+	igd := newFakeIGD(t)
+	defer igd.close()
+
+	addressManager := &fakeAddressManager{}
+	discover := func() (NAT, error) {
+		return discoverUPnP(igd.ssdpAddress(), time.Second)
+	}
+	manager := newManager(discover, 16111, addressManager)
+
+	checkAdvertisedAddress := func(expected string) {
+		t.Helper()
+		addresses := addressManager.addresses()
+		if len(addresses) != 1 {
+			t.Fatalf("expected a single advertised address, got %v", addresses)
+		}
+		if addresses[0].String() != expected {
+			t.Fatalf("got advertised address %s, want %s", addresses[0], expected)
+		}
+	}
+
+	manager.renewMapping()
+	checkAdvertisedAddress(igd.externalIP + ":16111")
+
+	const newExternalIP = "198.51.100.7"
+	igd.setExternalIP(newExternalIP)
+	manager.renewMapping()
+	checkAdvertisedAddress(newExternalIP + ":16111")
+
+	// Renewing the mapping without a change keeps advertising the same address
+	manager.renewMapping()
+	checkAdvertisedAddress(newExternalIP + ":16111")
+
+	manager.removeMapping()
+}
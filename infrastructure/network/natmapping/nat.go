@@ -0,0 +1,46 @@
+package natmapping
+
+import (
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NAT is an interface representing a gateway that can map ports from its
+// external address to this host, such as a UPnP Internet Gateway Device or a
+// NAT-PMP capable router.
+type NAT interface {
+	// GetExternalAddress returns the external address of the gateway
+	GetExternalAddress() (net.IP, error)
+
+	// AddPortMapping maps externalPort on the gateway to internalPort on
+	// this host for the given lease duration, and returns the external port
+	// that was actually mapped
+	AddPortMapping(protocol string, externalPort, internalPort uint16, description string,
+		leaseDuration time.Duration) (mappedExternalPort uint16, err error)
+
+	// DeletePortMapping removes a mapping previously added by AddPortMapping
+	DeletePortMapping(protocol string, externalPort, internalPort uint16) error
+}
+
+// ErrNoGateway is returned from Discover when no supported gateway was found
+var ErrNoGateway = errors.New("no UPnP or NAT-PMP gateway found")
+
+// Discover searches the local network for a gateway that supports port
+// mapping. UPnP is tried first, then NAT-PMP.
+func Discover(timeout time.Duration) (NAT, error) {
+	nat, err := DiscoverUPnP(timeout)
+	if err == nil {
+		return nat, nil
+	}
+	log.Debugf("UPnP discovery failed: %s", err)
+
+	nat, err = DiscoverNATPMP(timeout)
+	if err == nil {
+		return nat, nil
+	}
+	log.Debugf("NAT-PMP discovery failed: %s", err)
+
+	return nil, ErrNoGateway
+}
@@ -0,0 +1,179 @@
+package natmapping
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// natPMPPort is the port NAT-PMP gateways listen on, as defined in RFC 6886
+	natPMPPort = 5351
+
+	natPMPVersion = 0
+
+	natPMPOpcodeExternalAddress = 0
+	natPMPOpcodeMapUDP          = 1
+	natPMPOpcodeMapTCP          = 2
+
+	// natPMPResponseOpcodeOffset is added to the opcode of a request to get
+	// the opcode of its response
+	natPMPResponseOpcodeOffset = 128
+
+	// natPMPInitialRetryInterval is the time to wait for a response before
+	// the first retransmission. The interval doubles on every retransmission.
+	natPMPInitialRetryInterval = 250 * time.Millisecond
+)
+
+// natPMPResultCodeDescriptions describes the non-success result codes defined
+// in RFC 6886
+var natPMPResultCodeDescriptions = map[uint16]string{
+	1: "unsupported version",
+	2: "not authorized",
+	3: "network failure",
+	4: "out of resources",
+	5: "unsupported opcode",
+}
+
+// natPMPNAT implements NAT using the NAT-PMP protocol
+type natPMPNAT struct {
+	gatewayAddress string
+	timeout        time.Duration
+}
+
+// DiscoverNATPMP checks whether the default gateway of this host supports
+// NAT-PMP.
+func DiscoverNATPMP(timeout time.Duration) (NAT, error) {
+	gateway, err := defaultGateway()
+	if err != nil {
+		return nil, err
+	}
+	return discoverNATPMP(net.JoinHostPort(gateway.String(), strconv.Itoa(natPMPPort)), timeout)
+}
+
+// discoverNATPMP returns a NAT for the NAT-PMP gateway at the given address,
+// if it answers an external address request.
+func discoverNATPMP(gatewayAddress string, timeout time.Duration) (NAT, error) {
+	nat := &natPMPNAT{gatewayAddress: gatewayAddress, timeout: timeout}
+	_, err := nat.GetExternalAddress()
+	if err != nil {
+		return nil, err
+	}
+	return nat, nil
+}
+
+// request sends the given request to the gateway, retransmitting it until a
+// response arrives or the timeout elapses, and returns the response payload
+// following its result code.
+func (n *natPMPNAT) request(request []byte, responseLength int) ([]byte, error) {
+	conn, err := net.Dial("udp4", n.gatewayAddress)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer conn.Close()
+
+	opcode := request[1]
+	deadline := time.Now().Add(n.timeout)
+	retryInterval := natPMPInitialRetryInterval
+	response := make([]byte, 16)
+	for time.Now().Before(deadline) {
+		_, err := conn.Write(request)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		readDeadline := time.Now().Add(retryInterval)
+		if readDeadline.After(deadline) {
+			readDeadline = deadline
+		}
+		err = conn.SetReadDeadline(readDeadline)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		retryInterval *= 2
+
+		bytesRead, err := conn.Read(response)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				continue
+			}
+			return nil, errors.WithStack(err)
+		}
+		if bytesRead < responseLength || response[0] != natPMPVersion ||
+			response[1] != opcode+natPMPResponseOpcodeOffset {
+			continue
+		}
+
+		resultCode := binary.BigEndian.Uint16(response[2:4])
+		if resultCode != 0 {
+			description, ok := natPMPResultCodeDescriptions[resultCode]
+			if !ok {
+				description = "unknown error"
+			}
+			return nil, errors.Errorf("NAT-PMP request failed with result code %d: %s",
+				resultCode, description)
+		}
+		// Skip the result code and the seconds since the gateway started
+		return response[8:responseLength], nil
+	}
+	return nil, errors.Errorf("no NAT-PMP response from %s", n.gatewayAddress)
+}
+
+// GetExternalAddress returns the external address of the gateway
+// This is part of the NAT interface
+func (n *natPMPNAT) GetExternalAddress() (net.IP, error) {
+	response, err := n.request([]byte{natPMPVersion, natPMPOpcodeExternalAddress}, 12)
+	if err != nil {
+		return nil, err
+	}
+	return net.IPv4(response[0], response[1], response[2], response[3]), nil
+}
+
+// AddPortMapping maps externalPort on the gateway to internalPort on this host.
+// NAT-PMP mappings have no description, so description is ignored.
+// This is part of the NAT interface
+func (n *natPMPNAT) AddPortMapping(protocol string, externalPort, internalPort uint16, description string,
+	leaseDuration time.Duration) (uint16, error) {
+
+	response, err := n.mapPort(protocol, externalPort, internalPort, leaseDuration)
+	if err != nil {
+		return 0, err
+	}
+	// The gateway may map a different external port than the one requested
+	return binary.BigEndian.Uint16(response[2:4]), nil
+}
+
+// DeletePortMapping removes a mapping previously added by AddPortMapping
+// This is part of the NAT interface
+func (n *natPMPNAT) DeletePortMapping(protocol string, externalPort, internalPort uint16) error {
+	// A mapping is deleted by requesting it with a zero external port and
+	// lifetime
+	_, err := n.mapPort(protocol, 0, internalPort, 0)
+	return err
+}
+
+func (n *natPMPNAT) mapPort(protocol string, externalPort, internalPort uint16,
+	leaseDuration time.Duration) ([]byte, error) {
+
+	var opcode byte
+	switch strings.ToLower(protocol) {
+	case "tcp":
+		opcode = natPMPOpcodeMapTCP
+	case "udp":
+		opcode = natPMPOpcodeMapUDP
+	default:
+		return nil, errors.Errorf("unsupported protocol %s", protocol)
+	}
+
+	request := make([]byte, 12)
+	request[0] = natPMPVersion
+	request[1] = opcode
+	binary.BigEndian.PutUint16(request[4:6], internalPort)
+	binary.BigEndian.PutUint16(request[6:8], externalPort)
+	binary.BigEndian.PutUint32(request[8:12], uint32(leaseDuration/time.Second))
+	return n.request(request, 16)
+}
@@ -0,0 +1,149 @@
+package natmapping
+
+import (
+	"encoding/binary"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeNATPMPGateway is a NAT-PMP gateway on the loopback interface that
+// maps every requested port to the requested port plus portOffset
+type fakeNATPMPGateway struct {
+	conn       net.PacketConn
+	portOffset uint16
+
+	mutex    sync.Mutex
+	mappings map[uint16]uint16
+}
+
+func newFakeNATPMPGateway(t *testing.T) *fakeNATPMPGateway {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %s", err)
+	}
+	gateway := &fakeNATPMPGateway{
+		conn:       conn,
+		portOffset: 1000,
+		mappings:   make(map[uint16]uint16),
+	}
+	go gateway.answerRequests()
+	return gateway
+}
+
+func (g *fakeNATPMPGateway) answerRequests() {
+	request := make([]byte, 12)
+	for {
+		n, address, err := g.conn.ReadFrom(request)
+		if err != nil {
+			return
+		}
+		if n < 2 || request[0] != natPMPVersion {
+			continue
+		}
+
+		var response []byte
+		switch request[1] {
+		case natPMPOpcodeExternalAddress:
+			response = make([]byte, 12)
+			copy(response[8:], net.ParseIP("203.0.113.7").To4())
+		case natPMPOpcodeMapTCP:
+			if n < 12 {
+				continue
+			}
+			internalPort := binary.BigEndian.Uint16(request[4:6])
+			externalPort := binary.BigEndian.Uint16(request[6:8])
+			lifetime := binary.BigEndian.Uint32(request[8:12])
+
+			g.mutex.Lock()
+			if lifetime == 0 {
+				delete(g.mappings, internalPort)
+			} else {
+				externalPort += g.portOffset
+				g.mappings[internalPort] = externalPort
+			}
+			g.mutex.Unlock()
+
+			response = make([]byte, 16)
+			binary.BigEndian.PutUint16(response[8:10], internalPort)
+			binary.BigEndian.PutUint16(response[10:12], externalPort)
+			binary.BigEndian.PutUint32(response[12:16], lifetime)
+		default:
+			// Respond with the unsupported opcode result code
+			response = make([]byte, 8)
+			binary.BigEndian.PutUint16(response[2:4], 5)
+		}
+		response[0] = natPMPVersion
+		response[1] = request[1] + natPMPResponseOpcodeOffset
+		g.conn.WriteTo(response, address)
+	}
+}
+
+func (g *fakeNATPMPGateway) mapping(internalPort uint16) (uint16, bool) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	externalPort, ok := g.mappings[internalPort]
+	return externalPort, ok
+}
+
+// TestNATPMP ensures that the external address of a NAT-PMP gateway is
+// retrieved, and that port mappings are added and deleted, honoring the
+// external port chosen by the gateway.
+func TestNATPMP(t *testing.T) {
+	gateway := newFakeNATPMPGateway(t)
+	defer gateway.conn.Close()
+
+	nat, err := discoverNATPMP(gateway.conn.LocalAddr().String(), time.Second)
+	if err != nil {
+		t.Fatalf("discoverNATPMP: %s", err)
+	}
+
+	externalIP, err := nat.GetExternalAddress()
+	if err != nil {
+		t.Fatalf("GetExternalAddress: %s", err)
+	}
+	if !externalIP.Equal(net.ParseIP("203.0.113.7")) {
+		t.Errorf("GetExternalAddress: got %s, want %s", externalIP, "203.0.113.7")
+	}
+
+	mappedPort, err := nat.AddPortMapping("tcp", 16111, 16111, "test", time.Minute)
+	if err != nil {
+		t.Fatalf("AddPortMapping: %s", err)
+	}
+	if mappedPort != 17111 {
+		t.Errorf("AddPortMapping: got port %d, want %d", mappedPort, 17111)
+	}
+	if externalPort, ok := gateway.mapping(16111); !ok || externalPort != 17111 {
+		t.Errorf("AddPortMapping: the mapping was not added")
+	}
+
+	err = nat.DeletePortMapping("tcp", mappedPort, 16111)
+	if err != nil {
+		t.Fatalf("DeletePortMapping: %s", err)
+	}
+	if _, ok := gateway.mapping(16111); ok {
+		t.Errorf("DeletePortMapping: the mapping was not deleted")
+	}
+
+	_, err = nat.AddPortMapping("sctp", 16111, 16111, "test", time.Minute)
+	if err == nil {
+		t.Errorf("AddPortMapping: expected an error for an unsupported protocol")
+	}
+}
+
+// TestNATPMPNoGateway ensures that discovery fails when no gateway answers.
+func TestNATPMPNoGateway(t *testing.T) {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %s", err)
+	}
+	defer conn.Close()
+
+	// The connection is open but never answers, so the request is
+	// retransmitted until the timeout elapses
+	_, err = discoverNATPMP(conn.LocalAddr().String(), 600*time.Millisecond)
+	if err == nil {
+		t.Fatalf("discoverNATPMP: expected an error when no gateway answers")
+	}
+}
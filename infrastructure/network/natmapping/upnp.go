@@ -0,0 +1,341 @@
+package natmapping
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ssdpMulticastAddress is the address UPnP devices listen on for
+	// discovery requests
+	ssdpMulticastAddress = "239.255.255.250:1900"
+
+	internetGatewayDeviceType = "urn:schemas-upnp-org:device:InternetGatewayDevice:1"
+
+	// upnpHTTPTimeout is the timeout of every HTTP request made to the gateway
+	upnpHTTPTimeout = 10 * time.Second
+)
+
+// wanConnectionServiceTypes are the UPnP services that support port mapping,
+// in order of preference
+var wanConnectionServiceTypes = []string{
+	"urn:schemas-upnp-org:service:WANIPConnection:1",
+	"urn:schemas-upnp-org:service:WANPPPConnection:1",
+}
+
+// upnpNAT implements NAT using the WAN connection service of a UPnP Internet
+// Gateway Device
+type upnpNAT struct {
+	controlURL  string
+	serviceType string
+	localIP     net.IP
+	httpClient  *http.Client
+}
+
+// DiscoverUPnP searches the local network for a UPnP Internet Gateway Device
+// with a WAN connection service.
+func DiscoverUPnP(timeout time.Duration) (NAT, error) {
+	return discoverUPnP(ssdpMulticastAddress, timeout)
+}
+
+// discoverUPnP sends an SSDP search request to the given address, and returns
+// a NAT for the first Internet Gateway Device that answers it.
+func discoverUPnP(ssdpAddress string, timeout time.Duration) (NAT, error) {
+	ssdpUDPAddress, err := net.ResolveUDPAddr("udp4", ssdpAddress)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	conn, err := net.ListenPacket("udp4", ":0")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer conn.Close()
+
+	searchRequest := "M-SEARCH * HTTP/1.1\r\n" +
+		"HOST: " + ssdpMulticastAddress + "\r\n" +
+		"ST: " + internetGatewayDeviceType + "\r\n" +
+		"MAN: \"ssdp:discover\"\r\n" +
+		"MX: 2\r\n\r\n"
+
+	deadline := time.Now().Add(timeout)
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	_, err = conn.WriteTo([]byte(searchRequest), ssdpUDPAddress)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	answer := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(answer)
+		if err != nil {
+			return nil, errors.Wrap(err, "no UPnP Internet Gateway Device answered")
+		}
+		response, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(answer[:n])), nil)
+		if err != nil {
+			log.Debugf("Ignoring malformed SSDP answer: %s", err)
+			continue
+		}
+		if response.Header.Get("St") != internetGatewayDeviceType {
+			continue
+		}
+		location := response.Header.Get("Location")
+		if location == "" {
+			continue
+		}
+
+		nat, err := newUPnPNAT(&http.Client{Timeout: upnpHTTPTimeout}, location)
+		if err != nil {
+			log.Debugf("Ignoring Internet Gateway Device at %s: %s", location, err)
+			continue
+		}
+		return nat, nil
+	}
+}
+
+// upnpDevice is a device in a UPnP device description. Devices may be nested
+// in other devices.
+type upnpDevice struct {
+	DeviceType string        `xml:"deviceType"`
+	Devices    []upnpDevice  `xml:"deviceList>device"`
+	Services   []upnpService `xml:"serviceList>service"`
+}
+
+type upnpService struct {
+	ServiceType string `xml:"serviceType"`
+	ControlURL  string `xml:"controlURL"`
+}
+
+type upnpRoot struct {
+	URLBase string     `xml:"URLBase"`
+	Device  upnpDevice `xml:"device"`
+}
+
+// findService searches the device and its sub-devices for a service of the
+// given type.
+func (device *upnpDevice) findService(serviceType string) (*upnpService, bool) {
+	for i := range device.Services {
+		if device.Services[i].ServiceType == serviceType {
+			return &device.Services[i], true
+		}
+	}
+	for i := range device.Devices {
+		if service, ok := device.Devices[i].findService(serviceType); ok {
+			return service, true
+		}
+	}
+	return nil, false
+}
+
+// newUPnPNAT fetches the device description at the given location and
+// returns a NAT for its WAN connection service.
+func newUPnPNAT(httpClient *http.Client, location string) (*upnpNAT, error) {
+	response, err := httpClient.Get(location)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status fetching the device description: %s", response.Status)
+	}
+
+	var root upnpRoot
+	err = xml.NewDecoder(response.Body).Decode(&root)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed device description")
+	}
+	if root.Device.DeviceType != internetGatewayDeviceType {
+		return nil, errors.Errorf("unexpected device type %s", root.Device.DeviceType)
+	}
+
+	for _, serviceType := range wanConnectionServiceTypes {
+		service, ok := root.Device.findService(serviceType)
+		if !ok {
+			continue
+		}
+
+		baseURL := location
+		if root.URLBase != "" {
+			baseURL = root.URLBase
+		}
+		controlURL, err := resolveURL(baseURL, service.ControlURL)
+		if err != nil {
+			return nil, err
+		}
+		localIP, err := localIPForURL(controlURL)
+		if err != nil {
+			return nil, err
+		}
+		return &upnpNAT{
+			controlURL:  controlURL,
+			serviceType: serviceType,
+			localIP:     localIP,
+			httpClient:  httpClient,
+		}, nil
+	}
+	return nil, errors.New("the device has no WAN connection service")
+}
+
+func resolveURL(base string, reference string) (string, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	referenceURL, err := url.Parse(reference)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return baseURL.ResolveReference(referenceURL).String(), nil
+}
+
+// localIPForURL returns the IP of the local interface that is used to reach
+// the host of the given URL. This is the address ports are mapped to.
+func localIPForURL(rawURL string) (net.IP, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	host := parsedURL.Host
+	if parsedURL.Port() == "" {
+		host = net.JoinHostPort(host, "80")
+	}
+	// Dialing UDP sends no packets, it only selects the local address
+	conn, err := net.Dial("udp4", host)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP, nil
+}
+
+// soapRequest calls the given action of the WAN connection service, and
+// returns the values of the output arguments in the response.
+func (n *upnpNAT) soapRequest(action string, arguments [][2]string) (map[string]string, error) {
+	body := &bytes.Buffer{}
+	body.WriteString(`<?xml version="1.0"?>` +
+		`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" ` +
+		`s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/"><s:Body>`)
+	fmt.Fprintf(body, `<u:%s xmlns:u="%s">`, action, n.serviceType)
+	for _, argument := range arguments {
+		fmt.Fprintf(body, "<%s>", argument[0])
+		err := xml.EscapeText(body, []byte(argument[1]))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		fmt.Fprintf(body, "</%s>", argument[0])
+	}
+	fmt.Fprintf(body, `</u:%s></s:Body></s:Envelope>`, action)
+
+	request, err := http.NewRequest(http.MethodPost, n.controlURL, body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	request.Header.Set("Content-Type", `text/xml; charset="utf-8"`)
+	request.Header.Set("SOAPAction", fmt.Sprintf(`"%s#%s"`, n.serviceType, action))
+
+	response, err := n.httpClient.Do(request)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("UPnP action %s failed with status %s: %s",
+			action, response.Status, strings.TrimSpace(string(responseBody)))
+	}
+	return parseSOAPResponse(responseBody, action)
+}
+
+// parseSOAPResponse returns the output arguments of the response element of
+// the given action.
+func parseSOAPResponse(responseBody []byte, action string) (map[string]string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(responseBody))
+	responseElementName := action + "Response"
+	inResponseElement := false
+	values := make(map[string]string)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed response to UPnP action %s", action)
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			if !inResponseElement {
+				inResponseElement = element.Name.Local == responseElementName
+				continue
+			}
+			var value string
+			err := decoder.DecodeElement(&value, &element)
+			if err != nil {
+				return nil, errors.Wrapf(err, "malformed response to UPnP action %s", action)
+			}
+			values[element.Name.Local] = value
+		case xml.EndElement:
+			if inResponseElement && element.Name.Local == responseElementName {
+				return values, nil
+			}
+		}
+	}
+}
+
+// GetExternalAddress returns the external address of the gateway
+// This is part of the NAT interface
+func (n *upnpNAT) GetExternalAddress() (net.IP, error) {
+	values, err := n.soapRequest("GetExternalIPAddress", nil)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(values["NewExternalIPAddress"])
+	if ip == nil {
+		return nil, errors.Errorf("invalid external address %q", values["NewExternalIPAddress"])
+	}
+	return ip, nil
+}
+
+// AddPortMapping maps externalPort on the gateway to internalPort on this host
+// This is part of the NAT interface
+func (n *upnpNAT) AddPortMapping(protocol string, externalPort, internalPort uint16, description string,
+	leaseDuration time.Duration) (uint16, error) {
+
+	_, err := n.soapRequest("AddPortMapping", [][2]string{
+		{"NewRemoteHost", ""},
+		{"NewExternalPort", strconv.Itoa(int(externalPort))},
+		{"NewProtocol", strings.ToUpper(protocol)},
+		{"NewInternalPort", strconv.Itoa(int(internalPort))},
+		{"NewInternalClient", n.localIP.String()},
+		{"NewEnabled", "1"},
+		{"NewPortMappingDescription", description},
+		{"NewLeaseDuration", strconv.Itoa(int(leaseDuration / time.Second))},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return externalPort, nil
+}
+
+// DeletePortMapping removes a mapping previously added by AddPortMapping
+// This is part of the NAT interface
+func (n *upnpNAT) DeletePortMapping(protocol string, externalPort, internalPort uint16) error {
+	_, err := n.soapRequest("DeletePortMapping", [][2]string{
+		{"NewRemoteHost", ""},
+		{"NewExternalPort", strconv.Itoa(int(externalPort))},
+		{"NewProtocol", strings.ToUpper(protocol)},
+	})
+	return err
+}
@@ -0,0 +1,241 @@
+package natmapping
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const fakeIGDDescription = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+	<device>
+		<deviceType>urn:schemas-upnp-org:device:InternetGatewayDevice:1</deviceType>
+		<deviceList>
+			<device>
+				<deviceType>urn:schemas-upnp-org:device:WANDevice:1</deviceType>
+				<deviceList>
+					<device>
+						<deviceType>urn:schemas-upnp-org:device:WANConnectionDevice:1</deviceType>
+						<serviceList>
+							<service>
+								<serviceType>urn:schemas-upnp-org:service:WANIPConnection:1</serviceType>
+								<controlURL>/ctl/IPConn</controlURL>
+							</service>
+						</serviceList>
+					</device>
+				</deviceList>
+			</device>
+		</deviceList>
+	</device>
+</root>`
+
+// fakeIGD is a UPnP Internet Gateway Device that answers SSDP searches and
+// SOAP requests on the loopback interface
+type fakeIGD struct {
+	t          *testing.T
+	ssdpConn   net.PacketConn
+	httpServer *httptest.Server
+	externalIP string
+
+	mutex    sync.Mutex
+	mappings map[string]string
+}
+
+func newFakeIGD(t *testing.T) *fakeIGD {
+	igd := &fakeIGD{
+		t:          t,
+		externalIP: "203.0.113.7",
+		mappings:   make(map[string]string),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rootDesc.xml", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fakeIGDDescription)
+	})
+	mux.HandleFunc("/ctl/IPConn", igd.handleSOAPRequest)
+	igd.httpServer = httptest.NewServer(mux)
+
+	ssdpConn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %s", err)
+	}
+	igd.ssdpConn = ssdpConn
+	go igd.answerSSDPSearches()
+	return igd
+}
+
+func (igd *fakeIGD) ssdpAddress() string {
+	return igd.ssdpConn.LocalAddr().String()
+}
+
+func (igd *fakeIGD) close() {
+	igd.ssdpConn.Close()
+	igd.httpServer.Close()
+}
+
+func (igd *fakeIGD) answerSSDPSearches() {
+	buffer := make([]byte, 1500)
+	for {
+		n, address, err := igd.ssdpConn.ReadFrom(buffer)
+		if err != nil {
+			return
+		}
+		if !strings.HasPrefix(string(buffer[:n]), "M-SEARCH * HTTP/1.1\r\n") ||
+			!strings.Contains(string(buffer[:n]), "ST: "+internetGatewayDeviceType) {
+			continue
+		}
+		answer := "HTTP/1.1 200 OK\r\n" +
+			"CACHE-CONTROL: max-age=120\r\n" +
+			"ST: " + internetGatewayDeviceType + "\r\n" +
+			"LOCATION: " + igd.httpServer.URL + "/rootDesc.xml\r\n\r\n"
+		igd.ssdpConn.WriteTo([]byte(answer), address)
+	}
+}
+
+func (igd *fakeIGD) handleSOAPRequest(w http.ResponseWriter, r *http.Request) {
+	serviceType := "urn:schemas-upnp-org:service:WANIPConnection:1"
+	action := strings.TrimPrefix(strings.Trim(r.Header.Get("SOAPAction"), `"`), serviceType+"#")
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		igd.t.Errorf("error reading SOAP request: %s", err)
+		return
+	}
+	var envelope struct {
+		Body struct {
+			Action struct {
+				XMLName   xml.Name
+				Arguments []struct {
+					XMLName xml.Name
+					Value   string `xml:",chardata"`
+				} `xml:",any"`
+			} `xml:",any"`
+		}
+	}
+	err = xml.Unmarshal(body, &envelope)
+	if err != nil {
+		igd.t.Errorf("malformed SOAP request: %s", err)
+		return
+	}
+	if envelope.Body.Action.XMLName.Local != action {
+		igd.t.Errorf("SOAPAction %s does not match the body action %s",
+			action, envelope.Body.Action.XMLName.Local)
+	}
+	arguments := make(map[string]string)
+	for _, argument := range envelope.Body.Action.Arguments {
+		arguments[argument.XMLName.Local] = argument.Value
+	}
+
+	igd.mutex.Lock()
+	defer igd.mutex.Unlock()
+
+	var responseArguments string
+	switch action {
+	case "GetExternalIPAddress":
+		responseArguments = "<NewExternalIPAddress>" + igd.externalIP + "</NewExternalIPAddress>"
+	case "AddPortMapping":
+		key := arguments["NewProtocol"] + ":" + arguments["NewExternalPort"]
+		igd.mappings[key] = arguments["NewInternalClient"] + ":" + arguments["NewInternalPort"]
+	case "DeletePortMapping":
+		key := arguments["NewProtocol"] + ":" + arguments["NewExternalPort"]
+		if _, ok := igd.mappings[key]; !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, "NoSuchEntryInArray")
+			return
+		}
+		delete(igd.mappings, key)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, "Invalid Action")
+		return
+	}
+
+	fmt.Fprintf(w, `<?xml version="1.0"?>`+
+		`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>`+
+		`<u:%sResponse xmlns:u="%s">%s</u:%sResponse></s:Body></s:Envelope>`,
+		action, serviceType, responseArguments, action)
+}
+
+func (igd *fakeIGD) setExternalIP(externalIP string) {
+	igd.mutex.Lock()
+	defer igd.mutex.Unlock()
+	igd.externalIP = externalIP
+}
+
+func (igd *fakeIGD) mapping(key string) (string, bool) {
+	igd.mutex.Lock()
+	defer igd.mutex.Unlock()
+	mapping, ok := igd.mappings[key]
+	return mapping, ok
+}
+
+// TestUPnP ensures that a UPnP gateway is discovered through SSDP and that
+// its external address is retrieved and port mappings are added and deleted
+// through SOAP requests.
+func TestUPnP(t *testing.T) {
+	igd := newFakeIGD(t)
+	defer igd.close()
+
+	nat, err := discoverUPnP(igd.ssdpAddress(), time.Second)
+	if err != nil {
+		t.Fatalf("discoverUPnP: %s", err)
+	}
+
+	externalIP, err := nat.GetExternalAddress()
+	if err != nil {
+		t.Fatalf("GetExternalAddress: %s", err)
+	}
+	if externalIP.String() != igd.externalIP {
+		t.Errorf("GetExternalAddress: got %s, want %s", externalIP, igd.externalIP)
+	}
+
+	mappedPort, err := nat.AddPortMapping("tcp", 16111, 16112, "test", time.Minute)
+	if err != nil {
+		t.Fatalf("AddPortMapping: %s", err)
+	}
+	if mappedPort != 16111 {
+		t.Errorf("AddPortMapping: got port %d, want %d", mappedPort, 16111)
+	}
+	mapping, ok := igd.mapping("TCP:16111")
+	if !ok {
+		t.Fatalf("AddPortMapping: the mapping was not added")
+	}
+	if mapping != "127.0.0.1:16112" {
+		t.Errorf("AddPortMapping: got mapping to %s, want %s", mapping, "127.0.0.1:16112")
+	}
+
+	err = nat.DeletePortMapping("tcp", 16111, 16112)
+	if err != nil {
+		t.Fatalf("DeletePortMapping: %s", err)
+	}
+	if _, ok := igd.mapping("TCP:16111"); ok {
+		t.Errorf("DeletePortMapping: the mapping was not deleted")
+	}
+
+	err = nat.DeletePortMapping("tcp", 16111, 16112)
+	if err == nil {
+		t.Errorf("DeletePortMapping: expected an error deleting a missing mapping")
+	}
+}
+
+// TestUPnPNoGateway ensures that discovery fails when no gateway answers.
+func TestUPnPNoGateway(t *testing.T) {
+	// Nothing answers on this address, since the connection is closed
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %s", err)
+	}
+	address := conn.LocalAddr().String()
+	conn.Close()
+
+	_, err = discoverUPnP(address, 100*time.Millisecond)
+	if err == nil {
+		t.Fatalf("discoverUPnP: expected an error when no gateway answers")
+	}
+}
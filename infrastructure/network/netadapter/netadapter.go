@@ -43,7 +43,15 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, err
 	}
-	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners)
+	var proxyDial grpcserver.DialFunc
+	if cfg.Proxy != "" {
+		proxyDial = cfg.Dial
+	}
+	uploadLimits := grpcserver.UploadLimits{
+		PeerIBDBytesPerSecond:  cfg.MaxPeerIBDUploadRate * 1024,
+		TotalIBDBytesPerSecond: cfg.MaxIBDUploadRate * 1024,
+	}
+	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners, proxyDial, cfg.OnlyOnion, uploadLimits)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +128,12 @@ func (na *NetAdapter) P2PConnections() []*NetConnection {
 	return netConnections
 }
 
+// P2PTrafficCounters returns the counters of the traffic over all the p2p
+// connections since the NetAdapter was created
+func (na *NetAdapter) P2PTrafficCounters() *server.TrafficCounters {
+	return na.p2pServer.TrafficCounters()
+}
+
 // P2PConnectionCount returns the count of the connected p2p connections
 func (na *NetAdapter) P2PConnectionCount() int {
 	na.p2pConnectionsLock.RLock()
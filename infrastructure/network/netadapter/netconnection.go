@@ -67,7 +67,12 @@ func (c *NetConnection) SetID(peerID *id.ID) {
 
 // Address returns the address associated with this connection
 func (c *NetConnection) Address() string {
-	return c.connection.Address().String()
+	return c.NetAddress().String()
+}
+
+// TrafficCounters returns the counters of the traffic over this connection
+func (c *NetConnection) TrafficCounters() *server.TrafficCounters {
+	return c.connection.TrafficCounters()
 }
 
 // IsOutbound returns whether the connection is outbound
@@ -77,7 +82,8 @@ func (c *NetConnection) IsOutbound() bool {
 
 // NetAddress returns the NetAddress associated with this connection
 func (c *NetConnection) NetAddress() *appmessage.NetAddress {
-	return appmessage.NewNetAddress(c.connection.Address(), 0)
+	netAddress := *c.connection.NetAddress()
+	return &netAddress
 }
 
 func (c *NetConnection) setOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
@@ -2,12 +2,14 @@ package grpcserver
 
 import (
 	"github.com/davecgh/go-spew/spew"
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"io"
 	"time"
 
 	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
 )
@@ -25,35 +27,168 @@ func (c *gRPCConnection) connectionLoops() error {
 	return err
 }
 
+// maxQueuedIBDMessages is the maximum number of IBD upload messages that
+// may wait for the upload limits. Once reached, no other message is sent
+// either until the first of them is.
+const maxQueuedIBDMessages = routerpkg.DefaultMaxMessages
+
+// queuedIBDMessage is an IBD upload message waiting for the upload limits
+type queuedIBDMessage struct {
+	command      appmessage.MessageCommand
+	messageProto *protowire.KaspadMessage
+	messageSize  int
+}
+
+// sendLoop sends the messages of the outgoing route. IBD upload messages are
+// moved to a queue of their own, where they wait for the upload limits, so
+// that the other messages are never delayed by them.
 func (c *gRPCConnection) sendLoop() error {
-	outgoingRoute := c.router.OutgoingRoute()
+	outgoingMessages := make(chan appmessage.Message)
+	spawn("gRPCConnection.dequeueOutgoingMessages", func() { c.dequeueOutgoingMessages(outgoingMessages) })
+
+	var ibdQueue []*queuedIBDMessage
+	var ibdTimer *time.Timer
+	var ibdTimerChan <-chan time.Time
+	defer func() {
+		if ibdTimer != nil {
+			ibdTimer.Stop()
+		}
+	}()
+
+	// sendReadyIBDMessages sends the queued IBD messages for as long as the
+	// upload limits allow it, and schedules ibdTimer for the first one that
+	// has to wait. Every message reserves its share of the limits once it's
+	// first in the queue, and is sent when ibdTimer fires.
+	isFirstIBDMessageReserved := false
+	sendReadyIBDMessages := func() error {
+		for len(ibdQueue) > 0 {
+			queuedMessage := ibdQueue[0]
+			if !isFirstIBDMessageReserved {
+				isFirstIBDMessageReserved = true
+				delay := c.reserveIBDUploadLimits(queuedMessage.messageSize)
+				if delay > 0 {
+					log.Tracef("Delaying IBD upload to %s by %s due to the upload limits", c, delay)
+					ibdTimer = time.NewTimer(delay)
+					ibdTimerChan = ibdTimer.C
+					return nil
+				}
+			}
+
+			ibdQueue[0] = nil
+			ibdQueue = ibdQueue[1:]
+			isFirstIBDMessageReserved = false
+			err := c.sendMessageProto(queuedMessage.command, queuedMessage.messageProto, queuedMessage.messageSize)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	for c.IsConnected() {
-		message, err := outgoingRoute.Dequeue()
-		if err != nil {
-			if errors.Is(err, routerpkg.ErrRouteClosed) {
+		// Once the IBD queue is full, stop taking messages off the
+		// outgoing route until it drains
+		incomingMessages := outgoingMessages
+		if len(ibdQueue) >= maxQueuedIBDMessages {
+			incomingMessages = nil
+		}
+
+		select {
+		case message, ok := <-incomingMessages:
+			if !ok {
 				return nil
 			}
-			return err
+
+			log.Debugf("outgoing '%s' message to %s", message.Command(), c)
+			log.Tracef("outgoing '%s' message to %s: %s", message.Command(), c, logger.NewLogClosure(func() string {
+				return spew.Sdump(message)
+			}))
+
+			messageProto, err := protowire.FromAppMessage(message)
+			if err != nil {
+				return err
+			}
+			messageSize := proto.Size(messageProto)
+
+			if !isIBDUploadCommand(message.Command()) {
+				err = c.sendMessageProto(message.Command(), messageProto, messageSize)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			ibdQueue = append(ibdQueue, &queuedIBDMessage{
+				command:      message.Command(),
+				messageProto: messageProto,
+				messageSize:  messageSize,
+			})
+			if ibdTimerChan == nil {
+				err = sendReadyIBDMessages()
+				if err != nil {
+					return err
+				}
+			}
+
+		case <-ibdTimerChan:
+			ibdTimer = nil
+			ibdTimerChan = nil
+			err := sendReadyIBDMessages()
+			if err != nil {
+				return err
+			}
+
+		case <-c.stopChan:
+			return nil
 		}
+	}
+	return nil
+}
 
-		log.Debugf("outgoing '%s' message to %s", message.Command(), c)
-		log.Tracef("outgoing '%s' message to %s: %s", message.Command(), c, logger.NewLogClosure(func() string {
-			return spew.Sdump(message)
-		}))
+// dequeueOutgoingMessages passes the messages of the outgoing route on to
+// outgoingMessages, which is closed once the route is closed or the connection
+// is stopped
+func (c *gRPCConnection) dequeueOutgoingMessages(outgoingMessages chan<- appmessage.Message) {
+	defer close(outgoingMessages)
 
-		messageProto, err := protowire.FromAppMessage(message)
+	outgoingRoute := c.router.OutgoingRoute()
+	for {
+		message, err := outgoingRoute.Dequeue()
 		if err != nil {
-			return err
+			// Dequeue only fails once the route is closed
+			return
 		}
 
-		err = c.send(messageProto)
-		if err != nil {
-			return err
+		select {
+		case outgoingMessages <- message:
+		case <-c.stopChan:
+			return
 		}
 	}
+}
+
+func (c *gRPCConnection) sendMessageProto(command appmessage.MessageCommand,
+	messageProto *protowire.KaspadMessage, messageSize int) error {
+
+	err := c.send(messageProto)
+	if err != nil {
+		return err
+	}
+	c.trafficCounters.RecordSent(trafficCommandName(command), messageSize)
 	return nil
 }
 
+// reserveIBDUploadLimits reserves messageSize bytes of IBD data from the
+// upload limits, and returns how long to wait before sending them
+func (c *gRPCConnection) reserveIBDUploadLimits(messageSize int) time.Duration {
+	delay := c.ibdUploadLimiter.reserve(messageSize)
+	totalDelay := c.server.totalIBDUploadLimiter.reserve(messageSize)
+	if totalDelay > delay {
+		delay = totalDelay
+	}
+	return delay
+}
+
 func (c *gRPCConnection) receiveLoop() error {
 	messageNumber := uint64(0)
 	for c.IsConnected() {
@@ -72,6 +207,8 @@ func (c *gRPCConnection) receiveLoop() error {
 			return err
 		}
 
+		c.trafficCounters.RecordReceived(trafficCommandName(message.Command()), proto.Size(protoMessage))
+
 		messageNumber++
 		message.SetMessageNumber(messageNumber)
 		message.SetReceivedAt(time.Now())
@@ -103,3 +240,15 @@ func (c *gRPCConnection) receiveLoop() error {
 	}
 	return nil
 }
+
+// trafficCommandName returns the name under which the traffic of messages of
+// the given command is counted
+func trafficCommandName(command appmessage.MessageCommand) string {
+	if name, ok := appmessage.ProtocolMessageCommandToString[command]; ok {
+		return name
+	}
+	if name, ok := appmessage.RPCMessageCommandToString[command]; ok {
+		return name
+	}
+	return command.String()
+}
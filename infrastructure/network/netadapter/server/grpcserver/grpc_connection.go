@@ -1,10 +1,10 @@
 package grpcserver
 
 import (
-	"net"
 	"sync"
 	"sync/atomic"
 
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
@@ -17,7 +17,7 @@ import (
 
 type gRPCConnection struct {
 	server                   *gRPCServer
-	address                  *net.TCPAddr
+	address                  *appmessage.NetAddress
 	stream                   grpcStream
 	router                   *router.Router
 	lowLevelClientConnection *grpc.ClientConn
@@ -33,6 +33,9 @@ type gRPCConnection struct {
 	onDisconnectedHandler   server.OnDisconnectedHandler
 	onInvalidMessageHandler server.OnInvalidMessageHandler
 
+	trafficCounters  *server.TrafficCounters
+	ibdUploadLimiter *rateLimiter
+
 	isConnected uint32
 }
 
@@ -41,7 +44,7 @@ type grpcStream interface {
 	Recv() (*protowire.KaspadMessage, error)
 }
 
-func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
+func newConnection(server *gRPCServer, address *appmessage.NetAddress, stream grpcStream,
 	lowLevelClientConnection *grpc.ClientConn) *gRPCConnection {
 	connection := &gRPCConnection{
 		server:                   server,
@@ -50,6 +53,8 @@ func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
 		stopChan:                 make(chan struct{}),
 		isConnected:              1,
 		lowLevelClientConnection: lowLevelClientConnection,
+		trafficCounters:          server.newConnectionTrafficCounters(),
+		ibdUploadLimiter:         newRateLimiter(server.uploadLimits.PeerIBDBytesPerSecond),
 	}
 
 	return connection
@@ -81,7 +86,7 @@ func (c *gRPCConnection) Start(router *router.Router) {
 }
 
 func (c *gRPCConnection) String() string {
-	return c.Address().String()
+	return c.address.String()
 }
 
 func (c *gRPCConnection) IsConnected() bool {
@@ -123,10 +128,18 @@ func (c *gRPCConnection) Disconnect() {
 	}
 }
 
-func (c *gRPCConnection) Address() *net.TCPAddr {
+// NetAddress returns the address of the remote peer
+// This is part of the Connection interface
+func (c *gRPCConnection) NetAddress() *appmessage.NetAddress {
 	return c.address
 }
 
+// TrafficCounters returns the counters of the traffic over the connection
+// This is part of the Connection interface
+func (c *gRPCConnection) TrafficCounters() *server.TrafficCounters {
+	return c.trafficCounters
+}
+
 func (c *gRPCConnection) receive() (*protowire.KaspadMessage, error) {
 	// We use RLock here and in send() because they can work
 	// in parallel. closeSend(), however, must not have either
@@ -3,6 +3,7 @@ package grpcserver
 import (
 	"context"
 	"fmt"
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/pkg/errors"
@@ -17,6 +18,10 @@ type gRPCServer struct {
 	listeningAddresses []string
 	server             *grpc.Server
 	name               string
+
+	trafficCounters       *server.TrafficCounters
+	uploadLimits          UploadLimits
+	totalIBDUploadLimiter *rateLimiter
 }
 
 // newGRPCServer creates a gRPC server
@@ -26,6 +31,7 @@ func newGRPCServer(listeningAddresses []string, maxMessageSize int, name string)
 		server:             grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)),
 		listeningAddresses: listeningAddresses,
 		name:               name,
+		trafficCounters:    server.NewTrafficCounters(nil),
 	}
 }
 
@@ -85,6 +91,18 @@ func (s *gRPCServer) SetOnConnectedHandler(onConnectedHandler server.OnConnected
 	s.onConnectedHandler = onConnectedHandler
 }
 
+// TrafficCounters returns the counters of the traffic over all the
+// connections of the server
+func (s *gRPCServer) TrafficCounters() *server.TrafficCounters {
+	return s.trafficCounters
+}
+
+// newConnectionTrafficCounters returns the traffic counters of a new connection,
+// which record all the traffic in the counters of the server as well
+func (s *gRPCServer) newConnectionTrafficCounters() *server.TrafficCounters {
+	return server.NewTrafficCounters(s.trafficCounters)
+}
+
 func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStream) error {
 	peerInfo, ok := peer.FromContext(ctx)
 	if !ok {
@@ -95,7 +113,7 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 		return errors.Errorf("non-tcp connections are not supported")
 	}
 
-	connection := newConnection(s, tcpAddress, stream, nil)
+	connection := newConnection(s, appmessage.NewNetAddress(tcpAddress, 0), stream, nil)
 
 	err := s.onConnectedHandler(connection)
 	if err != nil {
@@ -2,6 +2,7 @@ package grpcserver
 
 import (
 	"context"
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/kaspanet/kaspad/util/panics"
@@ -10,20 +11,43 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/peer"
 	"net"
+	"strconv"
 	"time"
 )
 
 type p2pServer struct {
 	protowire.UnimplementedP2PServer
 	gRPCServer
+
+	proxyDial DialFunc
+	onlyOnion bool
 }
 
 const p2pMaxMessageSize = 10 * 1024 * 1024 // 10MB
 
-// NewP2PServer creates a new P2PServer
-func NewP2PServer(listeningAddresses []string) (server.P2PServer, error) {
+// DialFunc is a function that dials a network address within the given timeout,
+// such as net.DialTimeout or the DialTimeout method of a SOCKS5 proxy.
+type DialFunc func(network, address string, timeout time.Duration) (net.Conn, error)
+
+// NewP2PServer creates a new P2PServer.
+// If proxyDial is not nil, all outbound connections are dialed through it,
+// and .onion addresses may be connected to. If onlyOnion is set, connecting
+// to anything other than .onion addresses is refused. The upload of IBD data
+// is limited by uploadLimits.
+func NewP2PServer(listeningAddresses []string, proxyDial DialFunc, onlyOnion bool,
+	uploadLimits UploadLimits) (server.P2PServer, error) {
+
+	if onlyOnion && proxyDial == nil {
+		return nil, errors.New("connecting only to .onion addresses requires a proxy")
+	}
 	gRPCServer := newGRPCServer(listeningAddresses, p2pMaxMessageSize, "P2P")
-	p2pServer := &p2pServer{gRPCServer: *gRPCServer}
+	gRPCServer.uploadLimits = uploadLimits
+	gRPCServer.totalIBDUploadLimiter = newRateLimiter(uploadLimits.TotalIBDBytesPerSecond)
+	p2pServer := &p2pServer{
+		gRPCServer: *gRPCServer,
+		proxyDial:  proxyDial,
+		onlyOnion:  onlyOnion,
+	}
 	protowire.RegisterP2PServer(gRPCServer.server, p2pServer)
 	return p2pServer, nil
 }
@@ -43,7 +67,16 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
-	gRPCClientConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	dialAddress, targetAddress, err := p.resolveDialAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOptions := []grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock()}
+	if p.proxyDial != nil {
+		dialOptions = append(dialOptions, grpc.WithContextDialer(p.dialThroughProxy))
+	}
+	gRPCClientConnection, err := grpc.DialContext(ctx, dialAddress, dialOptions...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "%s error connecting to %s", p.name, address)
 	}
@@ -55,16 +88,22 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 		return nil, errors.Wrapf(err, "%s error getting client stream for %s", p.name, address)
 	}
 
-	peerInfo, ok := peer.FromContext(stream.Context())
-	if !ok {
-		return nil, errors.Errorf("%s error getting stream peer info from context for %s", p.name, address)
-	}
-	tcpAddress, ok := peerInfo.Addr.(*net.TCPAddr)
-	if !ok {
-		return nil, errors.Errorf("non-tcp addresses are not supported")
+	// When dialing through a proxy, the stream's peer is the proxy itself,
+	// so the connection is attributed to the dialed address instead.
+	netAddress := targetAddress
+	if netAddress == nil {
+		peerInfo, ok := peer.FromContext(stream.Context())
+		if !ok {
+			return nil, errors.Errorf("%s error getting stream peer info from context for %s", p.name, address)
+		}
+		tcpAddress, ok := peerInfo.Addr.(*net.TCPAddr)
+		if !ok {
+			return nil, errors.Errorf("non-tcp addresses are not supported")
+		}
+		netAddress = appmessage.NewNetAddress(tcpAddress, 0)
 	}
 
-	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection)
+	connection := newConnection(&p.gRPCServer, netAddress, stream, gRPCClientConnection)
 
 	err = p.onConnectedHandler(connection)
 	if err != nil {
@@ -75,3 +114,55 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 
 	return connection, nil
 }
+
+// resolveDialAddress returns the address that should be passed to the dialer
+// in order to connect to the given address, along with the address the
+// resulting connection should be attributed to. The latter is nil when it
+// should be taken from the established stream.
+//
+// .onion addresses are passed to the proxy as is, so they require one.
+func (p *p2pServer) resolveDialAddress(address string) (string, *appmessage.NetAddress, error) {
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "%s invalid address %s", p.name, address)
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "%s invalid port in address %s", p.name, address)
+	}
+
+	if appmessage.IsOnionHost(host) {
+		publicKey, err := appmessage.OnionHostToPublicKey(host)
+		if err != nil {
+			return "", nil, err
+		}
+		if p.proxyDial == nil {
+			return "", nil, errors.Errorf("%s can not connect to .onion address %s without a proxy", p.name, address)
+		}
+		return address, appmessage.NewOnionNetAddress(publicKey, uint16(port), 0), nil
+	}
+
+	if p.onlyOnion {
+		return "", nil, errors.Errorf("%s refusing to connect to %s: only .onion addresses are allowed", p.name, address)
+	}
+	if p.proxyDial == nil {
+		return address, nil, nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return "", nil, errors.Errorf("%s can not connect to %s through a proxy: only IP and .onion "+
+			"addresses are supported", p.name, address)
+	}
+	return address, appmessage.NewNetAddressIPPort(ip, uint16(port), 0), nil
+}
+
+// dialThroughProxy dials the given address through the configured proxy.
+// The address is passed as is to the proxy, so no DNS lookups are performed
+// locally.
+func (p *p2pServer) dialThroughProxy(ctx context.Context, address string) (net.Conn, error) {
+	timeout := time.Duration(0)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	return p.proxyDial("tcp", address, timeout)
+}
@@ -0,0 +1,73 @@
+package grpcserver
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestResolveDialAddress(t *testing.T) {
+	const onionAddress = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion:16111"
+	proxyDial := func(network, address string, timeout time.Duration) (net.Conn, error) {
+		return nil, nil
+	}
+
+	tests := []struct {
+		name            string
+		proxyDial       DialFunc
+		onlyOnion       bool
+		address         string
+		expectedTarget  string
+		expectedIsOnion bool
+		expectedError   bool
+	}{
+		{name: "ip without a proxy", address: "1.2.3.4:16111"},
+		{name: "host name without a proxy", address: "peer.example.com:16111"},
+		{name: "onion without a proxy", address: onionAddress, expectedError: true},
+		{name: "ip through a proxy", proxyDial: proxyDial, address: "1.2.3.4:16111",
+			expectedTarget: "1.2.3.4:16111"},
+		{name: "host name through a proxy", proxyDial: proxyDial, address: "peer.example.com:16111",
+			expectedError: true},
+		{name: "onion through a proxy", proxyDial: proxyDial, address: onionAddress,
+			expectedTarget: onionAddress, expectedIsOnion: true},
+		{name: "invalid onion through a proxy", proxyDial: proxyDial,
+			address: "a5ccbdkubbr2jlcp.onion:16111", expectedError: true},
+		{name: "ip with only onion", proxyDial: proxyDial, onlyOnion: true, address: "1.2.3.4:16111",
+			expectedError: true},
+		{name: "onion with only onion", proxyDial: proxyDial, onlyOnion: true, address: onionAddress,
+			expectedTarget: onionAddress, expectedIsOnion: true},
+	}
+
+	for _, test := range tests {
+		p := &p2pServer{gRPCServer: gRPCServer{name: "P2P"}, proxyDial: test.proxyDial, onlyOnion: test.onlyOnion}
+		dialAddress, targetAddress, err := p.resolveDialAddress(test.address)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		// Addresses are always dialed as given, so that no lookups are
+		// performed locally when going through a proxy
+		if dialAddress != test.address {
+			t.Errorf("%s: unexpected dial address. Want: %s, got: %s", test.name, test.address, dialAddress)
+		}
+		if test.expectedTarget == "" {
+			if targetAddress != nil {
+				t.Errorf("%s: unexpected target address %s", test.name, targetAddress)
+			}
+			continue
+		}
+		if targetAddress == nil {
+			t.Errorf("%s: missing target address", test.name)
+			continue
+		}
+		if targetAddress.String() != test.expectedTarget || targetAddress.IsOnion() != test.expectedIsOnion {
+			t.Errorf("%s: unexpected target address. Want: %s, got: %s", test.name, test.expectedTarget, targetAddress)
+		}
+	}
+}
@@ -103,20 +103,26 @@ func (x *NetAddress) toAppMessage() (*appmessage.NetAddress, error) {
 	if x.Port > math.MaxUint16 {
 		return nil, errors.Errorf("port number is larger than %d", math.MaxUint16)
 	}
+	if len(x.OnionPublicKey) != 0 && len(x.OnionPublicKey) != appmessage.OnionPublicKeySize {
+		return nil, errors.Errorf("onion public key is of size %d instead of %d",
+			len(x.OnionPublicKey), appmessage.OnionPublicKeySize)
+	}
 	return &appmessage.NetAddress{
-		Timestamp: mstime.UnixMilliseconds(x.Timestamp),
-		Services:  appmessage.ServiceFlag(x.Services),
-		IP:        x.Ip,
-		Port:      uint16(x.Port),
+		Timestamp:      mstime.UnixMilliseconds(x.Timestamp),
+		Services:       appmessage.ServiceFlag(x.Services),
+		IP:             x.Ip,
+		Port:           uint16(x.Port),
+		OnionPublicKey: x.OnionPublicKey,
 	}, nil
 }
 
 func appMessageNetAddressToProto(address *appmessage.NetAddress) *NetAddress {
 	return &NetAddress{
-		Timestamp: address.Timestamp.UnixMilliseconds(),
-		Services:  uint64(address.Services),
-		Ip:        address.IP,
-		Port:      uint32(address.Port),
+		Timestamp:      address.Timestamp.UnixMilliseconds(),
+		Services:       uint64(address.Services),
+		Ip:             address.IP,
+		Port:           uint32(address.Port),
+		OnionPublicKey: address.OnionPublicKey,
 	}
 }
 
@@ -0,0 +1,102 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_ArchivalBlock) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ArchivalBlock is nil")
+	}
+	return x.ArchivalBlock.toAppMessage()
+}
+
+func (x *ArchivalBlockMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ArchivalBlockMessage is nil")
+	}
+	block, err := x.Block.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	acceptanceData := make([]*appmessage.MsgBlockAcceptanceData, len(x.AcceptanceData))
+	for i, blockAcceptanceData := range x.AcceptanceData {
+		acceptanceData[i], err = blockAcceptanceData.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.MsgArchivalBlock{
+		Block:          block,
+		AcceptanceData: acceptanceData,
+	}, nil
+}
+
+func (x *BlockAcceptanceData) toAppMessage() (*appmessage.MsgBlockAcceptanceData, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BlockAcceptanceData is nil")
+	}
+	if len(x.TransactionAcceptanceData) > appmessage.MaxTxPerBlock {
+		return nil, errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", len(x.TransactionAcceptanceData), appmessage.MaxTxPerBlock)
+	}
+	blockHash, err := x.BlockHash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	transactionAcceptanceData := make([]*appmessage.MsgTransactionAcceptanceData, len(x.TransactionAcceptanceData))
+	for i, transaction := range x.TransactionAcceptanceData {
+		if transaction == nil {
+			return nil, errors.Wrapf(errorNil, "TransactionAcceptanceData is nil")
+		}
+		transactionID, err := transaction.TransactionId.toDomain()
+		if err != nil {
+			return nil, err
+		}
+		transactionAcceptanceData[i] = &appmessage.MsgTransactionAcceptanceData{
+			TransactionID: transactionID,
+			Fee:           transaction.Fee,
+			IsAccepted:    transaction.IsAccepted,
+		}
+	}
+
+	return &appmessage.MsgBlockAcceptanceData{
+		BlockHash:                 blockHash,
+		TransactionAcceptanceData: transactionAcceptanceData,
+	}, nil
+}
+
+func (x *KaspadMessage_ArchivalBlock) fromAppMessage(msgArchivalBlock *appmessage.MsgArchivalBlock) error {
+	block := new(BlockMessage)
+	err := block.fromAppMessage(msgArchivalBlock.Block)
+	if err != nil {
+		return err
+	}
+
+	acceptanceData := make([]*BlockAcceptanceData, len(msgArchivalBlock.AcceptanceData))
+	for i, blockAcceptanceData := range msgArchivalBlock.AcceptanceData {
+		transactionAcceptanceData := make([]*TransactionAcceptanceData,
+			len(blockAcceptanceData.TransactionAcceptanceData))
+		for j, transaction := range blockAcceptanceData.TransactionAcceptanceData {
+			transactionAcceptanceData[j] = &TransactionAcceptanceData{
+				TransactionId: domainTransactionIDToProto(transaction.TransactionID),
+				Fee:           transaction.Fee,
+				IsAccepted:    transaction.IsAccepted,
+			}
+		}
+		acceptanceData[i] = &BlockAcceptanceData{
+			BlockHash:                 domainHashToProto(blockAcceptanceData.BlockHash),
+			TransactionAcceptanceData: transactionAcceptanceData,
+		}
+	}
+
+	x.ArchivalBlock = &ArchivalBlockMessage{
+		Block:          block,
+		AcceptanceData: acceptanceData,
+	}
+	return nil
+}
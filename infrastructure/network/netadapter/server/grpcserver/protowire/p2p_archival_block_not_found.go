@@ -0,0 +1,31 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_ArchivalBlockNotFound) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ArchivalBlockNotFound is nil")
+	}
+	return x.ArchivalBlockNotFound.toAppMessage()
+}
+
+func (x *ArchivalBlockNotFoundMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ArchivalBlockNotFoundMessage is nil")
+	}
+	hash, err := x.Hash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+	return appmessage.NewMsgArchivalBlockNotFound(hash), nil
+}
+
+func (x *KaspadMessage_ArchivalBlockNotFound) fromAppMessage(msgArchivalBlockNotFound *appmessage.MsgArchivalBlockNotFound) error {
+	x.ArchivalBlockNotFound = &ArchivalBlockNotFoundMessage{
+		Hash: domainHashToProto(msgArchivalBlockNotFound.Hash),
+	}
+	return nil
+}
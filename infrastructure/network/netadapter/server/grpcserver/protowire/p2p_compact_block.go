@@ -0,0 +1,80 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_CompactBlock) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CompactBlock is nil")
+	}
+	return x.CompactBlock.toAppMessage()
+}
+
+func (x *CompactBlockMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CompactBlockMessage is nil")
+	}
+	transactionCount := len(x.ShortIds) + len(x.PrefilledTransactions)
+	if transactionCount > appmessage.MaxTxPerBlock {
+		return nil, errors.Errorf("too many transactions to fit into a block "+
+			"[count %d, max %d]", transactionCount, appmessage.MaxTxPerBlock)
+	}
+
+	header, err := x.Header.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	prefilledTransactions := make([]*appmessage.PrefilledTransaction, len(x.PrefilledTransactions))
+	for i, protoPrefilledTransaction := range x.PrefilledTransactions {
+		if protoPrefilledTransaction == nil {
+			return nil, errors.Wrapf(errorNil, "PrefilledTransaction is nil")
+		}
+		msgTx, err := protoPrefilledTransaction.Transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		prefilledTransactions[i] = &appmessage.PrefilledTransaction{
+			Index:       protoPrefilledTransaction.Index,
+			Transaction: msgTx.(*appmessage.MsgTx),
+		}
+	}
+
+	return &appmessage.MsgCompactBlock{
+		Header:                *header,
+		ShortIDs:              x.ShortIds,
+		PrefilledTransactions: prefilledTransactions,
+	}, nil
+}
+
+func (x *KaspadMessage_CompactBlock) fromAppMessage(msgCompactBlock *appmessage.MsgCompactBlock) error {
+	if msgCompactBlock.TransactionCount() > appmessage.MaxTxPerBlock {
+		return errors.Errorf("too many transactions to fit into a block "+
+			"[count %d, max %d]", msgCompactBlock.TransactionCount(), appmessage.MaxTxPerBlock)
+	}
+
+	protoHeader := new(BlockHeaderMessage)
+	err := protoHeader.fromAppMessage(&msgCompactBlock.Header)
+	if err != nil {
+		return err
+	}
+
+	protoPrefilledTransactions := make([]*PrefilledTransaction, len(msgCompactBlock.PrefilledTransactions))
+	for i, prefilledTransaction := range msgCompactBlock.PrefilledTransactions {
+		protoTx := new(TransactionMessage)
+		protoTx.fromAppMessage(prefilledTransaction.Transaction)
+		protoPrefilledTransactions[i] = &PrefilledTransaction{
+			Index:       prefilledTransaction.Index,
+			Transaction: protoTx,
+		}
+	}
+
+	x.CompactBlock = &CompactBlockMessage{
+		Header:                protoHeader,
+		ShortIds:              msgCompactBlock.ShortIDs,
+		PrefilledTransactions: protoPrefilledTransactions,
+	}
+	return nil
+}
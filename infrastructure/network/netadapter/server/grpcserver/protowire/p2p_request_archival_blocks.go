@@ -0,0 +1,43 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_RequestArchivalBlocks) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_RequestArchivalBlocks is nil")
+	}
+	return x.RequestArchivalBlocks.toAppMessage()
+}
+
+func (x *RequestArchivalBlocksMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RequestArchivalBlocksMessage is nil")
+	}
+	if len(x.Hashes) > appmessage.MaxRequestArchivalBlocksHashes {
+		return nil, errors.Errorf("too many hashes for message "+
+			"[count %d, max %d]", len(x.Hashes), appmessage.MaxRequestArchivalBlocksHashes)
+	}
+	hashes, err := protoHashesToDomain(x.Hashes)
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.MsgRequestArchivalBlocks{
+		Hashes:                hashes,
+		IncludeAcceptanceData: x.IncludeAcceptanceData,
+	}, nil
+}
+
+func (x *KaspadMessage_RequestArchivalBlocks) fromAppMessage(msgRequestArchivalBlocks *appmessage.MsgRequestArchivalBlocks) error {
+	if len(msgRequestArchivalBlocks.Hashes) > appmessage.MaxRequestArchivalBlocksHashes {
+		return errors.Errorf("too many hashes for message "+
+			"[count %d, max %d]", len(msgRequestArchivalBlocks.Hashes), appmessage.MaxRequestArchivalBlocksHashes)
+	}
+	x.RequestArchivalBlocks = &RequestArchivalBlocksMessage{
+		Hashes:                domainHashesToProto(msgRequestArchivalBlocks.Hashes),
+		IncludeAcceptanceData: msgRequestArchivalBlocks.IncludeAcceptanceData,
+	}
+	return nil
+}
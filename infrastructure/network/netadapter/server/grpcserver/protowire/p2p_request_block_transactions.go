@@ -0,0 +1,46 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_RequestBlockTransactions) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_RequestBlockTransactions is nil")
+	}
+	return x.RequestBlockTransactions.toAppMessage()
+}
+
+func (x *RequestBlockTransactionsMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RequestBlockTransactionsMessage is nil")
+	}
+	if len(x.Indexes) > appmessage.MaxTxPerBlock {
+		return nil, errors.Errorf("too many indexes for message "+
+			"[count %d, max %d]", len(x.Indexes), appmessage.MaxTxPerBlock)
+	}
+	blockHash, err := x.BlockHash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.MsgRequestBlockTransactions{
+		BlockHash: blockHash,
+		Indexes:   x.Indexes,
+	}, nil
+}
+
+func (x *KaspadMessage_RequestBlockTransactions) fromAppMessage(
+	msgRequestBlockTransactions *appmessage.MsgRequestBlockTransactions) error {
+
+	if len(msgRequestBlockTransactions.Indexes) > appmessage.MaxTxPerBlock {
+		return errors.Errorf("too many indexes for message "+
+			"[count %d, max %d]", len(msgRequestBlockTransactions.Indexes), appmessage.MaxTxPerBlock)
+	}
+
+	x.RequestBlockTransactions = &RequestBlockTransactionsMessage{
+		BlockHash: domainHashToProto(msgRequestBlockTransactions.BlockHash),
+		Indexes:   msgRequestBlockTransactions.Indexes,
+	}
+	return nil
+}
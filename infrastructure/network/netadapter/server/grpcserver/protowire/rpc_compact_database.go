@@ -0,0 +1,50 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_CompactDatabaseRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CompactDatabaseRequest is nil")
+	}
+	return &appmessage.CompactDatabaseRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_CompactDatabaseRequest) fromAppMessage(_ *appmessage.CompactDatabaseRequestMessage) error {
+	x.CompactDatabaseRequest = &CompactDatabaseRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_CompactDatabaseResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CompactDatabaseResponse is nil")
+	}
+	return x.CompactDatabaseResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_CompactDatabaseResponse) fromAppMessage(message *appmessage.CompactDatabaseResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.CompactDatabaseResponse = &CompactDatabaseResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *CompactDatabaseResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CompactDatabaseResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.CompactDatabaseResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_CreateDatabaseSnapshotRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CreateDatabaseSnapshotRequest is nil")
+	}
+	return x.CreateDatabaseSnapshotRequest.toAppMessage()
+}
+
+func (x *CreateDatabaseSnapshotRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CreateDatabaseSnapshotRequestMessage is nil")
+	}
+	return &appmessage.CreateDatabaseSnapshotRequestMessage{
+		Path:     x.Path,
+		Compress: x.Compress,
+	}, nil
+}
+
+func (x *KaspadMessage_CreateDatabaseSnapshotRequest) fromAppMessage(message *appmessage.CreateDatabaseSnapshotRequestMessage) error {
+	x.CreateDatabaseSnapshotRequest = &CreateDatabaseSnapshotRequestMessage{
+		Path:     message.Path,
+		Compress: message.Compress,
+	}
+	return nil
+}
+
+func (x *KaspadMessage_CreateDatabaseSnapshotResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CreateDatabaseSnapshotResponse is nil")
+	}
+	return x.CreateDatabaseSnapshotResponse.toAppMessage()
+}
+
+func (x *CreateDatabaseSnapshotResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CreateDatabaseSnapshotResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.CreateDatabaseSnapshotResponseMessage{
+		EntryCount: x.EntryCount,
+		Size:       x.Size,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *KaspadMessage_CreateDatabaseSnapshotResponse) fromAppMessage(message *appmessage.CreateDatabaseSnapshotResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.CreateDatabaseSnapshotResponse = &CreateDatabaseSnapshotResponseMessage{
+		EntryCount: message.EntryCount,
+		Size:       message.Size,
+		Error:      err,
+	}
+	return nil
+}
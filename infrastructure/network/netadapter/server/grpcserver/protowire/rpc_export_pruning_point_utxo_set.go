@@ -0,0 +1,65 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_ExportPruningPointUTXOSetRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ExportPruningPointUTXOSetRequest is nil")
+	}
+	return x.ExportPruningPointUTXOSetRequest.toAppMessage()
+}
+
+func (x *ExportPruningPointUTXOSetRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ExportPruningPointUTXOSetRequestMessage is nil")
+	}
+	return &appmessage.ExportPruningPointUTXOSetRequestMessage{
+		Path: x.Path,
+	}, nil
+}
+
+func (x *KaspadMessage_ExportPruningPointUTXOSetRequest) fromAppMessage(message *appmessage.ExportPruningPointUTXOSetRequestMessage) error {
+	x.ExportPruningPointUTXOSetRequest = &ExportPruningPointUTXOSetRequestMessage{Path: message.Path}
+	return nil
+}
+
+func (x *KaspadMessage_ExportPruningPointUTXOSetResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ExportPruningPointUTXOSetResponse is nil")
+	}
+	return x.ExportPruningPointUTXOSetResponse.toAppMessage()
+}
+
+func (x *ExportPruningPointUTXOSetResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ExportPruningPointUTXOSetResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.ExportPruningPointUTXOSetResponseMessage{
+		PruningPointHash: x.PruningPointHash,
+		HeaderCount:      x.HeaderCount,
+		UTXOCount:        x.UtxoCount,
+		Error:            rpcErr,
+	}, nil
+}
+
+func (x *KaspadMessage_ExportPruningPointUTXOSetResponse) fromAppMessage(message *appmessage.ExportPruningPointUTXOSetResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.ExportPruningPointUTXOSetResponse = &ExportPruningPointUTXOSetResponseMessage{
+		PruningPointHash: message.PruningPointHash,
+		HeaderCount:      message.HeaderCount,
+		UtxoCount:        message.UTXOCount,
+		Error:            err,
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetBlockAcceptanceDataRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetBlockAcceptanceDataRequest is nil")
+	}
+	return x.GetBlockAcceptanceDataRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_GetBlockAcceptanceDataRequest) fromAppMessage(message *appmessage.GetBlockAcceptanceDataRequestMessage) error {
+	x.GetBlockAcceptanceDataRequest = &GetBlockAcceptanceDataRequestMessage{
+		Hash: message.Hash,
+	}
+	return nil
+}
+
+func (x *GetBlockAcceptanceDataRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetBlockAcceptanceDataRequestMessage is nil")
+	}
+	return &appmessage.GetBlockAcceptanceDataRequestMessage{
+		Hash: x.Hash,
+	}, nil
+}
+
+func (x *KaspadMessage_GetBlockAcceptanceDataResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetBlockAcceptanceDataResponse is nil")
+	}
+	return x.GetBlockAcceptanceDataResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetBlockAcceptanceDataResponse) fromAppMessage(message *appmessage.GetBlockAcceptanceDataResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	acceptedBlocks := make([]*AcceptedBlock, len(message.AcceptedBlocks))
+	for i, acceptedBlock := range message.AcceptedBlocks {
+		acceptedBlocks[i] = &AcceptedBlock{
+			Hash:                   acceptedBlock.Hash,
+			AcceptedTransactionIds: acceptedBlock.AcceptedTransactionIDs,
+		}
+	}
+	x.GetBlockAcceptanceDataResponse = &GetBlockAcceptanceDataResponseMessage{
+		AcceptedBlocks: acceptedBlocks,
+		Error:          rpcErr,
+	}
+	return nil
+}
+
+func (x *GetBlockAcceptanceDataResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetBlockAcceptanceDataResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.AcceptedBlocks) != 0 {
+		return nil, errors.New("GetBlockAcceptanceDataResponseMessage contains both an error and a response")
+	}
+	acceptedBlocks := make([]*appmessage.AcceptedBlock, len(x.AcceptedBlocks))
+	for i, acceptedBlock := range x.AcceptedBlocks {
+		acceptedBlocks[i], err = acceptedBlock.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetBlockAcceptanceDataResponseMessage{
+		AcceptedBlocks: acceptedBlocks,
+		Error:          rpcErr,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetCacheStatsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetCacheStatsRequest is nil")
+	}
+	return &appmessage.GetCacheStatsRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_GetCacheStatsRequest) fromAppMessage(_ *appmessage.GetCacheStatsRequestMessage) error {
+	x.GetCacheStatsRequest = &GetCacheStatsRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_GetCacheStatsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetCacheStatsResponse is nil")
+	}
+	return x.GetCacheStatsResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetCacheStatsResponse) fromAppMessage(message *appmessage.GetCacheStatsResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	caches := make([]*CacheStats, len(message.Caches))
+	for i, cache := range message.Caches {
+		caches[i] = &CacheStats{
+			Name:     cache.Name,
+			Size:     cache.Size,
+			Capacity: cache.Capacity,
+			Hits:     cache.Hits,
+			Misses:   cache.Misses,
+		}
+	}
+	x.GetCacheStatsResponse = &GetCacheStatsResponseMessage{
+		Caches: caches,
+		Error:  rpcErr,
+	}
+	return nil
+}
+
+func (x *GetCacheStatsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetCacheStatsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Caches) != 0 {
+		return nil, errors.New("GetCacheStatsResponseMessage contains both an error and a response")
+	}
+	caches := make([]*appmessage.CacheStats, len(x.Caches))
+	for i, cache := range x.Caches {
+		if cache == nil {
+			return nil, errors.Wrapf(errorNil, "CacheStats is nil")
+		}
+		caches[i] = &appmessage.CacheStats{
+			Name:     cache.Name,
+			Size:     cache.Size,
+			Capacity: cache.Capacity,
+			Hits:     cache.Hits,
+			Misses:   cache.Misses,
+		}
+	}
+
+	return &appmessage.GetCacheStatsResponseMessage{
+		Caches: caches,
+		Error:  rpcErr,
+	}, nil
+}
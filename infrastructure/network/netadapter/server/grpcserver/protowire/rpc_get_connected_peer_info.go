@@ -37,6 +37,14 @@ func (x *KaspadMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *app
 			AdvertisedProtocolVersion: info.AdvertisedProtocolVersion,
 			TimeConnected:             info.TimeOffset,
 			IsIbdPeer:                 info.IsIBDPeer,
+			BanScore:                  info.BanScore,
+			BytesSent:                 info.BytesSent,
+			BytesReceived:             info.BytesReceived,
+			MessagesSent:              info.MessagesSent,
+			MessagesReceived:          info.MessagesReceived,
+			SentPerCommand:            messageTrafficStatsFromAppMessage(info.SentPerCommand),
+			ReceivedPerCommand:        messageTrafficStatsFromAppMessage(info.ReceivedPerCommand),
+			IsArchival:                info.IsArchival,
 		}
 	}
 	x.GetConnectedPeerInfoResponse = &GetConnectedPeerInfoResponseMessage{
@@ -78,6 +86,14 @@ func (x *GetConnectedPeerInfoMessage) toAppMessage() (*appmessage.GetConnectedPe
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "GetConnectedPeerInfoMessage is nil")
 	}
+	sentPerCommand, err := messageTrafficStatsToAppMessage(x.SentPerCommand)
+	if err != nil {
+		return nil, err
+	}
+	receivedPerCommand, err := messageTrafficStatsToAppMessage(x.ReceivedPerCommand)
+	if err != nil {
+		return nil, err
+	}
 	return &appmessage.GetConnectedPeerInfoMessage{
 		ID:                        x.Id,
 		Address:                   x.Address,
@@ -88,5 +104,13 @@ func (x *GetConnectedPeerInfoMessage) toAppMessage() (*appmessage.GetConnectedPe
 		AdvertisedProtocolVersion: x.AdvertisedProtocolVersion,
 		TimeConnected:             x.TimeOffset,
 		IsIBDPeer:                 x.IsIbdPeer,
+		BanScore:                  x.BanScore,
+		BytesSent:                 x.BytesSent,
+		BytesReceived:             x.BytesReceived,
+		MessagesSent:              x.MessagesSent,
+		MessagesReceived:          x.MessagesReceived,
+		SentPerCommand:            sentPerCommand,
+		ReceivedPerCommand:        receivedPerCommand,
+		IsArchival:                x.IsArchival,
 	}, nil
 }
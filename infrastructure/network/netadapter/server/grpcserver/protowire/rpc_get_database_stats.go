@@ -0,0 +1,78 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetDatabaseStatsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetDatabaseStatsRequest is nil")
+	}
+	return &appmessage.GetDatabaseStatsRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_GetDatabaseStatsRequest) fromAppMessage(_ *appmessage.GetDatabaseStatsRequestMessage) error {
+	x.GetDatabaseStatsRequest = &GetDatabaseStatsRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_GetDatabaseStatsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetDatabaseStatsResponse is nil")
+	}
+	return x.GetDatabaseStatsResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetDatabaseStatsResponse) fromAppMessage(message *appmessage.GetDatabaseStatsResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	stores := make([]*DatabaseStoreStats, len(message.Stores))
+	for i, store := range message.Stores {
+		stores[i] = &DatabaseStoreStats{
+			Name:       store.Name,
+			KeyCount:   store.KeyCount,
+			KeysSize:   store.KeysSize,
+			ValuesSize: store.ValuesSize,
+		}
+	}
+	x.GetDatabaseStatsResponse = &GetDatabaseStatsResponseMessage{
+		Stores: stores,
+		Error:  rpcErr,
+	}
+	return nil
+}
+
+func (x *GetDatabaseStatsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetDatabaseStatsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Stores) != 0 {
+		return nil, errors.New("GetDatabaseStatsResponseMessage contains both an error and a response")
+	}
+	stores := make([]*appmessage.DatabaseStoreStats, len(x.Stores))
+	for i, store := range x.Stores {
+		if store == nil {
+			return nil, errors.Wrapf(errorNil, "DatabaseStoreStats is nil")
+		}
+		stores[i] = &appmessage.DatabaseStoreStats{
+			Name:       store.Name,
+			KeyCount:   store.KeyCount,
+			KeysSize:   store.KeysSize,
+			ValuesSize: store.ValuesSize,
+		}
+	}
+
+	return &appmessage.GetDatabaseStatsResponseMessage{
+		Stores: stores,
+		Error:  rpcErr,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetNetTotalsRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.GetNetTotalsRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_GetNetTotalsRequest) fromAppMessage(_ *appmessage.GetNetTotalsRequestMessage) error {
+	x.GetNetTotalsRequest = &GetNetTotalsRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_GetNetTotalsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetNetTotalsResponse is nil")
+	}
+	return x.GetNetTotalsResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetNetTotalsResponse) fromAppMessage(message *appmessage.GetNetTotalsResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetNetTotalsResponse = &GetNetTotalsResponseMessage{
+		TotalBytesSent:        message.TotalBytesSent,
+		TotalBytesReceived:    message.TotalBytesReceived,
+		TotalMessagesSent:     message.TotalMessagesSent,
+		TotalMessagesReceived: message.TotalMessagesReceived,
+		SentPerCommand:        messageTrafficStatsFromAppMessage(message.SentPerCommand),
+		ReceivedPerCommand:    messageTrafficStatsFromAppMessage(message.ReceivedPerCommand),
+		Error:                 err,
+	}
+	return nil
+}
+
+func (x *GetNetTotalsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetNetTotalsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	sentPerCommand, err := messageTrafficStatsToAppMessage(x.SentPerCommand)
+	if err != nil {
+		return nil, err
+	}
+	receivedPerCommand, err := messageTrafficStatsToAppMessage(x.ReceivedPerCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appmessage.GetNetTotalsResponseMessage{
+		TotalBytesSent:        x.TotalBytesSent,
+		TotalBytesReceived:    x.TotalBytesReceived,
+		TotalMessagesSent:     x.TotalMessagesSent,
+		TotalMessagesReceived: x.TotalMessagesReceived,
+		SentPerCommand:        sentPerCommand,
+		ReceivedPerCommand:    receivedPerCommand,
+		Error:                 rpcErr,
+	}, nil
+}
+
+func (x *MessageTrafficStats) toAppMessage() (*appmessage.MessageTrafficStats, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "MessageTrafficStats is nil")
+	}
+	return &appmessage.MessageTrafficStats{
+		Command:      x.Command,
+		MessageCount: x.MessageCount,
+		ByteCount:    x.ByteCount,
+	}, nil
+}
+
+func messageTrafficStatsToAppMessage(stats []*MessageTrafficStats) ([]*appmessage.MessageTrafficStats, error) {
+	appStats := make([]*appmessage.MessageTrafficStats, len(stats))
+	for i, commandStats := range stats {
+		appCommandStats, err := commandStats.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		appStats[i] = appCommandStats
+	}
+	return appStats, nil
+}
+
+func messageTrafficStatsFromAppMessage(appStats []*appmessage.MessageTrafficStats) []*MessageTrafficStats {
+	stats := make([]*MessageTrafficStats, len(appStats))
+	for i, appCommandStats := range appStats {
+		stats[i] = &MessageTrafficStats{
+			Command:      appCommandStats.Command,
+			MessageCount: appCommandStats.MessageCount,
+			ByteCount:    appCommandStats.ByteCount,
+		}
+	}
+	return stats
+}
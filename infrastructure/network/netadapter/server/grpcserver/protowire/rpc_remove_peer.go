@@ -0,0 +1,59 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_RemovePeerRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_RemovePeerRequest is nil")
+	}
+	return x.RemovePeerRequest.toAppMessage()
+}
+
+func (x *RemovePeerRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RemovePeerRequestMessage is nil")
+	}
+	return &appmessage.RemovePeerRequestMessage{
+		Address: x.Address,
+	}, nil
+}
+
+func (x *KaspadMessage_RemovePeerRequest) fromAppMessage(message *appmessage.RemovePeerRequestMessage) error {
+	x.RemovePeerRequest = &RemovePeerRequestMessage{Address: message.Address}
+	return nil
+}
+
+func (x *KaspadMessage_RemovePeerResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_RemovePeerResponse is nil")
+	}
+	return x.RemovePeerResponse.toAppMessage()
+}
+
+func (x *RemovePeerResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RemovePeerResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.RemovePeerResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *KaspadMessage_RemovePeerResponse) fromAppMessage(message *appmessage.RemovePeerResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.RemovePeerResponse = &RemovePeerResponseMessage{
+		Error: err,
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_ResetUTXOIndexRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ResetUTXOIndexRequest is nil")
+	}
+	return &appmessage.ResetUTXOIndexRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_ResetUTXOIndexRequest) fromAppMessage(_ *appmessage.ResetUTXOIndexRequestMessage) error {
+	x.ResetUTXOIndexRequest = &ResetUTXOIndexRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_ResetUTXOIndexResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ResetUTXOIndexResponse is nil")
+	}
+	return x.ResetUTXOIndexResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_ResetUTXOIndexResponse) fromAppMessage(message *appmessage.ResetUTXOIndexResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.ResetUTXOIndexResponse = &ResetUTXOIndexResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *ResetUTXOIndexResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ResetUTXOIndexResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.ResetUTXOIndexResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
@@ -282,6 +282,55 @@ func toP2PPayload(message appmessage.Message) (isKaspadMessage_Payload, error) {
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.MsgRequestCompactBlock:
+		payload := new(KaspadMessage_RequestCompactBlock)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgCompactBlock:
+		payload := new(KaspadMessage_CompactBlock)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgRequestBlockTransactions:
+		payload := new(KaspadMessage_RequestBlockTransactions)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgBlockTransactions:
+		payload := new(KaspadMessage_BlockTransactions)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgRequestArchivalBlocks:
+		payload := new(KaspadMessage_RequestArchivalBlocks)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgArchivalBlock:
+		payload := new(KaspadMessage_ArchivalBlock)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgArchivalBlockNotFound:
+		payload := new(KaspadMessage_ArchivalBlockNotFound)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
@@ -779,6 +828,160 @@ func toRPCPayload(message appmessage.Message) (isKaspadMessage_Payload, error) {
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.GetNetTotalsRequestMessage:
+		payload := new(KaspadMessage_GetNetTotalsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetNetTotalsResponseMessage:
+		payload := new(KaspadMessage_GetNetTotalsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.DisconnectPeerRequestMessage:
+		payload := new(KaspadMessage_DisconnectPeerRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.DisconnectPeerResponseMessage:
+		payload := new(KaspadMessage_DisconnectPeerResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetConnectionRequestsRequestMessage:
+		payload := new(KaspadMessage_GetConnectionRequestsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetConnectionRequestsResponseMessage:
+		payload := new(KaspadMessage_GetConnectionRequestsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.RemovePeerRequestMessage:
+		payload := new(KaspadMessage_RemovePeerRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.RemovePeerResponseMessage:
+		payload := new(KaspadMessage_RemovePeerResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.CreateDatabaseSnapshotRequestMessage:
+		payload := new(KaspadMessage_CreateDatabaseSnapshotRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.CreateDatabaseSnapshotResponseMessage:
+		payload := new(KaspadMessage_CreateDatabaseSnapshotResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ExportPruningPointUTXOSetRequestMessage:
+		payload := new(KaspadMessage_ExportPruningPointUTXOSetRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ExportPruningPointUTXOSetResponseMessage:
+		payload := new(KaspadMessage_ExportPruningPointUTXOSetResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetDatabaseStatsRequestMessage:
+		payload := new(KaspadMessage_GetDatabaseStatsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetDatabaseStatsResponseMessage:
+		payload := new(KaspadMessage_GetDatabaseStatsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.CompactDatabaseRequestMessage:
+		payload := new(KaspadMessage_CompactDatabaseRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.CompactDatabaseResponseMessage:
+		payload := new(KaspadMessage_CompactDatabaseResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ResetUTXOIndexRequestMessage:
+		payload := new(KaspadMessage_ResetUTXOIndexRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ResetUTXOIndexResponseMessage:
+		payload := new(KaspadMessage_ResetUTXOIndexResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetCacheStatsRequestMessage:
+		payload := new(KaspadMessage_GetCacheStatsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetCacheStatsResponseMessage:
+		payload := new(KaspadMessage_GetCacheStatsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetBlockAcceptanceDataRequestMessage:
+		payload := new(KaspadMessage_GetBlockAcceptanceDataRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetBlockAcceptanceDataResponseMessage:
+		payload := new(KaspadMessage_GetBlockAcceptanceDataResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
@@ -0,0 +1,79 @@
+package grpcserver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+)
+
+// UploadLimits are the maximum rates, in bytes per second, at which the bulk
+// data requested during IBD is uploaded. Zero means unlimited.
+type UploadLimits struct {
+	// PeerIBDBytesPerSecond limits the upload to every single peer
+	PeerIBDBytesPerSecond int
+
+	// TotalIBDBytesPerSecond limits the upload to all the peers together
+	TotalIBDBytesPerSecond int
+}
+
+// ibdUploadCommands are the commands of the messages that are subject to the
+// IBD upload limits. Other messages, such as relayed blocks and pings, are
+// never delayed.
+var ibdUploadCommands = map[appmessage.MessageCommand]struct{}{
+	appmessage.CmdIBDBlock:                 {},
+	appmessage.CmdBlockHeaders:             {},
+	appmessage.CmdPruningPointUTXOSetChunk: {},
+}
+
+func isIBDUploadCommand(command appmessage.MessageCommand) bool {
+	_, ok := ibdUploadCommands[command]
+	return ok
+}
+
+// rateLimiter is a token bucket that limits a rate of bytes per second,
+// allowing bursts of up to a second's worth of bytes. It is safe for
+// concurrent use.
+type rateLimiter struct {
+	lock           sync.Mutex
+	bytesPerSecond float64
+	available      float64
+	lastUpdate     time.Time
+}
+
+// newRateLimiter returns a rateLimiter for the given rate, or nil if the
+// rate is zero, which means unlimited
+func newRateLimiter(bytesPerSecond int) *rateLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		bytesPerSecond: float64(bytesPerSecond),
+		available:      float64(bytesPerSecond),
+		lastUpdate:     time.Now(),
+	}
+}
+
+// reserve takes the given amount of bytes from the bucket, and returns how long
+// the caller has to wait before sending them. A nil rateLimiter never waits.
+func (rl *rateLimiter) reserve(byteCount int) time.Duration {
+	if rl == nil {
+		return 0
+	}
+
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	now := time.Now()
+	rl.available += now.Sub(rl.lastUpdate).Seconds() * rl.bytesPerSecond
+	if rl.available > rl.bytesPerSecond {
+		rl.available = rl.bytesPerSecond
+	}
+	rl.lastUpdate = now
+
+	rl.available -= float64(byteCount)
+	if rl.available >= 0 {
+		return 0
+	}
+	return time.Duration(-rl.available / rl.bytesPerSecond * float64(time.Second))
+}
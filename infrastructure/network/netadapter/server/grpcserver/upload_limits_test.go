@@ -0,0 +1,108 @@
+package grpcserver
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+)
+
+func TestRateLimiter(t *testing.T) {
+	// A zero rate is unlimited
+	unlimited := newRateLimiter(0)
+	if delay := unlimited.reserve(1_000_000); delay != 0 {
+		t.Fatalf("Unexpected delay from an unlimited rate limiter: %s", delay)
+	}
+
+	const bytesPerSecond = 1000
+	limiter := newRateLimiter(bytesPerSecond)
+
+	// A second's worth of bytes may be sent right away
+	if delay := limiter.reserve(bytesPerSecond); delay != 0 {
+		t.Fatalf("Unexpected delay within the burst: %s", delay)
+	}
+
+	// Anything beyond that has to wait for the bucket to refill
+	delay := limiter.reserve(bytesPerSecond / 2)
+	if delay < 400*time.Millisecond || delay > 500*time.Millisecond {
+		t.Fatalf("Unexpected delay after the burst. Want: ~500ms, got: %s", delay)
+	}
+
+	// Reserved bytes accumulate, so the next message waits for the
+	// previous one as well
+	delay = limiter.reserve(bytesPerSecond)
+	if delay < 1400*time.Millisecond || delay > 1500*time.Millisecond {
+		t.Fatalf("Unexpected delay of a queued message. Want: ~1.5s, got: %s", delay)
+	}
+}
+
+// fakeStream is a grpcStream that passes the sent messages to a channel and
+// receives nothing until it's closed
+type fakeStream struct {
+	sent   chan *protowire.KaspadMessage
+	closed chan struct{}
+}
+
+func (s *fakeStream) Send(message *protowire.KaspadMessage) error {
+	s.sent <- message
+	return nil
+}
+
+func (s *fakeStream) Recv() (*protowire.KaspadMessage, error) {
+	<-s.closed
+	return nil, io.EOF
+}
+
+func TestIBDUploadLimitsDontDelayOtherMessages(t *testing.T) {
+	const bytesPerSecond = 100
+	gRPCServer := &gRPCServer{
+		trafficCounters: server.NewTrafficCounters(nil),
+		uploadLimits:    UploadLimits{PeerIBDBytesPerSecond: bytesPerSecond},
+	}
+	stream := &fakeStream{sent: make(chan *protowire.KaspadMessage, 2), closed: make(chan struct{})}
+	defer close(stream.closed)
+	address := appmessage.NewNetAddressIPPort(net.ParseIP("1.2.3.4"), 16111, 0)
+	connection := newConnection(gRPCServer, address, stream, nil)
+	connection.SetOnDisconnectedHandler(func() {})
+	defer connection.Disconnect()
+
+	// Use up the burst and more, so that the next IBD message has to wait
+	// for about half a second
+	connection.ibdUploadLimiter.reserve(bytesPerSecond * 3 / 2)
+
+	connectionRouter := router.NewRouter()
+	connection.Start(connectionRouter)
+	err := connectionRouter.OutgoingRoute().Enqueue(appmessage.NewBlockHeadersMessage(nil))
+	if err != nil {
+		t.Fatalf("Enqueue() failed: %s", err)
+	}
+	err = connectionRouter.OutgoingRoute().Enqueue(appmessage.NewMsgPing(1))
+	if err != nil {
+		t.Fatalf("Enqueue() failed: %s", err)
+	}
+
+	// The ping is sent right away, while the IBD message is throttled
+	select {
+	case message := <-stream.sent:
+		if _, ok := message.Payload.(*protowire.KaspadMessage_Ping); !ok {
+			t.Fatalf("Expected the ping to be sent first, got: %T", message.Payload)
+		}
+	case <-time.After(200 * time.Millisecond):
+		t.Fatalf("The ping was delayed by the throttled IBD message")
+	}
+
+	// The IBD message is sent once the upload limits allow it
+	select {
+	case message := <-stream.sent:
+		if _, ok := message.Payload.(*protowire.KaspadMessage_BlockHeaders); !ok {
+			t.Fatalf("Expected the IBD message to be sent, got: %T", message.Payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("The throttled IBD message was never sent")
+	}
+}
@@ -2,8 +2,8 @@ package server
 
 import (
 	"fmt"
-	"net"
 
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 )
 
@@ -25,6 +25,7 @@ type Server interface {
 	Start() error
 	Stop() error
 	SetOnConnectedHandler(onConnectedHandler OnConnectedHandler)
+	TrafficCounters() *TrafficCounters
 }
 
 // P2PServer represents a p2p server.
@@ -42,5 +43,6 @@ type Connection interface {
 	IsOutbound() bool
 	SetOnDisconnectedHandler(onDisconnectedHandler OnDisconnectedHandler)
 	SetOnInvalidMessageHandler(onInvalidMessageHandler OnInvalidMessageHandler)
-	Address() *net.TCPAddr
+	NetAddress() *appmessage.NetAddress
+	TrafficCounters() *TrafficCounters
 }
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"sync"
+)
+
+// TrafficCounter counts messages and the bytes they took up
+type TrafficCounter struct {
+	MessageCount uint64
+	ByteCount    uint64
+}
+
+func (tc *TrafficCounter) add(byteCount int) {
+	tc.MessageCount++
+	tc.ByteCount += uint64(byteCount)
+}
+
+// TrafficCounters counts the messages sent and received over connections,
+// in total and per message command. It is safe for concurrent use.
+type TrafficCounters struct {
+	lock               sync.RWMutex
+	sent               TrafficCounter
+	received           TrafficCounter
+	sentPerCommand     map[string]*TrafficCounter
+	receivedPerCommand map[string]*TrafficCounter
+
+	parent *TrafficCounters
+}
+
+// NewTrafficCounters returns new TrafficCounters. If parent is not nil, all the
+// traffic recorded in the returned counters is recorded in parent as well.
+func NewTrafficCounters(parent *TrafficCounters) *TrafficCounters {
+	return &TrafficCounters{
+		sentPerCommand:     make(map[string]*TrafficCounter),
+		receivedPerCommand: make(map[string]*TrafficCounter),
+		parent:             parent,
+	}
+}
+
+// RecordSent records that a message of the given command and size was sent
+func (tc *TrafficCounters) RecordSent(command string, byteCount int) {
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	tc.sent.add(byteCount)
+	recordPerCommand(tc.sentPerCommand, command, byteCount)
+
+	if tc.parent != nil {
+		tc.parent.RecordSent(command, byteCount)
+	}
+}
+
+// RecordReceived records that a message of the given command and size was received
+func (tc *TrafficCounters) RecordReceived(command string, byteCount int) {
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	tc.received.add(byteCount)
+	recordPerCommand(tc.receivedPerCommand, command, byteCount)
+
+	if tc.parent != nil {
+		tc.parent.RecordReceived(command, byteCount)
+	}
+}
+
+func recordPerCommand(perCommand map[string]*TrafficCounter, command string, byteCount int) {
+	counter, ok := perCommand[command]
+	if !ok {
+		counter = &TrafficCounter{}
+		perCommand[command] = counter
+	}
+	counter.add(byteCount)
+}
+
+// Sent returns the total count of messages and bytes sent
+func (tc *TrafficCounters) Sent() TrafficCounter {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+
+	return tc.sent
+}
+
+// Received returns the total count of messages and bytes received
+func (tc *TrafficCounters) Received() TrafficCounter {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+
+	return tc.received
+}
+
+// SentPerCommand returns the count of messages and bytes sent per message command
+func (tc *TrafficCounters) SentPerCommand() map[string]TrafficCounter {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+
+	return copyPerCommand(tc.sentPerCommand)
+}
+
+// ReceivedPerCommand returns the count of messages and bytes received per message command
+func (tc *TrafficCounters) ReceivedPerCommand() map[string]TrafficCounter {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+
+	return copyPerCommand(tc.receivedPerCommand)
+}
+
+func copyPerCommand(perCommand map[string]*TrafficCounter) map[string]TrafficCounter {
+	perCommandCopy := make(map[string]TrafficCounter, len(perCommand))
+	for command, counter := range perCommand {
+		perCommandCopy[command] = *counter
+	}
+	return perCommandCopy
+}
@@ -68,6 +68,7 @@ func (mna *MinimalNetAdapter) Connect(address string) (*Routes, error) {
 	routes := <-mna.routesChan
 	err = mna.handleHandshake(routes, mna.netAdapter.ID())
 	if err != nil {
+		routes.Disconnect()
 		return nil, errors.Wrap(err, "Error in handshake")
 	}
 
@@ -112,6 +113,7 @@ func (mna *MinimalNetAdapter) handleHandshake(routes *Routes, ourID *id.ID) erro
 	if !ok {
 		return errors.Errorf("expected first message to be of type %s, but got %s", appmessage.CmdVersion, msg.Command())
 	}
+	routes.peerVersion = versionMessage
 	err = routes.OutgoingRoute.Enqueue(&appmessage.MsgVersion{
 		ProtocolVersion: versionMessage.ProtocolVersion,
 		Network:         mna.cfg.ActiveNetParams.Name,
@@ -166,10 +168,11 @@ func (mna *MinimalNetAdapter) handleHandshake(routes *Routes, ourID *id.ID) erro
 	if err != nil {
 		return err
 	}
-	_, ok = msg.(*appmessage.MsgAddresses)
+	addressesMessage, ok := msg.(*appmessage.MsgAddresses)
 	if !ok {
 		return errors.Errorf("expected fourth message to be of type %s, but got %s", appmessage.CmdAddresses, msg.Command())
 	}
+	routes.peerAddresses = addressesMessage.AddressList
 
 	return nil
 }
@@ -18,6 +18,20 @@ type Routes struct {
 	handshakeRoute               *router.Route
 	addressesRoute               *router.Route
 	pingRoute                    *router.Route
+
+	peerVersion   *appmessage.MsgVersion
+	peerAddresses []*appmessage.NetAddress
+}
+
+// PeerVersion returns the version message the peer sent during the handshake
+func (r *Routes) PeerVersion() *appmessage.MsgVersion {
+	return r.peerVersion
+}
+
+// PeerAddresses returns the addresses the peer sent in response to the
+// address request made during the handshake
+func (r *Routes) PeerAddresses() []*appmessage.NetAddress {
+	return r.peerAddresses
 }
 
 // WaitForMessageOfType waits for a message of requested type up to `timeout`, skipping all messages of any other type
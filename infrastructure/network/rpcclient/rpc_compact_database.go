@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// CompactDatabase sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) CompactDatabase() (*appmessage.CompactDatabaseResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewCompactDatabaseRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdCompactDatabaseResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	compactDatabaseResponse := response.(*appmessage.CompactDatabaseResponseMessage)
+	if compactDatabaseResponse.Error != nil {
+		return nil, c.convertRPCError(compactDatabaseResponse.Error)
+	}
+	return compactDatabaseResponse, nil
+}
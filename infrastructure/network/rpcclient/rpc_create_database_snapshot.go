@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// CreateDatabaseSnapshot sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) CreateDatabaseSnapshot(path string, compress bool) (*appmessage.CreateDatabaseSnapshotResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewCreateDatabaseSnapshotRequestMessage(path, compress))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdCreateDatabaseSnapshotResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	createDatabaseSnapshotResponse := response.(*appmessage.CreateDatabaseSnapshotResponseMessage)
+	if createDatabaseSnapshotResponse.Error != nil {
+		return nil, c.convertRPCError(createDatabaseSnapshotResponse.Error)
+	}
+	return createDatabaseSnapshotResponse, nil
+}
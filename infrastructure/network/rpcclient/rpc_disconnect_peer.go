@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// DisconnectPeer sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) DisconnectPeer(address string) error {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewDisconnectPeerRequestMessage(address))
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdDisconnectPeerResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	disconnectPeerResponse := response.(*appmessage.DisconnectPeerResponseMessage)
+	if disconnectPeerResponse.Error != nil {
+		return c.convertRPCError(disconnectPeerResponse.Error)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// ExportPruningPointUTXOSet sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) ExportPruningPointUTXOSet(path string) (*appmessage.ExportPruningPointUTXOSetResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewExportPruningPointUTXOSetRequestMessage(path))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdExportPruningPointUTXOSetResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	exportPruningPointUTXOSetResponse := response.(*appmessage.ExportPruningPointUTXOSetResponseMessage)
+	if exportPruningPointUTXOSetResponse.Error != nil {
+		return nil, c.convertRPCError(exportPruningPointUTXOSetResponse.Error)
+	}
+	return exportPruningPointUTXOSetResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// GetBlockAcceptanceData sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetBlockAcceptanceData(hash string) (*appmessage.GetBlockAcceptanceDataResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetBlockAcceptanceDataRequestMessage(hash))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetBlockAcceptanceDataResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getBlockAcceptanceDataResponse := response.(*appmessage.GetBlockAcceptanceDataResponseMessage)
+	if getBlockAcceptanceDataResponse.Error != nil {
+		return nil, c.convertRPCError(getBlockAcceptanceDataResponse.Error)
+	}
+	return getBlockAcceptanceDataResponse, nil
+}
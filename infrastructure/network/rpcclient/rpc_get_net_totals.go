@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// GetNetTotals sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetNetTotals() (*appmessage.GetNetTotalsResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetNetTotalsRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetNetTotalsResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getNetTotalsResponse := response.(*appmessage.GetNetTotalsResponseMessage)
+	if getNetTotalsResponse.Error != nil {
+		return nil, c.convertRPCError(getNetTotalsResponse.Error)
+	}
+	return getNetTotalsResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// RemovePeer sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) RemovePeer(address string) error {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewRemovePeerRequestMessage(address))
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdRemovePeerResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	removePeerResponse := response.(*appmessage.RemovePeerResponseMessage)
+	if removePeerResponse.Error != nil {
+		return c.convertRPCError(removePeerResponse.Error)
+	}
+	return nil
+}
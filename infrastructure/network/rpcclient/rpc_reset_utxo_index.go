@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// ResetUTXOIndex sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) ResetUTXOIndex() (*appmessage.ResetUTXOIndexResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewResetUTXOIndexRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdResetUTXOIndexResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	resetUTXOIndexResponse := response.(*appmessage.ResetUTXOIndexResponseMessage)
+	if resetUTXOIndexResponse.Error != nil {
+		return nil, c.convertRPCError(resetUTXOIndexResponse.Error)
+	}
+	return resetUTXOIndexResponse, nil
+}
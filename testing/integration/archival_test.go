@@ -0,0 +1,116 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/standalone"
+)
+
+func TestArchivalBlocks(t *testing.T) {
+	const numBlocks = 30
+
+	overrideDAGParams := dagconfig.SimnetParams
+
+	// This is done to make a pruning depth of 6 blocks
+	overrideDAGParams.FinalityDuration = 2 * overrideDAGParams.TargetTimePerBlock
+	overrideDAGParams.K = 0
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		overrideDAGParams:       &overrideDAGParams,
+		archival:                true,
+	})
+	defer teardown()
+
+	firstBlock := mineNextBlock(t, harness)
+	firstBlockHash := consensushashing.BlockHash(firstBlock)
+	for i := 1; i < numBlocks; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	dagInfo, err := harness.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %+v", err)
+	}
+	if dagInfo.PruningPointHash == overrideDAGParams.GenesisHash.String() ||
+		dagInfo.PruningPointHash == firstBlockHash.String() {
+		t.Fatalf("Expected the first block to be below the pruning point")
+	}
+
+	getBlockResponse, err := harness.rpcClient.GetBlock(firstBlockHash.String(), false)
+	if err != nil {
+		t.Fatalf("GetBlock: %+v", err)
+	}
+	if getBlockResponse.BlockVerboseData.IsHeaderOnly {
+		t.Fatalf("Expected the body of pruned block %s to be kept by an archival node", firstBlockHash)
+	}
+	if len(getBlockResponse.BlockVerboseData.TxIDs) != len(firstBlock.Transactions) {
+		t.Fatalf("Unexpected number of transaction IDs. Want: %d, got: %d",
+			len(firstBlock.Transactions), len(getBlockResponse.BlockVerboseData.TxIDs))
+	}
+
+	getBlockAcceptanceDataResponse, err := harness.rpcClient.GetBlockAcceptanceData(firstBlockHash.String())
+	if err != nil {
+		t.Fatalf("GetBlockAcceptanceData: %+v", err)
+	}
+	// The first block merges only the genesis
+	if len(getBlockAcceptanceDataResponse.AcceptedBlocks) != 1 ||
+		getBlockAcceptanceDataResponse.AcceptedBlocks[0].Hash != overrideDAGParams.GenesisHash.String() {
+		t.Fatalf("Unexpected accepted blocks for %s: %v", firstBlockHash, getBlockAcceptanceDataResponse.AcceptedBlocks)
+	}
+
+	adapterConfig := commonConfig()
+	adapterConfig.ActiveNetParams = &overrideDAGParams
+	adapterConfig.Listeners = nil
+	adapterConfig.RPCListeners = nil
+	adapter, err := standalone.NewMinimalNetAdapter(adapterConfig)
+	if err != nil {
+		t.Fatalf("NewMinimalNetAdapter: %+v", err)
+	}
+	routes, err := adapter.Connect(harness.p2pAddress)
+	if err != nil {
+		t.Fatalf("Connect: %+v", err)
+	}
+	defer routes.Disconnect()
+
+	if !routes.PeerVersion().HasService(appmessage.SFNodeArchival) {
+		t.Fatalf("Expected the archival node to advertise %s", appmessage.SFNodeArchival)
+	}
+
+	// A block that isn't stored is answered with ArchivalBlockNotFound, and
+	// the rest of the request is still served
+	unknownHash := externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{0xff})
+	err = routes.OutgoingRoute.Enqueue(appmessage.NewMsgRequestArchivalBlocks(
+		[]*externalapi.DomainHash{unknownHash, firstBlockHash}, true))
+	if err != nil {
+		t.Fatalf("Enqueue: %+v", err)
+	}
+	message, err := routes.WaitForMessageOfType(appmessage.CmdArchivalBlockNotFound, defaultTimeout)
+	if err != nil {
+		t.Fatalf("WaitForMessageOfType: %+v", err)
+	}
+	msgArchivalBlockNotFound := message.(*appmessage.MsgArchivalBlockNotFound)
+	if !msgArchivalBlockNotFound.Hash.Equal(unknownHash) {
+		t.Fatalf("Received ArchivalBlockNotFound for %s instead of %s", msgArchivalBlockNotFound.Hash, unknownHash)
+	}
+
+	message, err = routes.WaitForMessageOfType(appmessage.CmdArchivalBlock, defaultTimeout)
+	if err != nil {
+		t.Fatalf("WaitForMessageOfType: %+v", err)
+	}
+	msgArchivalBlock := message.(*appmessage.MsgArchivalBlock)
+	receivedBlock := appmessage.MsgBlockToDomainBlock(msgArchivalBlock.Block)
+	if !consensushashing.BlockHash(receivedBlock).Equal(firstBlockHash) {
+		t.Fatalf("Received block %s instead of %s", consensushashing.BlockHash(receivedBlock), firstBlockHash)
+	}
+	if len(msgArchivalBlock.AcceptanceData) != 1 ||
+		!msgArchivalBlock.AcceptanceData[0].BlockHash.Equal(overrideDAGParams.GenesisHash) {
+		t.Fatalf("Unexpected acceptance data for archival block %s", firstBlockHash)
+	}
+}
@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestGetCacheStats(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	const blockCount = 5
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	response, err := harness.rpcClient.GetCacheStats()
+	if err != nil {
+		t.Fatalf("Error getting the cache stats: %+v", err)
+	}
+
+	cachesByName := make(map[string]int)
+	for i, cache := range response.Caches {
+		cachesByName[cache.Name] = i
+		if cache.Size > cache.Capacity {
+			t.Fatalf("Cache %s holds %d entries, more than its capacity of %d",
+				cache.Name, cache.Size, cache.Capacity)
+		}
+	}
+
+	headersCacheIndex, ok := cachesByName["block-headers"]
+	if !ok {
+		t.Fatalf("The block headers cache is missing from the cache stats")
+	}
+	headersCache := response.Caches[headersCacheIndex]
+	// The genesis is cached as well
+	if headersCache.Size != blockCount+1 {
+		t.Fatalf("Unexpected number of cached headers. Want: %d, got: %d", blockCount+1, headersCache.Size)
+	}
+	if headersCache.Hits == 0 {
+		t.Fatalf("The block headers cache unexpectedly has no hits")
+	}
+}
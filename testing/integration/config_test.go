@@ -33,9 +33,11 @@ const (
 func setConfig(t *testing.T, harness *appHarness) {
 	harness.config = commonConfig()
 	harness.config.DataDir = randomDirectory(t)
+	harness.config.SnapshotDir = randomDirectory(t)
 	harness.config.Listeners = []string{harness.p2pAddress}
 	harness.config.RPCListeners = []string{harness.rpcAddress}
 	harness.config.UTXOIndex = harness.utxoIndex
+	harness.config.IsArchivalNode = harness.archival
 
 	if harness.overrideDAGParams != nil {
 		harness.config.ActiveNetParams = harness.overrideDAGParams
@@ -0,0 +1,43 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestCompactDatabase(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	const blockCount = 5
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	statsBefore, err := harness.rpcClient.GetDatabaseStats()
+	if err != nil {
+		t.Fatalf("Error getting the database stats: %+v", err)
+	}
+
+	_, err = harness.rpcClient.CompactDatabase()
+	if err != nil {
+		t.Fatalf("Error compacting the database: %+v", err)
+	}
+
+	// Compaction must not change the contents of the database
+	statsAfter, err := harness.rpcClient.GetDatabaseStats()
+	if err != nil {
+		t.Fatalf("Error getting the database stats: %+v", err)
+	}
+	for i, storeBefore := range statsBefore.Stores {
+		storeAfter := statsAfter.Stores[i]
+		if *storeAfter != *storeBefore {
+			t.Fatalf("The compaction changed store %s. Before: %+v, after: %+v",
+				storeBefore.Name, storeBefore, storeAfter)
+		}
+	}
+}
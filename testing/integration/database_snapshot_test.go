@@ -0,0 +1,80 @@
+package integration
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+)
+
+func TestCreateDatabaseSnapshot(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	const blockCount = 5
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	_, err := harness.rpcClient.CreateDatabaseSnapshot(filepath.Join(randomDirectory(t), "snapshot.gz"), false)
+	if err == nil {
+		t.Fatalf("CreateDatabaseSnapshot unexpectedly accepted a path outside of the snapshot directory")
+	}
+	_, err = harness.rpcClient.CreateDatabaseSnapshot("../snapshot.gz", false)
+	if err == nil {
+		t.Fatalf("CreateDatabaseSnapshot unexpectedly accepted a path that escapes the snapshot directory")
+	}
+
+	response, err := harness.rpcClient.CreateDatabaseSnapshot("snapshot.gz", true)
+	if err != nil {
+		t.Fatalf("Error creating a database snapshot: %+v", err)
+	}
+	if response.EntryCount == 0 || response.Size == 0 {
+		t.Fatalf("Unexpectedly empty snapshot: %d entries, %d bytes", response.EntryCount, response.Size)
+	}
+
+	snapshotPath := filepath.Join(harness.config.SnapshotDir, "snapshot.gz")
+	_, err = harness.rpcClient.CreateDatabaseSnapshot(snapshotPath, true)
+	if err == nil {
+		t.Fatalf("CreateDatabaseSnapshot unexpectedly overwrote an existing snapshot")
+	}
+
+	// The restored database must have the same DAG as the node
+	restoredDB, err := ldb.NewLevelDB(randomDirectory(t), 8)
+	if err != nil {
+		t.Fatalf("Error opening a database: %+v", err)
+	}
+	defer restoredDB.Close()
+	restoredEntryCount, err := dbsnapshot.Restore(snapshotPath, restoredDB, harness.config.ActiveNetParams.Name)
+	if err != nil {
+		t.Fatalf("Error restoring the database snapshot: %+v", err)
+	}
+	if restoredEntryCount != response.EntryCount {
+		t.Fatalf("Restored %d entries. Want: %d", restoredEntryCount, response.EntryCount)
+	}
+	restoredDomain, err := domain.New(harness.config.ActiveNetParams, restoredDB, false,
+		consensus.DefaultCacheSizes(harness.config.ActiveNetParams))
+	if err != nil {
+		t.Fatalf("Error loading the restored database: %+v", err)
+	}
+	restoredSelectedTip, err := restoredDomain.Consensus().GetVirtualSelectedParent()
+	if err != nil {
+		t.Fatalf("Error getting the restored selected tip: %+v", err)
+	}
+	selectedTipResponse, err := harness.rpcClient.GetSelectedTipHash()
+	if err != nil {
+		t.Fatalf("Error getting the selected tip: %+v", err)
+	}
+	if restoredSelectedTip.String() != selectedTipResponse.SelectedTipHash {
+		t.Fatalf("Unexpected restored selected tip. Want: %s, got: %s",
+			selectedTipResponse.SelectedTipHash, restoredSelectedTip)
+	}
+}
@@ -0,0 +1,41 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestGetDatabaseStats(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+	})
+	defer teardown()
+
+	const blockCount = 5
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	response, err := harness.rpcClient.GetDatabaseStats()
+	if err != nil {
+		t.Fatalf("Error getting the database stats: %+v", err)
+	}
+
+	keyCounts := make(map[string]uint64)
+	for _, store := range response.Stores {
+		keyCounts[store.Name] = store.KeyCount
+	}
+	// The genesis is stored as well
+	if keyCounts["blocks"] != blockCount+1 {
+		t.Fatalf("Unexpected number of blocks. Want: %d, got: %d", blockCount+1, keyCounts["blocks"])
+	}
+	if keyCounts["headers"] != blockCount+1 {
+		t.Fatalf("Unexpected number of headers. Want: %d, got: %d", blockCount+1, keyCounts["headers"])
+	}
+	if keyCounts["utxoindex"] == 0 {
+		t.Fatalf("The UTXO index is unexpectedly empty")
+	}
+}
@@ -0,0 +1,43 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportPruningPointUTXOSet(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	mineNextBlock(t, harness)
+
+	_, err := harness.rpcClient.ExportPruningPointUTXOSet(filepath.Join(randomDirectory(t), "pruningpoint"))
+	if err == nil {
+		t.Fatalf("ExportPruningPointUTXOSet unexpectedly accepted a path outside of the snapshot directory")
+	}
+
+	response, err := harness.rpcClient.ExportPruningPointUTXOSet("pruningpoint")
+	if err != nil {
+		t.Fatalf("Error exporting the pruning point: %+v", err)
+	}
+	if response.PruningPointHash != harness.config.ActiveNetParams.GenesisHash.String() {
+		t.Fatalf("Unexpected pruning point. Want: %s, got: %s",
+			harness.config.ActiveNetParams.GenesisHash, response.PruningPointHash)
+	}
+	exportPath := filepath.Join(harness.config.SnapshotDir, "pruningpoint")
+	_, err = os.Stat(exportPath)
+	if err != nil {
+		t.Fatalf("Error finding the pruning point file: %+v", err)
+	}
+
+	_, err = harness.rpcClient.ExportPruningPointUTXOSet(exportPath)
+	if err == nil {
+		t.Fatalf("ExportPruningPointUTXOSet unexpectedly overwrote an existing file")
+	}
+}
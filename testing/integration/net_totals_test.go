@@ -0,0 +1,56 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestNetTotals(t *testing.T) {
+	appHarness1, appHarness2, _, teardown := standardSetup(t)
+	defer teardown()
+
+	connect(t, appHarness1, appHarness2)
+
+	netTotals, err := appHarness1.rpcClient.GetNetTotals()
+	if err != nil {
+		t.Fatalf("Error getting net totals: %+v", err)
+	}
+	if netTotals.TotalMessagesSent == 0 || netTotals.TotalBytesSent == 0 {
+		t.Fatalf("Unexpectedly no sent messages were counted")
+	}
+	if netTotals.TotalMessagesReceived == 0 || netTotals.TotalBytesReceived == 0 {
+		t.Fatalf("Unexpectedly no received messages were counted")
+	}
+
+	// Both nodes sent each other a version message during the handshake
+	var versionMessagesSent, versionMessagesReceived uint64
+	for _, stats := range netTotals.SentPerCommand {
+		if stats.Command == "Version" {
+			versionMessagesSent = stats.MessageCount
+		}
+	}
+	for _, stats := range netTotals.ReceivedPerCommand {
+		if stats.Command == "Version" {
+			versionMessagesReceived = stats.MessageCount
+		}
+	}
+	if versionMessagesSent != 1 || versionMessagesReceived != 1 {
+		t.Fatalf("Unexpected version message counts. Want: 1 sent and 1 received, "+
+			"got: %d sent and %d received", versionMessagesSent, versionMessagesReceived)
+	}
+
+	connectedPeerInfo, err := appHarness1.rpcClient.GetConnectedPeerInfo()
+	if err != nil {
+		t.Fatalf("Error getting connected peer info: %+v", err)
+	}
+	if len(connectedPeerInfo.Infos) != 1 {
+		t.Fatalf("Unexpected amount of connected peers. Want: 1, got: %d", len(connectedPeerInfo.Infos))
+	}
+	peerInfo := connectedPeerInfo.Infos[0]
+	if peerInfo.MessagesSent == 0 || peerInfo.BytesSent == 0 {
+		t.Fatalf("Unexpected traffic with the peer: %d messages and %d bytes sent",
+			peerInfo.MessagesSent, peerInfo.BytesSent)
+	}
+	if len(peerInfo.SentPerCommand) == 0 || len(peerInfo.ReceivedPerCommand) == 0 {
+		t.Fatalf("Unexpectedly no per command traffic with the peer")
+	}
+}
@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeerManagement(t *testing.T) {
+	appHarness1, appHarness2, _, teardown := standardSetup(t)
+	defer teardown()
+
+	err := appHarness2.rpcClient.AddPeer(appHarness1.p2pAddress, true)
+	if err != nil {
+		t.Fatalf("Error adding peer: %+v", err)
+	}
+	waitUntil(t, "the apps to connect", func() bool {
+		return isConnected(t, appHarness1, appHarness2)
+	})
+
+	connectionRequests, err := appHarness2.rpcClient.GetConnectionRequests()
+	if err != nil {
+		t.Fatalf("Error getting connection requests: %+v", err)
+	}
+	if len(connectionRequests.ConnectionRequests) != 1 {
+		t.Fatalf("Unexpected amount of connection requests. Want: 1, got: %d",
+			len(connectionRequests.ConnectionRequests))
+	}
+	connectionRequest := connectionRequests.ConnectionRequests[0]
+	if connectionRequest.Address != appHarness1.p2pAddress || !connectionRequest.IsPermanent || !connectionRequest.IsConnected {
+		t.Fatalf("Unexpected connection request: %+v", connectionRequest)
+	}
+
+	err = appHarness2.rpcClient.DisconnectPeer("127.0.0.1:1")
+	if err == nil {
+		t.Fatalf("Expected an error when disconnecting a peer that isn't connected")
+	}
+	err = appHarness2.rpcClient.DisconnectPeer(appHarness1.p2pAddress)
+	if err != nil {
+		t.Fatalf("Error disconnecting peer: %+v", err)
+	}
+	waitUntil(t, "the apps to disconnect", func() bool {
+		return !isConnected(t, appHarness1, appHarness2)
+	})
+
+	// Removing the peer drops its connection request, so that it's not reconnected to
+	err = appHarness2.rpcClient.RemovePeer(appHarness1.p2pAddress)
+	if err != nil {
+		t.Fatalf("Error removing peer: %+v", err)
+	}
+	connectionRequests, err = appHarness2.rpcClient.GetConnectionRequests()
+	if err != nil {
+		t.Fatalf("Error getting connection requests: %+v", err)
+	}
+	if len(connectionRequests.ConnectionRequests) != 0 {
+		t.Fatalf("Unexpected connection requests after removing the peer: %+v",
+			connectionRequests.ConnectionRequests)
+	}
+	err = appHarness2.rpcClient.RemovePeer(appHarness1.p2pAddress)
+	if err == nil {
+		t.Fatalf("Expected an error when removing a peer that was already removed")
+	}
+}
+
+func waitUntil(t *testing.T, description string, condition func() bool) {
+	timeout := time.After(defaultTimeout)
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for !condition() {
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			t.Fatalf("Timed out waiting for %s", description)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+)
+
+func TestResetUTXOIndex(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+	})
+	defer teardown()
+
+	const blockCount = 10
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	utxosBefore, err := harness.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+	if err != nil {
+		t.Fatalf("Error getting the UTXOs: %+v", err)
+	}
+	if len(utxosBefore.Entries) == 0 {
+		t.Fatalf("The mining address has no UTXOs")
+	}
+
+	_, err = harness.rpcClient.ResetUTXOIndex()
+	if err != nil {
+		t.Fatalf("Error resetting the UTXO index: %+v", err)
+	}
+
+	var utxosAfter *appmessage.GetUTXOsByAddressesResponseMessage
+	waitUntil(t, "the UTXO index to be rebuilt", func() bool {
+		utxosAfter, err = harness.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+		if err != nil {
+			if !strings.Contains(err.Error(), "not ready") {
+				t.Fatalf("Error getting the UTXOs: %+v", err)
+			}
+			return false
+		}
+		return true
+	})
+
+	if len(utxosAfter.Entries) != len(utxosBefore.Entries) {
+		t.Fatalf("Unexpected amount of UTXOs after the rebuild. Want: %d, got: %d",
+			len(utxosBefore.Entries), len(utxosAfter.Entries))
+	}
+	for _, entryBefore := range utxosBefore.Entries {
+		found := false
+		for _, entryAfter := range utxosAfter.Entries {
+			if *entryAfter.Outpoint == *entryBefore.Outpoint {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("UTXO %s:%d is missing after the rebuild",
+				entryBefore.Outpoint.TransactionID, entryBefore.Outpoint.Index)
+		}
+	}
+}
@@ -23,6 +23,7 @@ type appHarness struct {
 	config                  *config.Config
 	database                database.Database
 	utxoIndex               bool
+	archival                bool
 	overrideDAGParams       *dagconfig.Params
 }
 
@@ -32,6 +33,7 @@ type harnessParams struct {
 	miningAddress           string
 	miningAddressPrivateKey string
 	utxoIndex               bool
+	archival                bool
 	overrideDAGParams       *dagconfig.Params
 }
 
@@ -43,6 +45,7 @@ func setupHarness(t *testing.T, params *harnessParams) (harness *appHarness, tea
 		miningAddress:           params.miningAddress,
 		miningAddressPrivateKey: params.miningAddressPrivateKey,
 		utxoIndex:               params.utxoIndex,
+		archival:                params.archival,
 		overrideDAGParams:       params.overrideDAGParams,
 	}
 
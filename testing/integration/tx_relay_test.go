@@ -76,6 +76,50 @@ func TestTxRelay(t *testing.T) {
 	case <-time.After(defaultTimeout):
 		t.Fatalf("Timeout waiting for transaction to be accepted into mempool")
 	}
+
+	// Mine a block that contains the transaction. The nodes already have the
+	// transaction in their mempools, so they're expected to reconstruct the
+	// block out of its compact form without requesting any of its transactions
+	receivedMessageCountsBefore := receivedMessageCounts(t, payee)
+	blockWithTransaction := mineNextBlock(t, payer)
+	if len(blockWithTransaction.Transactions) != 2 {
+		t.Fatalf("Expected the mined block to contain 2 transactions, got %d",
+			len(blockWithTransaction.Transactions))
+	}
+	select {
+	case header := <-payeeBlockAddedChan:
+		blockHash := consensushashing.BlockHash(blockWithTransaction)
+		payeeBlockHash := consensushashing.HeaderHash(appmessage.BlockHeaderToDomainBlockHeader(header))
+		if !payeeBlockHash.Equal(blockHash) {
+			t.Fatalf("Expected the payee to receive block %s, got %s", blockHash, payeeBlockHash)
+		}
+	case <-time.After(defaultTimeout):
+		t.Fatalf("Timeout waiting for block added")
+	}
+
+	receivedMessageCountsAfter := receivedMessageCounts(t, payee)
+	expectedReceivedMessages := map[string]uint64{"CompactBlock": 1, "BlockTransactions": 0, "Block": 0}
+	for command, expectedCount := range expectedReceivedMessages {
+		count := receivedMessageCountsAfter[command] - receivedMessageCountsBefore[command]
+		if count != expectedCount {
+			t.Fatalf("Expected the payee to receive %d %s messages for the block, got %d",
+				expectedCount, command, count)
+		}
+	}
+}
+
+// receivedMessageCounts returns the number of messages of each command the
+// given harness received from its peers
+func receivedMessageCounts(t *testing.T, harness *appHarness) map[string]uint64 {
+	netTotals, err := harness.rpcClient.GetNetTotals()
+	if err != nil {
+		t.Fatalf("Error getting net totals: %+v", err)
+	}
+	counts := make(map[string]uint64)
+	for _, stats := range netTotals.ReceivedPerCommand {
+		counts[stats.Command] = stats.MessageCount
+	}
+	return counts
 }
 
 func waitForPayeeToReceiveBlock(t *testing.T, payeeBlockAddedChan chan *appmessage.MsgBlockHeader) {
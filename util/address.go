@@ -23,6 +23,9 @@ const (
 	// PubKeyHash addresses always have the version byte set to 0.
 	pubKeyHashAddrID = 0x00
 
+	// PubKey addresses always have the version byte set to 1.
+	pubKeyAddrID = 0x01
+
 	// ScriptHash addresses always have the version byte set to 8.
 	scriptHashAddrID = 0x08
 )
@@ -79,11 +82,11 @@ func (prefix Bech32Prefix) String() string {
 }
 
 // encodeAddress returns a human-readable payment address given a network prefix
-// and a ripemd160 hash which encodes the kaspa network and address type. It is used
-// in both pay-to-pubkey-hash (P2PKH) and pay-to-script-hash (P2SH) address
-// encoding.
-func encodeAddress(prefix Bech32Prefix, hash160 []byte, version byte) string {
-	return bech32.Encode(prefix.String(), hash160[:ripemd160.Size], version)
+// and a payload which encodes the kaspa network and address type. It is used
+// in pay-to-pubkey (P2PK), pay-to-pubkey-hash (P2PKH) and pay-to-script-hash
+// (P2SH) address encoding.
+func encodeAddress(prefix Bech32Prefix, payload []byte, version byte) string {
+	return bech32.Encode(prefix.String(), payload, version)
 }
 
 // Address is an interface type for any type of destination a transaction
@@ -150,11 +153,72 @@ func DecodeAddress(addr string, expectedPrefix Bech32Prefix) (Address, error) {
 		default:
 			return nil, ErrUnknownAddressType
 		}
+	case PublicKeySize: // P2PK
+		switch version {
+		case pubKeyAddrID:
+			return NewAddressPubKey(decoded, prefix)
+		default:
+			return nil, ErrUnknownAddressType
+		}
 	default:
 		return nil, errors.New("decoded address is of unknown size")
 	}
 }
 
+// PublicKeySize is the size in bytes of the serialized Schnorr public key
+// that a pay-to-pubkey (P2PK) address pays to.
+const PublicKeySize = 32
+
+// AddressPubKey is an Address for a pay-to-pubkey (P2PK)
+// transaction.
+type AddressPubKey struct {
+	prefix    Bech32Prefix
+	publicKey [PublicKeySize]byte
+}
+
+// NewAddressPubKey returns a new AddressPubKey. publicKey must be a
+// serialized 32-byte Schnorr public key.
+func NewAddressPubKey(publicKey []byte, prefix Bech32Prefix) (*AddressPubKey, error) {
+	// Check for a valid public key length.
+	if len(publicKey) != PublicKeySize {
+		return nil, errors.Errorf("publicKey must be %d bytes", PublicKeySize)
+	}
+
+	addr := &AddressPubKey{prefix: prefix}
+	copy(addr.publicKey[:], publicKey)
+	return addr, nil
+}
+
+// EncodeAddress returns the string encoding of a pay-to-pubkey
+// address. Part of the Address interface.
+func (a *AddressPubKey) EncodeAddress() string {
+	return encodeAddress(a.prefix, a.publicKey[:], pubKeyAddrID)
+}
+
+// ScriptAddress returns the bytes to be included in a txout script to pay
+// to a public key. Part of the Address interface.
+func (a *AddressPubKey) ScriptAddress() []byte {
+	return a.publicKey[:]
+}
+
+// IsForPrefix returns whether or not the pay-to-pubkey address is associated
+// with the passed kaspa network.
+func (a *AddressPubKey) IsForPrefix(prefix Bech32Prefix) bool {
+	return a.prefix == prefix
+}
+
+// Prefix returns the prefix for this address
+func (a *AddressPubKey) Prefix() Bech32Prefix {
+	return a.prefix
+}
+
+// String returns a human-readable string for the pay-to-pubkey address.
+// This is equivalent to calling EncodeAddress, but is provided so the type can
+// be used as a fmt.Stringer.
+func (a *AddressPubKey) String() string {
+	return a.EncodeAddress()
+}
+
 // AddressPubKeyHash is an Address for a pay-to-pubkey-hash (P2PKH)
 // transaction.
 type AddressPubKeyHash struct {
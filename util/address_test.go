@@ -207,6 +207,71 @@ func TestAddresses(t *testing.T) {
 			passedPrefix:   util.Bech32PrefixKaspa,
 			expectedPrefix: util.Bech32PrefixKaspa,
 		},
+
+		// Positive P2PK tests.
+		{
+			name:    "mainnet p2pk",
+			addr:    "kaspa:qyps5ygcrunz6dpmgfy4q467v4k8x75p3z8ed8dy4wetnsx8em2ac32a7jd4h",
+			encoded: "kaspa:qyps5ygcrunz6dpmgfy4q467v4k8x75p3z8ed8dy4wetnsx8em2ac32a7jd4h",
+			valid:   true,
+			result: util.TstAddressPubKey(
+				util.Bech32PrefixKaspa,
+				[util.PublicKeySize]byte{
+					0x03, 0x0a, 0x11, 0x18, 0x1f, 0x26, 0x2d, 0x34, 0x3b, 0x42,
+					0x49, 0x50, 0x57, 0x5e, 0x65, 0x6c, 0x73, 0x7a, 0x81, 0x88,
+					0x8f, 0x96, 0x9d, 0xa4, 0xab, 0xb2, 0xb9, 0xc0, 0xc7, 0xce,
+					0xd5, 0xdc}),
+			f: func() (util.Address, error) {
+				publicKey := []byte{
+					0x03, 0x0a, 0x11, 0x18, 0x1f, 0x26, 0x2d, 0x34, 0x3b, 0x42,
+					0x49, 0x50, 0x57, 0x5e, 0x65, 0x6c, 0x73, 0x7a, 0x81, 0x88,
+					0x8f, 0x96, 0x9d, 0xa4, 0xab, 0xb2, 0xb9, 0xc0, 0xc7, 0xce,
+					0xd5, 0xdc}
+				return util.NewAddressPubKey(publicKey, util.Bech32PrefixKaspa)
+			},
+			passedPrefix:   util.Bech32PrefixKaspa,
+			expectedPrefix: util.Bech32PrefixKaspa,
+		},
+		{
+			name:    "testnet p2pk",
+			addr:    "kaspatest:qyps5ygcrunz6dpmgfy4q467v4k8x75p3z8ed8dy4wetnsx8em2acsvm9anyn",
+			encoded: "kaspatest:qyps5ygcrunz6dpmgfy4q467v4k8x75p3z8ed8dy4wetnsx8em2acsvm9anyn",
+			valid:   true,
+			result: util.TstAddressPubKey(
+				util.Bech32PrefixKaspaTest,
+				[util.PublicKeySize]byte{
+					0x03, 0x0a, 0x11, 0x18, 0x1f, 0x26, 0x2d, 0x34, 0x3b, 0x42,
+					0x49, 0x50, 0x57, 0x5e, 0x65, 0x6c, 0x73, 0x7a, 0x81, 0x88,
+					0x8f, 0x96, 0x9d, 0xa4, 0xab, 0xb2, 0xb9, 0xc0, 0xc7, 0xce,
+					0xd5, 0xdc}),
+			f: func() (util.Address, error) {
+				publicKey := []byte{
+					0x03, 0x0a, 0x11, 0x18, 0x1f, 0x26, 0x2d, 0x34, 0x3b, 0x42,
+					0x49, 0x50, 0x57, 0x5e, 0x65, 0x6c, 0x73, 0x7a, 0x81, 0x88,
+					0x8f, 0x96, 0x9d, 0xa4, 0xab, 0xb2, 0xb9, 0xc0, 0xc7, 0xce,
+					0xd5, 0xdc}
+				return util.NewAddressPubKey(publicKey, util.Bech32PrefixKaspaTest)
+			},
+			passedPrefix:   util.Bech32PrefixUnknown,
+			expectedPrefix: util.Bech32PrefixKaspaTest,
+		},
+
+		// Negative P2PK tests.
+		{
+			name:  "p2pk wrong public key length",
+			addr:  "",
+			valid: false,
+			f: func() (util.Address, error) {
+				publicKey := []byte{
+					0x03, 0x0a, 0x11, 0x18, 0x1f, 0x26, 0x2d, 0x34, 0x3b, 0x42,
+					0x49, 0x50, 0x57, 0x5e, 0x65, 0x6c, 0x73, 0x7a, 0x81, 0x88,
+					0x8f, 0x96, 0x9d, 0xa4, 0xab, 0xb2, 0xb9, 0xc0, 0xc7, 0xce,
+					0xd5}
+				return util.NewAddressPubKey(publicKey, util.Bech32PrefixKaspa)
+			},
+			passedPrefix:   util.Bech32PrefixKaspa,
+			expectedPrefix: util.Bech32PrefixKaspa,
+		},
 	}
 
 	for _, test := range tests {
@@ -246,6 +311,9 @@ func TestAddresses(t *testing.T) {
 
 			case *util.AddressScriptHash:
 				saddr = util.TstAddressSAddr(encoded)
+
+			case *util.AddressPubKey:
+				saddr = util.TstAddressSAddrP2PK(encoded)
 			}
 
 			// Check script address, as well as the Hash160 method for P2PKH and
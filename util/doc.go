@@ -20,8 +20,8 @@ Address Overview
 The Address interface provides an abstraction for a kaspa address. While the
 most common type is a pay-to-pubkey-hash, kaspa already supports others and
 may well support more in the future. This package currently provides
-implementations for the pay-to-pubkey-hash, and pay-to-script-hash address
-types.
+implementations for the pay-to-pubkey, pay-to-pubkey-hash, and
+pay-to-script-hash address types.
 
 To decode/encode an address:
 
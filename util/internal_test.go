@@ -39,6 +39,22 @@ func TstAddressScriptHash(prefix Bech32Prefix, hash [ripemd160.Size]byte) *Addre
 	}
 }
 
+// TstAddressPubKey makes an AddressPubKey, setting the
+// unexported fields with the parameters publicKey and prefix.
+func TstAddressPubKey(prefix Bech32Prefix, publicKey [PublicKeySize]byte) *AddressPubKey {
+	return &AddressPubKey{
+		prefix:    prefix,
+		publicKey: publicKey,
+	}
+}
+
+// TstAddressSAddrP2PK returns the expected script address bytes for
+// P2PK kaspa addresses.
+func TstAddressSAddrP2PK(addr string) []byte {
+	_, decoded, _, _ := bech32.Decode(addr)
+	return decoded[:PublicKeySize]
+}
+
 // TstAddressSAddr returns the expected script address bytes for
 // P2PKH and P2SH kaspa addresses.
 func TstAddressSAddr(addr string) []byte {
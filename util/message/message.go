@@ -0,0 +1,109 @@
+/*
+Package message provides signing and verification of arbitrary messages with
+the keys that control kaspa addresses.
+
+A message is signed with a Schnorr signature over a domain-separated hash of
+its contents, so a message signature can never be replayed as a transaction
+signature. Since Schnorr public keys can't be recovered from a signature, the
+serialized signature carries the public key alongside it, and verification
+checks that this public key matches the given address.
+*/
+package message
+
+import (
+	"bytes"
+
+	"github.com/kaspanet/go-secp256k1"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/hashes"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+// SignatureSize is the size in bytes of a serialized message signature:
+// a Schnorr signature followed by the public key of the signer
+const SignatureSize = secp256k1.SerializedSchnorrSignatureSize + secp256k1.SerializedSchnorrPublicKeySize
+
+// ErrInvalidSignature indicates that a message signature does not prove
+// control over the address it was checked against
+var ErrInvalidSignature = errors.New("invalid message signature")
+
+// Hash returns the domain-separated hash of the given message
+func Hash(message string) *externalapi.DomainHash {
+	writer := hashes.NewPersonalMessageSigningHashWriter()
+	writer.InfallibleWrite([]byte(message))
+	return writer.Finalize()
+}
+
+// Sign signs the given message with the given private key, and returns the
+// serialized signature
+func Sign(privateKey *secp256k1.SchnorrKeyPair, message string) ([]byte, error) {
+	publicKey, err := privateKey.SchnorrPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	serializedPublicKey, err := publicKey.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	secpHash := secp256k1.Hash(*Hash(message).ByteArray())
+	signature, err := privateKey.SchnorrSign(&secpHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot sign message")
+	}
+
+	serializedSignature := make([]byte, 0, SignatureSize)
+	serializedSignature = append(serializedSignature, signature.Serialize()[:]...)
+	serializedSignature = append(serializedSignature, serializedPublicKey[:]...)
+	return serializedSignature, nil
+}
+
+// Verify checks that the given serialized signature was created over the given
+// message by the key that controls the given address. It returns
+// ErrInvalidSignature if it was not
+func Verify(address util.Address, message string, serializedSignature []byte) error {
+	if len(serializedSignature) != SignatureSize {
+		return errors.Wrapf(ErrInvalidSignature, "signature must be %d bytes long, but got %d",
+			SignatureSize, len(serializedSignature))
+	}
+	signatureBytes := serializedSignature[:secp256k1.SerializedSchnorrSignatureSize]
+	publicKeyBytes := serializedSignature[secp256k1.SerializedSchnorrSignatureSize:]
+
+	err := verifyPublicKeyMatchesAddress(address, publicKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	publicKey, err := secp256k1.DeserializeSchnorrPubKey(publicKeyBytes)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "malformed public key: %s", err)
+	}
+	signature, err := secp256k1.DeserializeSchnorrSignatureFromSlice(signatureBytes)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "malformed signature: %s", err)
+	}
+
+	secpHash := secp256k1.Hash(*Hash(message).ByteArray())
+	if !publicKey.SchnorrVerify(&secpHash, signature) {
+		return errors.Wrapf(ErrInvalidSignature, "signature does not match the message")
+	}
+	return nil
+}
+
+func verifyPublicKeyMatchesAddress(address util.Address, publicKey []byte) error {
+	switch address := address.(type) {
+	case *util.AddressPubKeyHash:
+		if !bytes.Equal(util.Hash160(publicKey), address.ScriptAddress()) {
+			return errors.Wrapf(ErrInvalidSignature, "public key does not belong to address %s", address)
+		}
+		return nil
+	case *util.AddressPubKey:
+		if !bytes.Equal(publicKey, address.ScriptAddress()) {
+			return errors.Wrapf(ErrInvalidSignature, "public key does not belong to address %s", address)
+		}
+		return nil
+	default:
+		return errors.Errorf("message signatures can't be verified for address %s of type %T", address, address)
+	}
+}
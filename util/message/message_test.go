@@ -0,0 +1,106 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/kaspanet/go-secp256k1"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+func newTestKeyAndAddress(t *testing.T) (*secp256k1.SchnorrKeyPair, util.Address) {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("GeneratePrivateKey: %s", err)
+	}
+	publicKey, err := privateKey.SchnorrPublicKey()
+	if err != nil {
+		t.Fatalf("SchnorrPublicKey: %s", err)
+	}
+	serializedPublicKey, err := publicKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %s", err)
+	}
+	address, err := util.NewAddressPubKeyHashFromPublicKey(serializedPublicKey[:], util.Bech32PrefixKaspaTest)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHashFromPublicKey: %s", err)
+	}
+	return privateKey, address
+}
+
+func TestSignAndVerify(t *testing.T) {
+	privateKey, address := newTestKeyAndAddress(t)
+	_, otherAddress := newTestKeyAndAddress(t)
+
+	const message = "I control this address"
+	signature, err := Sign(privateKey, message)
+	if err != nil {
+		t.Fatalf("Sign: %s", err)
+	}
+	if len(signature) != SignatureSize {
+		t.Fatalf("unexpected signature size. Want: %d, got: %d", SignatureSize, len(signature))
+	}
+
+	err = Verify(address, message, signature)
+	if err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+
+	err = Verify(address, message+"!", signature)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify of a different message: expected ErrInvalidSignature, got: %v", err)
+	}
+
+	err = Verify(otherAddress, message, signature)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify against a different address: expected ErrInvalidSignature, got: %v", err)
+	}
+
+	tamperedSignature := make([]byte, len(signature))
+	copy(tamperedSignature, signature)
+	tamperedSignature[0] ^= 1
+	err = Verify(address, message, tamperedSignature)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify of a tampered signature: expected ErrInvalidSignature, got: %v", err)
+	}
+
+	err = Verify(address, message, signature[:SignatureSize-1])
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify of a truncated signature: expected ErrInvalidSignature, got: %v", err)
+	}
+}
+
+func TestVerifyPubKeyAddress(t *testing.T) {
+	privateKey, _ := newTestKeyAndAddress(t)
+	publicKey, err := privateKey.SchnorrPublicKey()
+	if err != nil {
+		t.Fatalf("SchnorrPublicKey: %s", err)
+	}
+	serializedPublicKey, err := publicKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %s", err)
+	}
+	address, err := util.NewAddressPubKey(serializedPublicKey[:], util.Bech32PrefixKaspaTest)
+	if err != nil {
+		t.Fatalf("NewAddressPubKey: %s", err)
+	}
+
+	const message = "I control this address"
+	signature, err := Sign(privateKey, message)
+	if err != nil {
+		t.Fatalf("Sign: %s", err)
+	}
+	err = Verify(address, message, signature)
+	if err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+}
+
+func TestHash(t *testing.T) {
+	if Hash("a").Equal(Hash("b")) {
+		t.Fatalf("different messages have the same hash")
+	}
+	if !Hash("a").Equal(Hash("a")) {
+		t.Fatalf("the same message has different hashes")
+	}
+}